@@ -0,0 +1,221 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/executor"
+	"github.com/mike-jacks/neo/config"
+	"github.com/mike-jacks/neo/db"
+	"github.com/mike-jacks/neo/domainschema"
+	"github.com/mike-jacks/neo/generated"
+	"github.com/mike-jacks/neo/migrations"
+	"github.com/mike-jacks/neo/model"
+	"github.com/mike-jacks/neo/resolver"
+	"github.com/mike-jacks/neo/subscriptions"
+)
+
+// command is a subcommand run instead of the server, args are its positional arguments
+type command func(ctx context.Context, cfg *config.Config, database *db.Neo4jDatabase, args []string) error
+
+var commands = map[string]command{
+	"migrate": migrateCommand,
+	"schema":  schemaCommand,
+}
+
+const migrateUsage = `usage: neo migrate <command> [flags] [argument]
+
+commands:
+  status             list migrations and whether they are applied
+  apply [version]    apply pending migrations, up to and including version when given
+  rollback [steps]   roll back the last steps applied migrations, 1 by default`
+
+func migrateCommand(ctx context.Context, cfg *config.Config, database *db.Neo4jDatabase, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	loaded, err := migrations.Load(cfg.Migrations.Dir)
+	if err != nil {
+		return err
+	}
+	migrator := migrations.NewMigrator(database, graphqlExecutor(database), loaded, cfg.Migrations.LockTTL)
+
+	switch args[0] {
+	case "status":
+		statuses, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATE\tAPPLIED AT")
+		for _, status := range statuses {
+			appliedAt := ""
+			if status.AppliedAt != nil {
+				appliedAt = status.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", status.Version, status.Name, status.State, appliedAt)
+		}
+		return w.Flush()
+	case "apply":
+		target := int64(0)
+		if len(args) > 1 {
+			if target, err = strconv.ParseInt(args[1], 10, 64); err != nil {
+				return fmt.Errorf("invalid version %q", args[1])
+			}
+		}
+		applied, err := migrator.Apply(ctx, target)
+		for _, migration := range applied {
+			fmt.Printf("applied %d_%s\n", migration.Version, migration.Name)
+		}
+		if err == nil && len(applied) == 0 {
+			fmt.Println("no pending migrations")
+		}
+		return err
+	case "rollback":
+		steps := 1
+		if len(args) > 1 {
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps %q", args[1])
+			}
+		}
+		rolledBack, err := migrator.Rollback(ctx, steps)
+		for _, migration := range rolledBack {
+			fmt.Printf("rolled back %d_%s\n", migration.Version, migration.Name)
+		}
+		if err == nil && len(rolledBack) == 0 {
+			fmt.Println("no applied migrations")
+		}
+		return err
+	}
+	return errors.New(migrateUsage)
+}
+
+const schemaUsage = `usage: neo schema <command> [flags] <argument>
+
+commands:
+  plan <file>                                 print the changes needed to make a domain match a YAML or JSON schema document
+  apply <file>                                print the plan and apply it
+  diagram [-format f] [-counts] <domain>      print a diagram of a domain schema, f is one of
+                                              mermaid-er (default), mermaid-class, dot or plantuml`
+
+var diagramFormats = map[string]model.SchemaDiagramFormat{
+	"mermaid-er":    model.SchemaDiagramFormatMermaidEr,
+	"mermaid-class": model.SchemaDiagramFormatMermaidClass,
+	"dot":           model.SchemaDiagramFormatDot,
+	"plantuml":      model.SchemaDiagramFormatPlantuml,
+}
+
+func schemaCommand(ctx context.Context, cfg *config.Config, database *db.Neo4jDatabase, args []string) error {
+	if len(args) > 0 && args[0] == "diagram" {
+		return schemaDiagramCommand(ctx, database, args[1:])
+	}
+	if len(args) != 2 || (args[0] != "plan" && args[0] != "apply") {
+		return errors.New(schemaUsage)
+	}
+
+	content, err := os.ReadFile(args[1])
+	if err != nil {
+		return fmt.Errorf("unable to read schema document: %w", err)
+	}
+	document, err := domainschema.Parse(content)
+	if err != nil {
+		return err
+	}
+	plan, err := domainschema.NewPlan(ctx, database, document)
+	if err != nil {
+		return err
+	}
+
+	changes := plan.Changes()
+	if len(changes) == 0 {
+		fmt.Printf("domain schema %s is up to date\n", plan.Domain)
+		return nil
+	}
+	fmt.Printf("plan for domain schema %s:\n", plan.Domain)
+	printSchemaChanges(changes)
+	if args[0] == "plan" {
+		return nil
+	}
+
+	applied, err := plan.Apply(ctx, database, nil)
+	fmt.Printf("applied %d of %d changes\n", len(applied), len(changes))
+	return err
+}
+
+func schemaDiagramCommand(ctx context.Context, database *db.Neo4jDatabase, args []string) error {
+	flags := flag.NewFlagSet("diagram", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	formatName := flags.String("format", "mermaid-er", "")
+	includeCounts := flags.Bool("counts", false, "")
+	if err := flags.Parse(args); err != nil || flags.NArg() != 1 {
+		return errors.New(schemaUsage)
+	}
+	format, ok := diagramFormats[*formatName]
+	if !ok {
+		return fmt.Errorf("invalid diagram format %q", *formatName)
+	}
+
+	response, err := domainschema.Diagram(ctx, database, flags.Arg(0), format, *includeCounts)
+	if err != nil {
+		return err
+	}
+	if !response.Success {
+		return errors.New(*response.Message)
+	}
+	fmt.Print(*response.Diagram)
+	return nil
+}
+
+func printSchemaChanges(changes []*model.SchemaChange) {
+	symbols := map[model.SchemaChangeAction]string{
+		model.SchemaChangeActionCreate: "+",
+		model.SchemaChangeActionUpdate: "~",
+		model.SchemaChangeActionDelete: "-",
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, change := range changes {
+		affected := ""
+		if change.AffectedObjectNodes > 0 || change.AffectedObjectRelationships > 0 {
+			affected = fmt.Sprintf(" (%d object nodes, %d object relationships)", change.AffectedObjectNodes, change.AffectedObjectRelationships)
+		}
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\t%s%s\n", symbols[change.Action], change.Kind, change.Target, change.Compatibility, change.Description, affected)
+	}
+	w.Flush()
+}
+
+// graphqlExecutor runs operations in process against the same resolvers the server uses
+func graphqlExecutor(database db.Database) migrations.GraphQLExecutor {
+	resolver := resolver.NewResolver(database, subscriptions.NewSubscriptionManager())
+	exec := executor.New(generated.NewExecutableSchema(generated.Config{Resolvers: resolver}))
+
+	return func(ctx context.Context, document string, operationName string) (json.RawMessage, error) {
+		ctx = graphql.StartOperationTrace(ctx)
+		now := graphql.Now()
+		params := &graphql.RawParams{
+			Query:         document,
+			OperationName: operationName,
+			ReadTime:      graphql.TraceTiming{Start: now, End: now},
+		}
+
+		opCtx, errs := exec.CreateOperationContext(ctx, params)
+		if len(errs) > 0 {
+			return nil, errs
+		}
+		handler, ctx := exec.DispatchOperation(ctx, opCtx)
+		response := handler(ctx)
+		if len(response.Errors) > 0 {
+			return nil, response.Errors
+		}
+		return response.Data, nil
+	}
+}
@@ -0,0 +1,355 @@
+// Package config holds the typed server configuration.
+//
+// Values are resolved in increasing order of precedence:
+//
+//  1. built-in defaults
+//  2. the YAML, JSON or TOML file named by --config or NEO_CONFIG, TOML when its name ends in .toml
+//  3. environment variables, including those loaded from .env
+//  4. command line flags
+//
+// Run the server with --print-config to see the effective values with secrets redacted, it prints
+// them before reporting invalid settings.
+package config
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/mike-jacks/neo/logging"
+	"gopkg.in/yaml.v3"
+)
+
+type Config struct {
+	Server     ServerConfig     `yaml:"server"`
+	Neo4j      Neo4jConfig      `yaml:"neo4j"`
+	GraphQL    GraphQLConfig    `yaml:"graphql"`
+	Websocket  WebsocketConfig  `yaml:"websocket"`
+	CORS       CORSConfig       `yaml:"cors"`
+	Logging    LoggingConfig    `yaml:"logging"`
+	Tracing    TracingConfig    `yaml:"tracing"`
+	Migrations MigrationsConfig `yaml:"migrations"`
+
+	// PrintConfig is only settable from the command line
+	PrintConfig bool `yaml:"-"`
+	// Args are the positional arguments left after the flags, used by subcommands
+	Args []string `yaml:"-"`
+}
+
+type ServerConfig struct {
+	Port            int           `yaml:"port"`
+	URL             string        `yaml:"url"`
+	ShutdownTimeout time.Duration `yaml:"shutdownTimeout"`
+	// ShutdownReadinessDelay is how long readiness fails before the server stops accepting requests,
+	// so load balancers stop routing to it first
+	ShutdownReadinessDelay time.Duration `yaml:"shutdownReadinessDelay"`
+	HealthCheckTimeout     time.Duration `yaml:"healthCheckTimeout"`
+}
+
+type Neo4jConfig struct {
+	URI      string `yaml:"uri"`
+	Username string `yaml:"username"`
+	Password string `yaml:"password"`
+	// Database is the database every session targets, empty uses the user's home database
+	Database                     string        `yaml:"database"`
+	MaxConnectionPoolSize        int           `yaml:"maxConnectionPoolSize"`
+	ConnectionAcquisitionTimeout time.Duration `yaml:"connectionAcquisitionTimeout"`
+	MaxConnectionLifetime        time.Duration `yaml:"maxConnectionLifetime"`
+	MaxTransactionRetryTime      time.Duration `yaml:"maxTransactionRetryTime"`
+	// CACertFile is a PEM file of certificates trusted for TLS, for servers using a private CA
+	CACertFile string `yaml:"caCertFile"`
+	// CausalConsistency shares bookmarks between sessions so reads observe earlier writes in a cluster
+	CausalConsistency bool `yaml:"causalConsistency"`
+}
+
+type GraphQLConfig struct {
+	QueryCacheSize          int `yaml:"queryCacheSize"`
+	PersistedQueryCacheSize int `yaml:"persistedQueryCacheSize"`
+}
+
+type WebsocketConfig struct {
+	ReadBufferSize  int `yaml:"readBufferSize"`
+	WriteBufferSize int `yaml:"writeBufferSize"`
+}
+
+type CORSConfig struct {
+	AllowedOrigins []string `yaml:"allowedOrigins"`
+	AllowedMethods []string `yaml:"allowedMethods"`
+	AllowedHeaders []string `yaml:"allowedHeaders"`
+}
+
+type LoggingConfig struct {
+	Level   string `yaml:"level"`
+	Format  string `yaml:"format"`
+	Queries bool   `yaml:"queries"`
+}
+
+type TracingConfig struct {
+	OTLPEndpoint string `yaml:"otlpEndpoint"`
+}
+
+type MigrationsConfig struct {
+	Dir string `yaml:"dir"`
+	// LockTTL bounds how long a crashed instance can keep other instances from migrating
+	LockTTL time.Duration `yaml:"lockTTL"`
+}
+
+// Default returns the configuration used when nothing else is set
+func Default() *Config {
+	return &Config{
+		Server: ServerConfig{
+			Port:                   8080,
+			ShutdownTimeout:        30 * time.Second,
+			ShutdownReadinessDelay: 5 * time.Second,
+			HealthCheckTimeout:     5 * time.Second,
+		},
+		Neo4j: Neo4jConfig{
+			MaxConnectionPoolSize:        100,
+			ConnectionAcquisitionTimeout: time.Minute,
+			MaxConnectionLifetime:        time.Hour,
+			MaxTransactionRetryTime:      30 * time.Second,
+			CausalConsistency:            true,
+		},
+		GraphQL: GraphQLConfig{
+			QueryCacheSize:          1000,
+			PersistedQueryCacheSize: 100,
+		},
+		Websocket: WebsocketConfig{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+		},
+		CORS: CORSConfig{
+			AllowedOrigins: []string{"*"},
+			AllowedMethods: []string{"GET", "POST", "OPTIONS"},
+			AllowedHeaders: []string{"*"},
+		},
+		Logging: LoggingConfig{
+			Level:  "info",
+			Format: "text",
+		},
+		Migrations: MigrationsConfig{
+			Dir:     "migrations/files",
+			LockTTL: 10 * time.Minute,
+		},
+	}
+}
+
+// Load resolves the configuration from defaults, the config file, the environment and args, which
+// are the command line arguments without the program name, then validates it. With --print-config
+// it is not validated, the caller prints it and then calls Validate.
+func Load(args []string) (*Config, error) {
+	cfg := Default()
+	settings := cfg.settings()
+
+	flags := flag.NewFlagSet("neo", flag.ContinueOnError)
+	configPath := flags.String("config", os.Getenv("NEO_CONFIG"), "path to a YAML, JSON or TOML config file (env NEO_CONFIG)")
+	flags.BoolVar(&cfg.PrintConfig, "print-config", false, "print the effective configuration with secrets redacted and exit")
+	flagValues := map[string]*string{}
+	for _, setting := range settings {
+		flagValues[setting.flag] = flags.String(setting.flag, "", fmt.Sprintf("%s (env %s)", setting.usage, setting.env))
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	cfg.Args = flags.Args()
+
+	if *configPath != "" {
+		if err := cfg.loadFile(*configPath); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, setting := range settings {
+		value, ok := os.LookupEnv(setting.env)
+		if !ok || value == "" {
+			continue
+		}
+		if err := setting.value.Set(value); err != nil {
+			return nil, fmt.Errorf("invalid value for %s: %w", setting.env, err)
+		}
+	}
+
+	var flagErr error
+	flags.Visit(func(f *flag.Flag) {
+		for _, setting := range settings {
+			if setting.flag != f.Name {
+				continue
+			}
+			if err := setting.value.Set(*flagValues[f.Name]); err != nil {
+				flagErr = errors.Join(flagErr, fmt.Errorf("invalid value for --%s: %w", f.Name, err))
+			}
+		}
+	})
+	if flagErr != nil {
+		return nil, flagErr
+	}
+
+	if cfg.PrintConfig {
+		return cfg, nil
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (cfg *Config) loadFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("unable to open config file: %w", err)
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if strings.EqualFold(filepath.Ext(path), ".toml") {
+		// TOML is converted to YAML so both are decoded with the same keys, durations and unknown
+		// field checks
+		values := map[string]any{}
+		if _, err := toml.NewDecoder(file).Decode(&values); err != nil {
+			return fmt.Errorf("unable to parse config file %s: %w", path, err)
+		}
+		converted, err := yaml.Marshal(values)
+		if err != nil {
+			return fmt.Errorf("unable to parse config file %s: %w", path, err)
+		}
+		reader = bytes.NewReader(converted)
+	}
+
+	decoder := yaml.NewDecoder(reader)
+	decoder.KnownFields(true)
+	if err := decoder.Decode(cfg); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("unable to parse config file %s: %w", path, err)
+	}
+	return nil
+}
+
+// Validate reports every invalid setting at once, naming the environment variable and flag that set it
+func (cfg *Config) Validate() error {
+	var errs []error
+	invalid := func(key string, format string, args ...any) {
+		for _, setting := range cfg.settings() {
+			if setting.key == key {
+				errs = append(errs, fmt.Errorf("%s (env %s, flag --%s): %s", key, setting.env, setting.flag, fmt.Sprintf(format, args...)))
+				return
+			}
+		}
+		errs = append(errs, fmt.Errorf("%s: %s", key, fmt.Sprintf(format, args...)))
+	}
+
+	if cfg.Server.Port < 1 || cfg.Server.Port > 65535 {
+		invalid("server.port", "must be between 1 and 65535, got %d", cfg.Server.Port)
+	}
+	if cfg.Server.URL != "" {
+		if parsed, err := url.Parse(cfg.Server.URL); err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			invalid("server.url", "must be an absolute URL such as https://neo.example.com, got %q", cfg.Server.URL)
+		}
+	}
+	if cfg.Server.ShutdownTimeout <= 0 {
+		invalid("server.shutdownTimeout", "must be positive, got %s", cfg.Server.ShutdownTimeout)
+	}
+	if cfg.Server.ShutdownReadinessDelay < 0 {
+		invalid("server.shutdownReadinessDelay", "must not be negative, got %s", cfg.Server.ShutdownReadinessDelay)
+	}
+	if cfg.Server.HealthCheckTimeout <= 0 {
+		invalid("server.healthCheckTimeout", "must be positive, got %s", cfg.Server.HealthCheckTimeout)
+	}
+
+	if cfg.Neo4j.URI == "" {
+		invalid("neo4j.uri", "is required, for example neo4j+s://xxxx.databases.neo4j.io")
+	} else if parsed, err := url.Parse(cfg.Neo4j.URI); err != nil || !validNeo4jScheme(parsed.Scheme) {
+		invalid("neo4j.uri", "must use one of the neo4j, neo4j+s, neo4j+ssc, bolt, bolt+s or bolt+ssc schemes, got %q", cfg.Neo4j.URI)
+	}
+	if cfg.Neo4j.Username == "" {
+		invalid("neo4j.username", "is required")
+	}
+	if cfg.Neo4j.MaxConnectionPoolSize < 1 {
+		invalid("neo4j.maxConnectionPoolSize", "must be at least 1, got %d", cfg.Neo4j.MaxConnectionPoolSize)
+	}
+	if cfg.Neo4j.ConnectionAcquisitionTimeout <= 0 {
+		invalid("neo4j.connectionAcquisitionTimeout", "must be positive, got %s", cfg.Neo4j.ConnectionAcquisitionTimeout)
+	}
+	if cfg.Neo4j.MaxConnectionLifetime <= 0 {
+		invalid("neo4j.maxConnectionLifetime", "must be positive, got %s", cfg.Neo4j.MaxConnectionLifetime)
+	}
+	if cfg.Neo4j.MaxTransactionRetryTime < 0 {
+		invalid("neo4j.maxTransactionRetryTime", "must not be negative, got %s", cfg.Neo4j.MaxTransactionRetryTime)
+	}
+	if cfg.Neo4j.CACertFile != "" {
+		if _, err := os.Stat(cfg.Neo4j.CACertFile); err != nil {
+			invalid("neo4j.caCertFile", "%s", err)
+		}
+		if parsed, err := url.Parse(cfg.Neo4j.URI); err == nil && !strings.HasSuffix(parsed.Scheme, "+s") {
+			invalid("neo4j.caCertFile", "is only used with the neo4j+s or bolt+s schemes, got %q", cfg.Neo4j.URI)
+		}
+	}
+
+	if cfg.GraphQL.QueryCacheSize < 1 {
+		invalid("graphql.queryCacheSize", "must be at least 1, got %d", cfg.GraphQL.QueryCacheSize)
+	}
+	if cfg.GraphQL.PersistedQueryCacheSize < 1 {
+		invalid("graphql.persistedQueryCacheSize", "must be at least 1, got %d", cfg.GraphQL.PersistedQueryCacheSize)
+	}
+	if cfg.Websocket.ReadBufferSize < 1 {
+		invalid("websocket.readBufferSize", "must be at least 1, got %d", cfg.Websocket.ReadBufferSize)
+	}
+	if cfg.Websocket.WriteBufferSize < 1 {
+		invalid("websocket.writeBufferSize", "must be at least 1, got %d", cfg.Websocket.WriteBufferSize)
+	}
+	if len(cfg.CORS.AllowedOrigins) == 0 {
+		invalid("cors.allowedOrigins", "must list at least one origin, use * to allow any")
+	}
+
+	if _, err := logging.ParseLevel(cfg.Logging.Level); err != nil {
+		invalid("logging.level", "%s", err)
+	}
+	if format := strings.ToLower(cfg.Logging.Format); format != "text" && format != "json" {
+		invalid("logging.format", "must be text or json, got %q", cfg.Logging.Format)
+	}
+
+	if cfg.Migrations.LockTTL <= 0 {
+		invalid("migrations.lockTTL", "must be positive, got %s", cfg.Migrations.LockTTL)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid configuration:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func validNeo4jScheme(scheme string) bool {
+	switch scheme {
+	case "neo4j", "neo4j+s", "neo4j+ssc", "bolt", "bolt+s", "bolt+ssc":
+		return true
+	}
+	return false
+}
+
+// ServerURL returns the public URL of the server, defaulting to localhost on the configured port
+func (cfg *Config) ServerURL() string {
+	if cfg.Server.URL != "" {
+		return strings.TrimSuffix(cfg.Server.URL, "/")
+	}
+	return fmt.Sprintf("http://localhost:%d", cfg.Server.Port)
+}
+
+// Print writes the effective configuration as YAML with secrets redacted
+func (cfg *Config) Print(w io.Writer) error {
+	redacted := *cfg
+	for _, setting := range redacted.settings() {
+		if setting.secret && setting.value.String() != "" {
+			_ = setting.value.Set(redactedValue)
+		}
+	}
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	defer encoder.Close()
+	return encoder.Encode(&redacted)
+}
@@ -0,0 +1,63 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadReadsTOMLConfigFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "neo.toml")
+	content := `
+[server]
+port = 9090
+shutdownTimeout = "45s"
+
+[neo4j]
+uri = "neo4j://localhost:7687"
+username = "neo4j"
+
+[cors]
+allowedOrigins = ["https://neo.example.com"]
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := Load([]string{"--config", path})
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.Server.Port != 9090 || cfg.Server.ShutdownTimeout != 45*time.Second || cfg.Neo4j.URI != "neo4j://localhost:7687" {
+		t.Errorf("got server %+v and neo4j %+v, want the values of the file", cfg.Server, cfg.Neo4j)
+	}
+	if len(cfg.CORS.AllowedOrigins) != 1 || cfg.CORS.AllowedOrigins[0] != "https://neo.example.com" {
+		t.Errorf("got allowed origins %v, want those of the file", cfg.CORS.AllowedOrigins)
+	}
+	if cfg.Neo4j.MaxConnectionPoolSize != 100 {
+		t.Errorf("got max connection pool size %d, want the default", cfg.Neo4j.MaxConnectionPoolSize)
+	}
+}
+
+func TestLoadRejectsUnknownTOMLKeys(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "neo.toml")
+	if err := os.WriteFile(path, []byte("[server]\nprot = 9090\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Load([]string{"--config", path}); err == nil || !strings.Contains(err.Error(), "prot") {
+		t.Errorf("got error %v, want the unknown key reported", err)
+	}
+}
+
+func TestLoadLeavesValidationToPrintConfig(t *testing.T) {
+	cfg, err := Load([]string{"--print-config", "--port", "0"})
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), "server.port") {
+		t.Errorf("got validation error %v, want the invalid port reported", err)
+	}
+}
@@ -0,0 +1,130 @@
+package config
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const redactedValue = "********"
+
+// setting binds one configuration value to its YAML key, environment variable and flag
+type setting struct {
+	key    string
+	env    string
+	flag   string
+	usage  string
+	secret bool
+	value  value
+}
+
+// value is satisfied by flag.Value, every setting is applied from a string whatever its source
+type value interface {
+	String() string
+	Set(string) error
+}
+
+// settings lists every setting that can be overridden from the environment or the command line.
+// Environment variable names predating the config file are kept for compatibility.
+func (cfg *Config) settings() []setting {
+	return []setting{
+		{key: "server.port", env: "PORT", flag: "port", usage: "HTTP listen port", value: (*intValue)(&cfg.Server.Port)},
+		{key: "server.url", env: "URL", flag: "url", usage: "public URL of the server", value: (*stringValue)(&cfg.Server.URL)},
+		{key: "server.shutdownTimeout", env: "SHUTDOWN_TIMEOUT", flag: "shutdown-timeout", usage: "time allowed to drain in-flight operations on shutdown", value: (*durationValue)(&cfg.Server.ShutdownTimeout)},
+		{key: "server.shutdownReadinessDelay", env: "SHUTDOWN_READINESS_DELAY", flag: "shutdown-readiness-delay", usage: "time readiness fails before requests are refused on shutdown, 0 to refuse them at once", value: (*durationValue)(&cfg.Server.ShutdownReadinessDelay)},
+		{key: "server.healthCheckTimeout", env: "HEALTH_CHECK_TIMEOUT", flag: "health-check-timeout", usage: "time allowed for readiness checks", value: (*durationValue)(&cfg.Server.HealthCheckTimeout)},
+
+		{key: "neo4j.uri", env: "NEO4J_URI", flag: "neo4j-uri", usage: "Neo4j connection URI", value: (*stringValue)(&cfg.Neo4j.URI)},
+		{key: "neo4j.username", env: "NEO4J_USERNAME", flag: "neo4j-username", usage: "Neo4j username", value: (*stringValue)(&cfg.Neo4j.Username)},
+		{key: "neo4j.password", env: "NEO4J_PASSWORD", flag: "neo4j-password", usage: "Neo4j password", secret: true, value: (*stringValue)(&cfg.Neo4j.Password)},
+		{key: "neo4j.database", env: "NEO4J_DATABASE", flag: "neo4j-database", usage: "Neo4j database to use, empty for the user's home database", value: (*stringValue)(&cfg.Neo4j.Database)},
+		{key: "neo4j.maxConnectionPoolSize", env: "NEO4J_MAX_CONNECTION_POOL_SIZE", flag: "neo4j-max-connection-pool-size", usage: "maximum number of connections per Neo4j server", value: (*intValue)(&cfg.Neo4j.MaxConnectionPoolSize)},
+		{key: "neo4j.connectionAcquisitionTimeout", env: "NEO4J_CONNECTION_ACQUISITION_TIMEOUT", flag: "neo4j-connection-acquisition-timeout", usage: "maximum time to wait for a connection from the pool", value: (*durationValue)(&cfg.Neo4j.ConnectionAcquisitionTimeout)},
+		{key: "neo4j.maxConnectionLifetime", env: "NEO4J_MAX_CONNECTION_LIFETIME", flag: "neo4j-max-connection-lifetime", usage: "maximum age of a pooled connection", value: (*durationValue)(&cfg.Neo4j.MaxConnectionLifetime)},
+		{key: "neo4j.maxTransactionRetryTime", env: "NEO4J_MAX_TRANSACTION_RETRY_TIME", flag: "neo4j-max-transaction-retry-time", usage: "maximum time spent retrying a transaction after transient errors", value: (*durationValue)(&cfg.Neo4j.MaxTransactionRetryTime)},
+		{key: "neo4j.caCertFile", env: "NEO4J_CA_CERT_FILE", flag: "neo4j-ca-cert-file", usage: "PEM file of CA certificates trusted for neo4j+s and bolt+s connections", value: (*stringValue)(&cfg.Neo4j.CACertFile)},
+		{key: "neo4j.causalConsistency", env: "NEO4J_CAUSAL_CONSISTENCY", flag: "neo4j-causal-consistency", usage: "chain sessions with bookmarks so reads see earlier writes", value: (*boolValue)(&cfg.Neo4j.CausalConsistency)},
+
+		{key: "graphql.queryCacheSize", env: "QUERY_CACHE_SIZE", flag: "query-cache-size", usage: "number of parsed query documents to cache", value: (*intValue)(&cfg.GraphQL.QueryCacheSize)},
+		{key: "graphql.persistedQueryCacheSize", env: "PERSISTED_QUERY_CACHE_SIZE", flag: "persisted-query-cache-size", usage: "number of automatic persisted queries to cache", value: (*intValue)(&cfg.GraphQL.PersistedQueryCacheSize)},
+
+		{key: "websocket.readBufferSize", env: "WEBSOCKET_READ_BUFFER_SIZE", flag: "websocket-read-buffer-size", usage: "websocket read buffer size in bytes", value: (*intValue)(&cfg.Websocket.ReadBufferSize)},
+		{key: "websocket.writeBufferSize", env: "WEBSOCKET_WRITE_BUFFER_SIZE", flag: "websocket-write-buffer-size", usage: "websocket write buffer size in bytes", value: (*intValue)(&cfg.Websocket.WriteBufferSize)},
+
+		{key: "cors.allowedOrigins", env: "CORS_ALLOWED_ORIGINS", flag: "cors-allowed-origins", usage: "comma separated list of allowed CORS origins", value: (*listValue)(&cfg.CORS.AllowedOrigins)},
+		{key: "cors.allowedMethods", env: "CORS_ALLOWED_METHODS", flag: "cors-allowed-methods", usage: "comma separated list of allowed CORS methods", value: (*listValue)(&cfg.CORS.AllowedMethods)},
+		{key: "cors.allowedHeaders", env: "CORS_ALLOWED_HEADERS", flag: "cors-allowed-headers", usage: "comma separated list of allowed CORS headers", value: (*listValue)(&cfg.CORS.AllowedHeaders)},
+
+		{key: "logging.level", env: "LOG_LEVEL", flag: "log-level", usage: "log level: debug, info, warn or error", value: (*stringValue)(&cfg.Logging.Level)},
+		{key: "logging.format", env: "LOG_FORMAT", flag: "log-format", usage: "log format: text or json", value: (*stringValue)(&cfg.Logging.Format)},
+		{key: "logging.queries", env: "LOG_QUERIES", flag: "log-queries", usage: "log generated Cypher at debug level with parameter values redacted", value: (*boolValue)(&cfg.Logging.Queries)},
+
+		{key: "tracing.otlpEndpoint", env: "OTEL_EXPORTER_OTLP_ENDPOINT", flag: "otlp-endpoint", usage: "OTLP/HTTP collector URL, tracing is disabled when empty", value: (*stringValue)(&cfg.Tracing.OTLPEndpoint)},
+
+		{key: "migrations.dir", env: "MIGRATIONS_DIR", flag: "migrations-dir", usage: "directory holding the migration files", value: (*stringValue)(&cfg.Migrations.Dir)},
+		{key: "migrations.lockTTL", env: "MIGRATIONS_LOCK_TTL", flag: "migrations-lock-ttl", usage: "time after which a migration lock held by a crashed instance expires", value: (*durationValue)(&cfg.Migrations.LockTTL)},
+	}
+}
+
+type stringValue string
+
+func (v *stringValue) String() string { return string(*v) }
+
+func (v *stringValue) Set(s string) error {
+	*v = stringValue(s)
+	return nil
+}
+
+type intValue int
+
+func (v *intValue) String() string { return strconv.Itoa(int(*v)) }
+
+func (v *intValue) Set(s string) error {
+	i, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return err
+	}
+	*v = intValue(i)
+	return nil
+}
+
+type boolValue bool
+
+func (v *boolValue) String() string { return strconv.FormatBool(bool(*v)) }
+
+func (v *boolValue) Set(s string) error {
+	b, err := strconv.ParseBool(strings.TrimSpace(s))
+	if err != nil {
+		return err
+	}
+	*v = boolValue(b)
+	return nil
+}
+
+type durationValue time.Duration
+
+func (v *durationValue) String() string { return time.Duration(*v).String() }
+
+func (v *durationValue) Set(s string) error {
+	d, err := time.ParseDuration(strings.TrimSpace(s))
+	if err != nil {
+		return err
+	}
+	*v = durationValue(d)
+	return nil
+}
+
+type listValue []string
+
+func (v *listValue) String() string { return strings.Join(*v, ",") }
+
+func (v *listValue) Set(s string) error {
+	items := []string{}
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	*v = items
+	return nil
+}
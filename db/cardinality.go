@@ -0,0 +1,332 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mike-jacks/neo/model"
+	"github.com/mike-jacks/neo/utils"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// The cardinality of a relationship schema node caps the object relationships of its name an object node of its
+// from type has to object nodes of its to type, and the other way around. Creating an object relationship fails when
+// it would exceed a cap, deleting one fails when it would leave a required side without object relationships. The
+// statement creating or deleting the object relationship locks both object nodes and checks the cap in the same
+// transaction, see cardinalityCreateGuard and cardinalityDeleteGuard, so concurrent writes cannot both pass.
+// Setting a cardinality only counts the existing object nodes breaking it, relationshipCardinalityReport lists them.
+
+// cardinalityLimits returns how many object relationships of a relationship schema node an object node may have on
+// each side, 0 for any number
+func cardinalityLimits(cardinality model.Cardinality) (outgoing int64, incoming int64) {
+	switch cardinality {
+	case model.CardinalityOneToOne:
+		return 1, 1
+	case model.CardinalityOneToMany:
+		return 0, 1
+	case model.CardinalityManyToOne:
+		return 1, 0
+	}
+	return 0, 0
+}
+
+// cardinalitySchemaMatch matches, for an object relationship named name from fromObjectNode to toObjectNode, the
+// relationship schema nodes with a cardinality describing it. It ends in a WHERE clause conditions can be added to.
+var cardinalitySchemaMatch = `
+	MATCH (relationshipSchemaNode:RELATIONSHIP_SCHEMA {_name: name})
+	WHERE relationshipSchemaNode._cardinality IS NOT NULL
+	MATCH (fromTypeSchemaNode:TYPE_SCHEMA {_id: relationshipSchemaNode._fromTypeSchemaNodeId}), (toTypeSchemaNode:TYPE_SCHEMA {_id: relationshipSchemaNode._toTypeSchemaNodeId})
+	WHERE ` + objectNodeOfType("fromObjectNode", "fromTypeSchemaNode") + ` AND ` + objectNodeOfType("toObjectNode", "toTypeSchemaNode")
+
+// outgoingCount and incomingCount count the object relationships a relationship schema node matched by
+// cardinalitySchemaMatch describes of fromObjectNode and toObjectNode
+var (
+	outgoingCount = `size([(fromObjectNode)-[rel]->(objectNode) WHERE rel._name = name AND ` + objectNodeOfType("objectNode", "toTypeSchemaNode") + ` | rel])`
+	incomingCount = `size([(objectNode)-[rel]->(toObjectNode) WHERE rel._name = name AND ` + objectNodeOfType("objectNode", "fromTypeSchemaNode") + ` | rel])`
+)
+
+// cardinalityMatchQuery returns the relationship schema nodes cardinalitySchemaMatch matches with their counts
+var cardinalityMatchQuery = cardinalitySchemaMatch + `
+	RETURN relationshipSchemaNode, ` + outgoingCount + ` AS outgoing, ` + incomingCount + ` AS incoming
+`
+
+// lockObjectNodes takes the write locks of fromObjectNode and toObjectNode, so transactions changing their object
+// relationships run one after the other and the cardinality guards count the object relationships committed meanwhile
+var lockObjectNodes = `
+	SET fromObjectNode._lock = true, toObjectNode._lock = true
+	REMOVE fromObjectNode._lock, toObjectNode._lock
+`
+
+// cardinalityCreateGuard keeps the rows binding fromObjectNode, toObjectNode and name, after lockObjectNodes, when
+// one more object relationship named name between them stays within the cardinality of every relationship schema node
+var cardinalityCreateGuard = `
+	WHERE NOT EXISTS {` + cardinalitySchemaMatch + ` AND (
+		(relationshipSchemaNode._cardinality IN ` + cardinalitiesLimiting(true) + ` AND ` + outgoingCount + ` >= 1)
+		OR (relationshipSchemaNode._cardinality IN ` + cardinalitiesLimiting(false) + ` AND ` + incomingCount + ` >= 1)
+	)}
+`
+
+// cardinalityDeleteGuard keeps the rows binding fromObjectNode, toObjectNode and name, after lockObjectNodes, when
+// deleting one object relationship named name between them leaves every required side of a relationship schema node
+// with object relationships
+var cardinalityDeleteGuard = `
+	WHERE NOT EXISTS {` + cardinalitySchemaMatch + ` AND (
+		(relationshipSchemaNode.` + utils.FromRequiredKey + ` AND ` + outgoingCount + ` <= 1)
+		OR (relationshipSchemaNode.` + utils.ToRequiredKey + ` AND ` + incomingCount + ` <= 1)
+	)}
+`
+
+// cardinalitiesLimiting lists the cardinalities allowing one object relationship per from object node, outgoing, or
+// per to object node as a Cypher list
+func cardinalitiesLimiting(outgoing bool) string {
+	limiting := []string{}
+	for _, cardinality := range model.AllCardinality {
+		outgoingLimit, incomingLimit := cardinalityLimits(cardinality)
+		if (outgoing && outgoingLimit > 0) || (!outgoing && incomingLimit > 0) {
+			limiting = append(limiting, fmt.Sprintf("'%s'", cardinality))
+		}
+	}
+	return "[" + strings.Join(limiting, ", ") + "]"
+}
+
+// cardinalityCounts is a relationship schema node with a cardinality describing an object relationship and the
+// number of object relationships it describes of the from and to object nodes
+type cardinalityCounts struct {
+	relationshipSchemaNode *model.RelationshipSchemaNode
+	outgoing               int64
+	incoming               int64
+}
+
+// cardinalityCountsOf reads the rows of cardinalityMatchQuery run in tx after match, which binds fromObjectNode,
+// toObjectNode and name
+func (db *Neo4jDatabase) cardinalityCountsOf(ctx context.Context, tx neo4j.ManagedTransaction, match string, parameters map[string]any) ([]*cardinalityCounts, error) {
+	result, err := transactionQuery(ctx, tx, match+cardinalityMatchQuery, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := []*cardinalityCounts{}
+	for result.Next(ctx) {
+		record := result.Record()
+		relationshipSchemaNode, _, err := neo4j.GetRecordValue[dbtype.Node](record, "relationshipSchemaNode")
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the relationshipSchemaNode")
+		}
+		outgoing, _, _ := neo4j.GetRecordValue[int64](record, "outgoing")
+		incoming, _, _ := neo4j.GetRecordValue[int64](record, "incoming")
+		counts = append(counts, &cardinalityCounts{
+			relationshipSchemaNode: &model.RelationshipSchemaNode{
+				ID:           utils.PopString(relationshipSchemaNode.Props, "_id"),
+				Name:         utils.PopString(relationshipSchemaNode.Props, "_name"),
+				OriginalName: utils.PopString(relationshipSchemaNode.Props, "_originalName"),
+				Cardinality:  utils.PopRelationshipCardinality(relationshipSchemaNode.Props),
+			},
+			outgoing: outgoing,
+			incoming: incoming,
+		})
+	}
+	return counts, result.Err()
+}
+
+// checkCardinalityOnCreate rejects an object relationship named name from fromObjectNodeId to toObjectNodeId
+// exceeding the cardinality of a relationship schema node. It explains why cardinalityCreateGuard kept no row, in
+// the same transaction.
+func (db *Neo4jDatabase) checkCardinalityOnCreate(ctx context.Context, tx neo4j.ManagedTransaction, name string, fromObjectNodeId string, toObjectNodeId string) error {
+	match := "MATCH (fromObjectNode {_id: $fromObjectNodeId}), (toObjectNode {_id: $toObjectNodeId}) WITH fromObjectNode, toObjectNode, $name AS name"
+	parameters := map[string]any{
+		"name":             name,
+		"fromObjectNodeId": fromObjectNodeId,
+		"toObjectNodeId":   toObjectNodeId,
+	}
+	counts, err := db.cardinalityCountsOf(ctx, tx, match, parameters)
+	if err != nil {
+		return err
+	}
+	for _, count := range counts {
+		cardinality := count.relationshipSchemaNode.Cardinality
+		outgoingLimit, incomingLimit := cardinalityLimits(cardinality.Cardinality)
+		if outgoingLimit > 0 && count.outgoing >= outgoingLimit {
+			return reject("object node %s already has its %s relationship, %s allows one per from object node", fromObjectNodeId, count.relationshipSchemaNode.Name, cardinality.Cardinality)
+		}
+		if incomingLimit > 0 && count.incoming >= incomingLimit {
+			return reject("object node %s already has its %s relationship, %s allows one per to object node", toObjectNodeId, count.relationshipSchemaNode.Name, cardinality.Cardinality)
+		}
+	}
+	return nil
+}
+
+// checkCardinalityOnDelete rejects deleting the object relationship id when it would leave a required side of a
+// relationship schema node without object relationships. It explains why cardinalityDeleteGuard kept no row, in the
+// same transaction.
+func (db *Neo4jDatabase) checkCardinalityOnDelete(ctx context.Context, tx neo4j.ManagedTransaction, id string) error {
+	match := "MATCH (fromObjectNode)-[relationship {_id: $id}]->(toObjectNode) WITH fromObjectNode, toObjectNode, relationship._name AS name"
+	counts, err := db.cardinalityCountsOf(ctx, tx, match, map[string]any{"id": id})
+	if err != nil {
+		return err
+	}
+	for _, count := range counts {
+		cardinality := count.relationshipSchemaNode.Cardinality
+		if cardinality.FromRequired && count.outgoing <= 1 {
+			return reject("%s relationship %s is the last of its from object node, which requires one", count.relationshipSchemaNode.Name, id)
+		}
+		if cardinality.ToRequired && count.incoming <= 1 {
+			return reject("%s relationship %s is the last of its to object node, which requires one", count.relationshipSchemaNode.Name, id)
+		}
+	}
+	return nil
+}
+
+// SetCardinalityOnRelationshipSchemaNode stores the cardinality of the relationship schema node, a nil cardinality
+// removes it. The message reports how many existing object nodes break it.
+func (db *Neo4jDatabase) SetCardinalityOnRelationshipSchemaNode(ctx context.Context, id string, cardinality *model.RelationshipCardinalityInput) (*model.RelationshipSchemaNodeResponse, error) {
+	ctx, done := instrument(ctx, "SetCardinalityOnRelationshipSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
+	defer session.Close(ctx)
+
+	query := fmt.Sprintf("MATCH (relationshipSchemaNode:RELATIONSHIP_SCHEMA {_id: $id}) REMOVE relationshipSchemaNode.%s, relationshipSchemaNode.%s, relationshipSchemaNode.%s RETURN relationshipSchemaNode", utils.CardinalityKey, utils.FromRequiredKey, utils.ToRequiredKey)
+	parameters := map[string]any{
+		"id": id,
+	}
+	if cardinality != nil {
+		query = fmt.Sprintf("MATCH (relationshipSchemaNode:RELATIONSHIP_SCHEMA {_id: $id}) SET relationshipSchemaNode.%s = $cardinality, relationshipSchemaNode.%s = $fromRequired, relationshipSchemaNode.%s = $toRequired RETURN relationshipSchemaNode", utils.CardinalityKey, utils.FromRequiredKey, utils.ToRequiredKey)
+		parameters["cardinality"] = cardinality.Cardinality.String()
+		parameters["fromRequired"] = cardinality.FromRequired != nil && *cardinality.FromRequired
+		parameters["toRequired"] = cardinality.ToRequired != nil && *cardinality.ToRequired
+	}
+
+	result, err := writeQuery(ctx, session, query, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Next(ctx) {
+		record := result.Record()
+		relationshipSchemaNode, ok := record.Get("relationshipSchemaNode")
+		if !ok {
+			return nil, fmt.Errorf("failed to retrieve the relationshipSchemaNode")
+		}
+		neo4jRelationshipSchemaNode, ok := relationshipSchemaNode.(dbtype.Node)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type for relationshipSchemaNode: %T", relationshipSchemaNode)
+		}
+		data := &model.RelationshipSchemaNode{
+			ID:                   utils.PopString(neo4jRelationshipSchemaNode.Props, "_id"),
+			Domain:               utils.PopString(neo4jRelationshipSchemaNode.Props, "_domain"),
+			Name:                 utils.PopString(neo4jRelationshipSchemaNode.Props, "_name"),
+			OriginalName:         utils.PopString(neo4jRelationshipSchemaNode.Props, "_originalName"),
+			Type:                 utils.PopString(neo4jRelationshipSchemaNode.Props, "_type"),
+			FromTypeSchemaNodeID: utils.PopString(neo4jRelationshipSchemaNode.Props, "_fromTypeSchemaNodeId"),
+			ToTypeSchemaNodeID:   utils.PopString(neo4jRelationshipSchemaNode.Props, "_toTypeSchemaNodeId"),
+			Cardinality:          utils.PopRelationshipCardinality(neo4jRelationshipSchemaNode.Props),
+			Properties:           utils.ExtractPropertiesFromNeo4jNode(neo4jRelationshipSchemaNode.Props),
+			Labels:               neo4jRelationshipSchemaNode.Labels,
+		}
+		if cardinality == nil {
+			message := fmt.Sprintf("Cardinality removed from relationship schema node %s", data.Name)
+			return &model.RelationshipSchemaNodeResponse{Success: true, Message: &message, RelationshipSchemaNode: data}, nil
+		}
+		violations, err := db.cardinalityViolations(ctx, "MATCH (relationshipSchemaNode:RELATIONSHIP_SCHEMA {_id: $id})", map[string]any{"id": id})
+		if err != nil {
+			return nil, err
+		}
+		message := fmt.Sprintf("Cardinality %s set on relationship schema node %s, %d existing object nodes do not satisfy it", data.Cardinality.Cardinality, data.Name, len(violations))
+		return &model.RelationshipSchemaNodeResponse{Success: true, Message: &message, RelationshipSchemaNode: data}, nil
+	}
+	message := fmt.Sprintf("Relationship schema node with id %s was not found", id)
+	return &model.RelationshipSchemaNodeResponse{Success: false, Message: &message, RelationshipSchemaNode: nil}, nil
+}
+
+// RelationshipCardinalityReport lists the object nodes of domain with too few or too many object relationships for
+// the cardinality of a relationship schema node of domain
+func (db *Neo4jDatabase) RelationshipCardinalityReport(ctx context.Context, domain string) (*model.CardinalityReportResponse, error) {
+	ctx, done := instrument(ctx, "RelationshipCardinalityReport")
+	defer done()
+
+	violations, err := db.cardinalityViolations(ctx, "MATCH (relationshipSchemaNode:RELATIONSHIP_SCHEMA {_domain: $domain})", map[string]any{"domain": domain})
+	if err != nil {
+		return nil, err
+	}
+	message := fmt.Sprintf("%d cardinality violations found in domain %s", len(violations), domain)
+	return &model.CardinalityReportResponse{Success: true, Message: &message, Violations: violations}, nil
+}
+
+// cardinalityViolationsQuery lists, for the relationship schema nodes matched before it, every object node of their
+// from and to types with the number of object relationships they describe on that side
+var cardinalityViolationsQuery = `
+	WITH relationshipSchemaNode
+	WHERE relationshipSchemaNode._cardinality IS NOT NULL
+	MATCH (fromTypeSchemaNode:TYPE_SCHEMA {_id: relationshipSchemaNode._fromTypeSchemaNodeId}), (toTypeSchemaNode:TYPE_SCHEMA {_id: relationshipSchemaNode._toTypeSchemaNodeId})
+	CALL {
+		WITH relationshipSchemaNode, fromTypeSchemaNode, toTypeSchemaNode
+		MATCH (objectNode)
+		WHERE ` + objectNodeOfType("objectNode", "fromTypeSchemaNode") + `
+		RETURN objectNode, "FROM" AS side, size([(objectNode)-[rel]->(other) WHERE rel._name = relationshipSchemaNode._name AND ` + objectNodeOfType("other", "toTypeSchemaNode") + ` | rel]) AS count
+		UNION
+		WITH relationshipSchemaNode, fromTypeSchemaNode, toTypeSchemaNode
+		MATCH (objectNode)
+		WHERE ` + objectNodeOfType("objectNode", "toTypeSchemaNode") + `
+		RETURN objectNode, "TO" AS side, size([(other)-[rel]->(objectNode) WHERE rel._name = relationshipSchemaNode._name AND ` + objectNodeOfType("other", "fromTypeSchemaNode") + ` | rel]) AS count
+	}
+	WITH relationshipSchemaNode, objectNode, side, count
+	WHERE (side = "FROM" AND ((relationshipSchemaNode._fromRequired AND count = 0) OR (relationshipSchemaNode._cardinality IN $outgoingCapped AND count > 1)))
+		OR (side = "TO" AND ((relationshipSchemaNode._toRequired AND count = 0) OR (relationshipSchemaNode._cardinality IN $incomingCapped AND count > 1)))
+	RETURN relationshipSchemaNode._id AS id, relationshipSchemaNode._name AS relationship, relationshipSchemaNode._cardinality AS cardinality, objectNode._id AS objectNodeId, side, count
+	ORDER BY relationship, side, objectNodeId
+`
+
+// cardinalityViolations checks the relationship schema nodes match finds against their object relationships
+func (db *Neo4jDatabase) cardinalityViolations(ctx context.Context, match string, parameters map[string]any) ([]*model.CardinalityViolation, error) {
+	session := db.newSession(ctx, neo4j.AccessModeRead)
+	defer session.Close(ctx)
+
+	outgoingCapped, incomingCapped := []string{}, []string{}
+	for _, cardinality := range model.AllCardinality {
+		outgoing, incoming := cardinalityLimits(cardinality)
+		if outgoing > 0 {
+			outgoingCapped = append(outgoingCapped, cardinality.String())
+		}
+		if incoming > 0 {
+			incomingCapped = append(incomingCapped, cardinality.String())
+		}
+	}
+	parameters["outgoingCapped"] = outgoingCapped
+	parameters["incomingCapped"] = incomingCapped
+
+	result, err := readQuery(ctx, session, match+cardinalityViolationsQuery, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	violations := []*model.CardinalityViolation{}
+	for result.Next(ctx) {
+		record := result.Record()
+		id, _, _ := neo4j.GetRecordValue[string](record, "id")
+		relationship, _, _ := neo4j.GetRecordValue[string](record, "relationship")
+		cardinality, _, _ := neo4j.GetRecordValue[string](record, "cardinality")
+		objectNodeId, _, _ := neo4j.GetRecordValue[string](record, "objectNodeId")
+		side, _, _ := neo4j.GetRecordValue[string](record, "side")
+		count, _, _ := neo4j.GetRecordValue[int64](record, "count")
+
+		direction := "outgoing"
+		if side == model.RelationshipSideTo.String() {
+			direction = "incoming"
+		}
+		message := fmt.Sprintf("object node %s has %d %s %s relationships, %s allows one", objectNodeId, count, direction, relationship, cardinality)
+		if count == 0 {
+			message = fmt.Sprintf("object node %s has no %s %s relationship, the %s side is required", objectNodeId, direction, relationship, side)
+		}
+		violations = append(violations, &model.CardinalityViolation{
+			RelationshipSchemaNodeID: id,
+			Relationship:             relationship,
+			ObjectNodeID:             objectNodeId,
+			Side:                     model.RelationshipSide(side),
+			Count:                    int(count),
+			Message:                  message,
+		})
+	}
+	return violations, result.Err()
+}
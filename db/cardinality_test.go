@@ -0,0 +1,87 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+func TestCardinalitiesLimiting(t *testing.T) {
+	if got, want := cardinalitiesLimiting(true), "['ONE_TO_ONE', 'MANY_TO_ONE']"; got != want {
+		t.Errorf("got outgoing limiting cardinalities %s, want %s", got, want)
+	}
+	if got, want := cardinalitiesLimiting(false), "['ONE_TO_ONE', 'ONE_TO_MANY']"; got != want {
+		t.Errorf("got incoming limiting cardinalities %s, want %s", got, want)
+	}
+}
+
+// counts is a row of cardinalityMatchQuery for a relationship schema node named name with cardinality
+func counts(name string, cardinality string, fromRequired bool, outgoing int64, incoming int64) *neo4j.Record {
+	relationshipSchemaNode := dbtype.Node{Props: map[string]any{"_id": "schema", "_name": name, "_cardinality": cardinality, "_fromRequired": fromRequired}}
+	return &neo4j.Record{Keys: []string{"relationshipSchemaNode", "outgoing", "incoming"}, Values: []any{relationshipSchemaNode, outgoing, incoming}}
+}
+
+func TestCreateObjectRelationshipChecksCardinalityInItsTransaction(t *testing.T) {
+	session := &fakeSession{maxAttempts: 1, runs: []func(string) ([]*neo4j.Record, error){
+		returning(),
+		returning(counts("RUNS_ON", "ONE_TO_ONE", false, 1, 0)),
+	}}
+	database := &Neo4jDatabase{Driver: &fakeDriver{session: session}}
+
+	response, err := database.CreateObjectRelationship(context.Background(), "runs on", nil, "app", "server")
+	if err != nil {
+		t.Fatalf("CreateObjectRelationship failed: %v", err)
+	}
+	if response.Success || response.Message == nil || !strings.Contains(*response.Message, "already has its RUNS_ON relationship") {
+		t.Errorf("got response %v, want the cardinality rejection", response)
+	}
+	if session.writes != 1 || session.reads != 0 || len(session.queries) != 2 {
+		t.Fatalf("ran %d queries in %d write and %d read transactions, want 2 in 1 write transaction", len(session.queries), session.writes, session.reads)
+	}
+	create := session.queries[0]
+	if strings.Index(create, "SET fromObjectNode._lock") > strings.Index(create, "WHERE NOT EXISTS") || strings.Index(create, "WHERE NOT EXISTS") > strings.Index(create, "MERGE") {
+		t.Errorf("create query %s does not lock both object nodes and guard the cardinality before merging", create)
+	}
+}
+
+func TestDeleteObjectRelationshipChecksRequiredSidesInItsTransaction(t *testing.T) {
+	session := &fakeSession{maxAttempts: 1, runs: []func(string) ([]*neo4j.Record, error){
+		returning(),
+		returning(),
+		returning(counts("RUNS_ON", "MANY_TO_MANY", true, 1, 3)),
+	}}
+	database := &Neo4jDatabase{Driver: &fakeDriver{session: session}}
+
+	response, err := database.DeleteObjectRelationship(context.Background(), "relationship")
+	if err != nil {
+		t.Fatalf("DeleteObjectRelationship failed: %v", err)
+	}
+	if response.Success || response.Message == nil || !strings.Contains(*response.Message, "is the last of its from object node") {
+		t.Errorf("got response %v, want the required side rejection", response)
+	}
+	if session.writes != 1 || session.reads != 0 {
+		t.Errorf("ran %d write and %d read transactions, want 1 write transaction", session.writes, session.reads)
+	}
+	if !strings.Contains(session.queries[1], "WHERE NOT EXISTS") {
+		t.Errorf("delete query %s does not guard the required sides", session.queries[1])
+	}
+}
+
+func TestCreateObjectRelationshipWithinCardinality(t *testing.T) {
+	created := &neo4j.Record{Keys: []string{"relationship"}, Values: []any{dbtype.Relationship{Props: map[string]any{"_id": "relationship", "_name": "RUNS_ON"}}}}
+	session := &fakeSession{maxAttempts: 1, runs: []func(string) ([]*neo4j.Record, error){
+		returning(created),
+	}}
+	database := &Neo4jDatabase{Driver: &fakeDriver{session: session}}
+
+	response, err := database.CreateObjectRelationship(context.Background(), "runs on", nil, "app", "server")
+	if err != nil {
+		t.Fatalf("CreateObjectRelationship failed: %v", err)
+	}
+	if !response.Success || response.ObjectRelationship.ID != "relationship" || len(session.queries) != 1 {
+		t.Errorf("got response %v after %d queries, want the created relationship without counting", response, len(session.queries))
+	}
+}
@@ -0,0 +1,211 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mike-jacks/neo/expression"
+	"github.com/mike-jacks/neo/model"
+	"github.com/mike-jacks/neo/utils"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// Computed properties are not stored on object nodes. Their expressions are stored on the type schema node and
+// evaluated every time object nodes of the type are read, so they always reflect the current properties and
+// object relationships. Writing a computed property on an object node is rejected.
+
+// SetComputedPropertyOnTypeSchemaNode stores the expression of a computed property of the type schema node, a nil
+// expression removes it. A computed property cannot share its key with a stored property of the type schema node.
+func (db *Neo4jDatabase) SetComputedPropertyOnTypeSchemaNode(ctx context.Context, id string, property string, source *string) (*model.TypeSchemaNodeResponse, error) {
+	ctx, done := instrument(ctx, "SetComputedPropertyOnTypeSchemaNode")
+	defer done()
+
+	property = utils.RemoveSpacesAndLowerCase(property)
+	if _, err := propertyReference("typeSchemaNode", property); err != nil {
+		message := err.Error()
+		return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
+	}
+	if source != nil {
+		if _, err := expression.Parse(*source); err != nil {
+			message := fmt.Sprintf("computed property %s: %s", property, err)
+			return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
+		}
+	}
+
+	current, err := db.GetTypeSchemaNode(ctx, id)
+	if err != nil || !current.Success {
+		return current, err
+	}
+	for _, typeProperty := range current.TypeSchemaNode.Properties {
+		if typeProperty.Key == property {
+			message := fmt.Sprintf("%s is a stored property of type schema node %s, a computed property needs its own key", property, current.TypeSchemaNode.Name)
+			return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
+		}
+	}
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
+	defer session.Close(ctx)
+
+	query := fmt.Sprintf("MATCH (typeSchemaNode:TYPE_SCHEMA {_id: $id}) SET typeSchemaNode.`%s` = $expression RETURN typeSchemaNode", utils.ComputedPropertyKey(property))
+	parameters := map[string]any{
+		"id":         id,
+		"expression": utils.DereferenceOrNilString(source),
+	}
+
+	result, err := writeQuery(ctx, session, query, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Next(ctx) {
+		record := result.Record()
+		typeSchemaNode, ok := record.Get("typeSchemaNode")
+		if !ok {
+			return nil, fmt.Errorf("failed to retrieve the typeSchemaNode")
+		}
+		neo4jTypeSchemaNode, ok := typeSchemaNode.(dbtype.Node)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type for typeSchemaNode: %T", typeSchemaNode)
+		}
+		data := &model.TypeSchemaNode{
+			ID:                     utils.PopString(neo4jTypeSchemaNode.Props, "_id"),
+			Domain:                 utils.PopString(neo4jTypeSchemaNode.Props, "_domain"),
+			Name:                   utils.PopString(neo4jTypeSchemaNode.Props, "_name"),
+			OriginalName:           utils.PopString(neo4jTypeSchemaNode.Props, "_originalName"),
+			Type:                   utils.PopString(neo4jTypeSchemaNode.Props, "_type"),
+			JSONSchemas:            utils.PopJSONSchemas(neo4jTypeSchemaNode.Props),
+			Constraints:            utils.PopPropertyConstraints(neo4jTypeSchemaNode.Props),
+			ComputedProperties:     utils.PopComputedProperties(neo4jTypeSchemaNode.Props),
+			ParentTypeSchemaNodeID: utils.PopParentTypeSchemaNodeID(neo4jTypeSchemaNode.Props),
+			Properties:             utils.ExtractPropertiesFromNeo4jNode(neo4jTypeSchemaNode.Props),
+			Labels:                 neo4jTypeSchemaNode.Labels,
+		}
+		message := fmt.Sprintf("Computed property %s set on type schema node %s", property, data.Name)
+		if source == nil {
+			message = fmt.Sprintf("Computed property %s removed from type schema node %s", property, data.Name)
+		}
+		return &model.TypeSchemaNodeResponse{Success: true, Message: &message, TypeSchemaNode: data}, nil
+	}
+	message := fmt.Sprintf("Type schema node with id %s was not found", id)
+	return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
+}
+
+// rejectComputedProperties fails on properties computed by typeSchemaNode, which are read-only
+func rejectComputedProperties(typeSchemaNode *model.TypeSchemaNode, properties []*model.PropertyInput) error {
+	computed := map[string]string{}
+	for _, property := range typeSchemaNode.ComputedProperties {
+		computed[property.Key] = property.Expression
+	}
+	for _, property := range properties {
+		if source, ok := computed[property.Key]; ok {
+			return fmt.Errorf("property %s is computed from %s and read-only", property.Key, source)
+		}
+	}
+	return nil
+}
+
+type computedProperty struct {
+	key        string
+	expression *expression.Expression
+}
+
+// addComputedProperties evaluates the computed properties of the type schema nodes of objectNodes, and of their
+// ancestors, and adds them to their properties, marked read-only. A computed property that is null or fails on an object node is left out.
+func (db *Neo4jDatabase) addComputedProperties(ctx context.Context, objectNodes ...*model.ObjectNode) error {
+	type typeKey struct{ domain, name string }
+	computedByType := map[typeKey][]*computedProperty{}
+	countsRelationships := false
+	ids := []string{}
+	for _, objectNode := range objectNodes {
+		key := typeKey{objectNode.Domain, utils.RemoveSpacesAndUpperCase(objectNode.Type)}
+		if _, ok := computedByType[key]; !ok {
+			chain, err := db.typeSchemaNodeChain(ctx, key.domain, key.name)
+			if err != nil {
+				return err
+			}
+			computedByType[key] = []*computedProperty{}
+			seen := map[string]bool{}
+			for _, typeSchemaNode := range chain {
+				for _, property := range typeSchemaNode.ComputedProperties {
+					if seen[property.Key] {
+						continue
+					}
+					seen[property.Key] = true
+					parsed, err := expression.Parse(property.Expression)
+					if err != nil {
+						continue
+					}
+					countsRelationships = countsRelationships || len(parsed.Outgoing()) > 0 || len(parsed.Incoming()) > 0
+					computedByType[key] = append(computedByType[key], &computedProperty{key: property.Key, expression: parsed})
+				}
+			}
+		}
+		ids = append(ids, objectNode.ID)
+	}
+
+	outgoing, incoming := map[string]map[string]int64{}, map[string]map[string]int64{}
+	if countsRelationships {
+		var err error
+		if outgoing, err = db.relationshipCounts(ctx, "MATCH (objectNode)-[relationship]->()", ids); err != nil {
+			return err
+		}
+		if incoming, err = db.relationshipCounts(ctx, "MATCH (objectNode)<-[relationship]-()", ids); err != nil {
+			return err
+		}
+	}
+
+	for _, objectNode := range objectNodes {
+		computed := computedByType[typeKey{objectNode.Domain, utils.RemoveSpacesAndUpperCase(objectNode.Type)}]
+		if len(computed) == 0 {
+			continue
+		}
+		environment := expression.Environment{
+			Properties: map[string]any{},
+			Outgoing:   outgoing[objectNode.ID],
+			Incoming:   incoming[objectNode.ID],
+		}
+		for _, property := range objectNode.Properties {
+			environment.Properties[property.Key] = property.Value
+		}
+		for _, property := range computed {
+			if _, stored := environment.Properties[property.key]; stored {
+				continue
+			}
+			value, err := property.expression.Evaluate(environment)
+			if err != nil || value == nil {
+				continue
+			}
+			for _, extracted := range utils.ExtractPropertiesFromNeo4jNode(map[string]any{property.key: value}) {
+				extracted.ReadOnly = true
+				objectNode.Properties = append(objectNode.Properties, extracted)
+			}
+		}
+	}
+	return nil
+}
+
+// relationshipCounts counts the object relationships match finds for each of the object nodes ids by name
+func (db *Neo4jDatabase) relationshipCounts(ctx context.Context, match string, ids []string) (map[string]map[string]int64, error) {
+	session := db.newSession(ctx, neo4j.AccessModeRead)
+	defer session.Close(ctx)
+
+	query := match + " WHERE objectNode._id IN $ids RETURN objectNode._id AS id, type(relationship) AS name, count(relationship) AS count"
+	result, err := readQuery(ctx, session, query, map[string]any{"ids": ids})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]map[string]int64{}
+	for result.Next(ctx) {
+		record := result.Record()
+		id, _, _ := neo4j.GetRecordValue[string](record, "id")
+		name, _, _ := neo4j.GetRecordValue[string](record, "name")
+		count, _, _ := neo4j.GetRecordValue[int64](record, "count")
+		if counts[id] == nil {
+			counts[id] = map[string]int64{}
+		}
+		counts[id][name] = count
+	}
+	return counts, result.Err()
+}
@@ -19,9 +19,12 @@ type Database interface {
 
 	UpdatePropertiesOnObjectNode(ctx context.Context, id string, properties []*model.PropertyInput) (*model.ObjectNodeResponse, error)
 	RemovePropertiesFromObjectNode(ctx context.Context, id string, properties []string) (*model.ObjectNodeResponse, error)
+	UpdateObjectNode(ctx context.Context, id string, newName *string, properties []*model.PropertyInput, removedProperties []string) (*model.ObjectNodeResponse, error)
 
 	GetObjectNode(ctx context.Context, id string) (*model.ObjectNodeResponse, error)
-	GetObjectNodes(ctx context.Context, domain *string, typeArg *string) (*model.ObjectNodesResponse, error)
+	GetObjectNodes(ctx context.Context, domain *string, typeArg *string, where []*model.PropertyFilter, orderBy []*model.PropertySort) (*model.ObjectNodesResponse, error)
+	ObjectNodesWithinDistance(ctx context.Context, domain string, typeArg string, property string, center model.PointInput, meters float64) (*model.ObjectNodesResponse, error)
+	ObjectNodesInBoundingBox(ctx context.Context, domain string, typeArg string, property string, lowerLeft model.PointInput, upperRight model.PointInput) (*model.ObjectNodesResponse, error)
 
 	CreateObjectRelationship(ctx context.Context, name string, properties []*model.PropertyInput, fromObjectNodeId string, toObjectNodeId string) (*model.ObjectRelationshipResponse, error)
 	DeleteObjectRelationship(ctx context.Context, id string) (*model.ObjectRelationshipResponse, error)
@@ -35,16 +38,21 @@ type Database interface {
 
 	CreateDomainSchemaNode(ctx context.Context, domain string) (*model.DomainSchemaNodeResponse, error)
 	RenameDomainSchemaNode(ctx context.Context, id string, newName string) (*model.DomainSchemaNodeResponse, error)
-	DeleteDomainSchemaNode(ctx context.Context, id string) (*model.DomainSchemaNodeResponse, error)
+	DeleteDomainSchemaNode(ctx context.Context, id string, crossDomain model.CrossDomainRule) (*model.DomainSchemaNodeResponse, error)
 	
 	GetDomainSchemaNodes(ctx context.Context) (*model.DomainSchemaNodesResponse, error)
 	GetDomainSchemaNode(ctx context.Context, id string) (*model.DomainSchemaNodeResponse, error)
+	GetDomainExternalDependencies(ctx context.Context, domain string) (*model.DomainDependenciesResponse, error)
 
 	CreateTypeSchemaNode(ctx context.Context, domain string, name string) (*model.TypeSchemaNodeResponse, error)
 	RenameTypeSchemaNode(ctx context.Context, id string, newName string) (*model.TypeSchemaNodeResponse, error)
 	UpdatePropertiesOnTypeSchemaNode(ctx context.Context, id string, properties []*model.PropertyInput) (*model.TypeSchemaNodeResponse, error)
-	RenamePropertyOnTypeSchemaNode(ctx context.Context, id string, oldPropertyName string, newPropertyName string) (*model.TypeSchemaNodeResponse, error)
-	RemovePropertiesFromTypeSchemaNode(ctx context.Context, id string, properties []string) (*model.TypeSchemaNodeResponse, error)
+	RenamePropertyOnTypeSchemaNode(ctx context.Context, id string, oldPropertyName string, newPropertyName string, propagate bool) (*model.TypeSchemaNodeResponse, error)
+	RemovePropertiesFromTypeSchemaNode(ctx context.Context, id string, properties []string, propagate bool) (*model.TypeSchemaNodeResponse, error)
+	SetJSONSchemaOnTypeSchemaNode(ctx context.Context, id string, property string, schema *string) (*model.TypeSchemaNodeResponse, error)
+	SetPropertyConstraintOnTypeSchemaNode(ctx context.Context, id string, property string, constraint *model.PropertyConstraintInput) (*model.TypeSchemaNodeResponse, error)
+	SetComputedPropertyOnTypeSchemaNode(ctx context.Context, id string, property string, expression *string) (*model.TypeSchemaNodeResponse, error)
+	SetParentOnTypeSchemaNode(ctx context.Context, id string, parentTypeSchemaNodeId *string) (*model.TypeSchemaNodeResponse, error)
 	DeleteTypeSchemaNode(ctx context.Context, id string) (*model.TypeSchemaNodeResponse, error)
 
 	GetTypeSchemaNodes(ctx context.Context, domain *string) (*model.TypeSchemaNodesResponse, error)
@@ -54,12 +62,19 @@ type Database interface {
 
 	GetRelationshipSchemaNode(ctx context.Context, id string) (*model.RelationshipSchemaNodeResponse, error)
 	GetRelationshipSchemaNodes(ctx context.Context, domain *string) (*model.RelationshipSchemaNodesResponse, error)
+	RelationshipCardinalityReport(ctx context.Context, domain string) (*model.CardinalityReportResponse, error)
 
 	CreateRelationshipSchemaNode(ctx context.Context, name string, domain string, fromTypeSchemaNodeId string, toTypeSchemaNodeId string) (*model.RelationshipSchemaNodeResponse, error)
 	RenameRelationshipSchemaNode(ctx context.Context, id string, newName string) (*model.RelationshipSchemaNodeResponse, error)
 	UpdatePropertiesOnRelationshipSchemaNode(ctx context.Context, id string, properties []*model.PropertyInput) (*model.RelationshipSchemaNodeResponse, error)
-	RenamePropertyOnRelationshipSchemaNode(ctx context.Context, id string, oldPropertyName string, newPropertyName string) (*model.RelationshipSchemaNodeResponse, error)
-	RemovePropertiesFromRelationshipSchemaNode(ctx context.Context, id string, properties []string) (*model.RelationshipSchemaNodeResponse, error)
+	RenamePropertyOnRelationshipSchemaNode(ctx context.Context, id string, oldPropertyName string, newPropertyName string, propagate bool) (*model.RelationshipSchemaNodeResponse, error)
+	RemovePropertiesFromRelationshipSchemaNode(ctx context.Context, id string, properties []string, propagate bool) (*model.RelationshipSchemaNodeResponse, error)
+	SetCardinalityOnRelationshipSchemaNode(ctx context.Context, id string, cardinality *model.RelationshipCardinalityInput) (*model.RelationshipSchemaNodeResponse, error)
 	DeleteRelationshipSchemaNode(ctx context.Context, id string) (*model.RelationshipSchemaNodeResponse, error)
 
+	GetDomainObjectCounts(ctx context.Context, domain string) (*DomainObjectCounts, error)
+
+	GetIndexes(ctx context.Context) (*model.IndexesResponse, error)
+	RebuildConstraints(ctx context.Context) (*model.IndexesResponse, error)
+
 }
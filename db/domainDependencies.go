@@ -0,0 +1,179 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mike-jacks/neo/model"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// A relationship schema node may connect a type schema node of its domain with one of another domain, and object
+// relationships may connect object nodes of different domains. Both refer to their ends by id, so renaming either
+// domain keeps them. Deleting a domain follows a model.CrossDomainRule: RESTRICT refuses while the domain has external
+// dependencies, CASCADE deletes them with it.
+
+// crossDomainRelationshipSchemaNodesQuery matches, as relationshipSchemaNode, the relationship schema nodes connecting a
+// type schema node of $domain with a type schema node of another domain
+const crossDomainRelationshipSchemaNodesQuery = `
+	MATCH (relationshipSchemaNode:RELATIONSHIP_SCHEMA)
+	MATCH (fromTypeSchemaNode:TYPE_SCHEMA {_id: relationshipSchemaNode._fromTypeSchemaNodeId}), (toTypeSchemaNode:TYPE_SCHEMA {_id: relationshipSchemaNode._toTypeSchemaNodeId})
+	WHERE fromTypeSchemaNode._domain <> toTypeSchemaNode._domain AND $domain IN [fromTypeSchemaNode._domain, toTypeSchemaNode._domain]
+`
+
+// relationshipSchemaNodeDomains returns the domains of the type schema nodes a relationship schema node is about to
+// connect, empty for a type schema node that does not exist
+func (db *Neo4jDatabase) relationshipSchemaNodeDomains(ctx context.Context, fromTypeSchemaNodeId string, toTypeSchemaNodeId string) (string, string, error) {
+	session := db.newSession(ctx, neo4j.AccessModeRead)
+	defer session.Close(ctx)
+
+	query := `
+		OPTIONAL MATCH (fromTypeSchemaNode:TYPE_SCHEMA {_id: $fromTypeSchemaNodeId})
+		OPTIONAL MATCH (toTypeSchemaNode:TYPE_SCHEMA {_id: $toTypeSchemaNodeId})
+		RETURN fromTypeSchemaNode._domain AS fromDomain, toTypeSchemaNode._domain AS toDomain
+	`
+	parameters := map[string]any{
+		"fromTypeSchemaNodeId": fromTypeSchemaNodeId,
+		"toTypeSchemaNodeId":   toTypeSchemaNodeId,
+	}
+	result, err := readQuery(ctx, session, query, parameters)
+	if err != nil {
+		return "", "", err
+	}
+	if !result.Next(ctx) {
+		return "", "", result.Err()
+	}
+	fromDomain, _, _ := neo4j.GetRecordValue[string](result.Record(), "fromDomain")
+	toDomain, _, _ := neo4j.GetRecordValue[string](result.Record(), "toDomain")
+	return fromDomain, toDomain, nil
+}
+
+// GetDomainExternalDependencies lists, per direction, other domain and name, the object relationships connecting
+// domain with other domains and the cross-domain relationship schema nodes describing them
+func (db *Neo4jDatabase) GetDomainExternalDependencies(ctx context.Context, domain string) (*model.DomainDependenciesResponse, error) {
+	ctx, done := instrument(ctx, "GetDomainExternalDependencies")
+	defer done()
+
+	domain = strings.TrimSpace(domain)
+	dependencies, err := db.domainDependencies(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+	message := fmt.Sprintf("%d external dependencies found for domain %s", len(dependencies), domain)
+	return &model.DomainDependenciesResponse{Success: true, Message: &message, Dependencies: dependencies}, nil
+}
+
+func (db *Neo4jDatabase) domainDependencies(ctx context.Context, domain string) ([]*model.DomainDependency, error) {
+	session := db.newSession(ctx, neo4j.AccessModeRead)
+	defer session.Close(ctx)
+
+	parameters := map[string]any{
+		"domain": domain,
+	}
+
+	schemaQuery := crossDomainRelationshipSchemaNodesQuery + `
+		OPTIONAL MATCH (fromObjectNode)-[rel {_name: relationshipSchemaNode._name}]->(toObjectNode)
+		WHERE ` + objectNodeOfType("fromObjectNode", "fromTypeSchemaNode") + ` AND ` + objectNodeOfType("toObjectNode", "toTypeSchemaNode") + `
+		RETURN relationshipSchemaNode._id AS id, relationshipSchemaNode._name AS relationship,
+			CASE WHEN fromTypeSchemaNode._domain = $domain THEN "OUTGOING" ELSE "INCOMING" END AS direction,
+			CASE WHEN fromTypeSchemaNode._domain = $domain THEN toTypeSchemaNode._domain ELSE fromTypeSchemaNode._domain END AS domain,
+			count(rel) AS count
+	`
+	linkQuery := `
+		MATCH (objectNode {_domain: $domain})-[rel]-(other)
+		WHERE other._domain <> $domain AND NOT objectNode:RELATIONSHIP_SCHEMA AND NOT objectNode:DOMAIN_SCHEMA AND NOT objectNode:TYPE_SCHEMA
+		RETURN rel._name AS relationship,
+			CASE WHEN startNode(rel) = objectNode THEN "OUTGOING" ELSE "INCOMING" END AS direction,
+			other._domain AS domain,
+			count(DISTINCT rel) AS count
+	`
+
+	type dependencyKey struct{ direction, domain, relationship string }
+	described := map[dependencyKey]int64{}
+	dependencies := []*model.DomainDependency{}
+
+	result, err := readQuery(ctx, session, schemaQuery, parameters)
+	if err != nil {
+		return nil, err
+	}
+	for result.Next(ctx) {
+		record := result.Record()
+		id, _, _ := neo4j.GetRecordValue[string](record, "id")
+		dependency := dependencyOf(record)
+		dependency.RelationshipSchemaNodeID = &id
+		described[dependencyKey{dependency.Direction.String(), dependency.Domain, dependency.Relationship}] += int64(dependency.ObjectRelationshipCount)
+		dependencies = append(dependencies, dependency)
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	// Object relationships no cross-domain relationship schema node describes are dependencies of their own
+	result, err = readQuery(ctx, session, linkQuery, parameters)
+	if err != nil {
+		return nil, err
+	}
+	for result.Next(ctx) {
+		dependency := dependencyOf(result.Record())
+		undescribed := int64(dependency.ObjectRelationshipCount) - described[dependencyKey{dependency.Direction.String(), dependency.Domain, dependency.Relationship}]
+		if undescribed <= 0 {
+			continue
+		}
+		dependency.ObjectRelationshipCount = int(undescribed)
+		dependencies = append(dependencies, dependency)
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+
+	sort.SliceStable(dependencies, func(i, j int) bool {
+		a, b := dependencies[i], dependencies[j]
+		if a.Direction != b.Direction {
+			return a.Direction < b.Direction
+		}
+		if a.Domain != b.Domain {
+			return a.Domain < b.Domain
+		}
+		return a.Relationship < b.Relationship
+	})
+	return dependencies, nil
+}
+
+// dependencyOf reads the direction, domain, relationship and count of a dependency row
+func dependencyOf(record *neo4j.Record) *model.DomainDependency {
+	direction, _, _ := neo4j.GetRecordValue[string](record, "direction")
+	domain, _, _ := neo4j.GetRecordValue[string](record, "domain")
+	relationship, _, _ := neo4j.GetRecordValue[string](record, "relationship")
+	count, _, _ := neo4j.GetRecordValue[int64](record, "count")
+	return &model.DomainDependency{
+		Direction:               model.DependencyDirection(direction),
+		Domain:                  domain,
+		Relationship:            relationship,
+		ObjectRelationshipCount: int(count),
+	}
+}
+
+// deleteExternalDependencies deletes the relationship schema nodes of other domains connecting to domain, and clears
+// the RELATIONSHIP properties of object nodes of other domains referencing object nodes of domain. The object
+// relationships connecting domain to other domains go with its object nodes.
+func (db *Neo4jDatabase) deleteExternalDependencies(ctx context.Context, tx neo4j.ManagedTransaction, domain string) error {
+	keysQuery := `
+		MATCH (source)-[reference]->(target {_domain: $domain})
+		WHERE source._domain <> $domain AND reference._referenceKey IS NOT NULL
+		RETURN DISTINCT reference._referenceKey AS key
+	`
+	sourcesMatch := `MATCH (source)-[reference {_referenceKey: $key}]->(target {_domain: $domain}) WHERE source._domain <> $domain`
+	if err := db.clearReferenceProperties(ctx, tx, keysQuery, sourcesMatch, map[string]any{"domain": domain}); err != nil {
+		return err
+	}
+
+	query := crossDomainRelationshipSchemaNodesQuery + `
+		WITH relationshipSchemaNode
+		WHERE relationshipSchemaNode._domain <> $domain
+		DETACH DELETE relationshipSchemaNode
+	`
+	_, err := transactionQuery(ctx, tx, query, map[string]any{"domain": domain})
+	return err
+}
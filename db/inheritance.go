@@ -0,0 +1,316 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mike-jacks/neo/model"
+	"github.com/mike-jacks/neo/utils"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// A type schema node may extend a parent type schema node of the same domain. Its object nodes are object nodes of
+// every ancestor too: they carry the labels of the ancestors next to their own, are validated against the properties,
+// constraints and computed properties of the ancestors, and fit the relationship schemas of the ancestors.
+//
+// Which types an object node is of is decided by its type and the ancestor names stored in utils.SupertypesKey, never
+// by its labels: labels are sanitized, so they cannot be compared with type names, and AddLabelsOnObjectNode adds
+// labels of any name.
+
+// objectNodeOfType is the Cypher condition objectNode meets when it is an object node of typeSchemaNode, of its type
+// or of a subtype. Every query matching object nodes to type schema nodes goes through it.
+func objectNodeOfType(objectNode string, typeSchemaNode string) string {
+	return fmt.Sprintf("(%[1]s._domain = %[2]s._domain AND (replace(%[1]s._type, ' ', '_') = %[2]s._name OR %[2]s._name IN coalesce(%[1]s.%[3]s, [])))", objectNode, typeSchemaNode, utils.SupertypesKey)
+}
+
+// hasType tells whether an object node of objectType with the ancestor types supertypes is an object node of typeName,
+// the Go counterpart of objectNodeOfType
+func hasType(objectType string, supertypes []string, typeName string) bool {
+	name := utils.RemoveSpacesAndUpperCase(typeName)
+	return utils.RemoveSpacesAndUpperCase(objectType) == name || slices.Contains(supertypes, name)
+}
+
+// TypeSchemaNodeAncestors returns the parent of typeSchemaNode among types, the parent of the parent and so on
+func TypeSchemaNodeAncestors(types []*model.TypeSchemaNode, typeSchemaNode *model.TypeSchemaNode) []*model.TypeSchemaNode {
+	byID := map[string]*model.TypeSchemaNode{}
+	for _, t := range types {
+		byID[t.ID] = t
+	}
+	ancestors := []*model.TypeSchemaNode{}
+	seen := map[string]bool{typeSchemaNode.ID: true}
+	for parentID := typeSchemaNode.ParentTypeSchemaNodeID; parentID != nil && !seen[*parentID]; {
+		parent, ok := byID[*parentID]
+		if !ok {
+			break
+		}
+		seen[parent.ID] = true
+		ancestors = append(ancestors, parent)
+		parentID = parent.ParentTypeSchemaNodeID
+	}
+	return ancestors
+}
+
+// typeSchemaNodeChain returns the type schema node of the object nodes of domain and typeName followed by its
+// ancestors, empty when there is none
+func (db *Neo4jDatabase) typeSchemaNodeChain(ctx context.Context, domain string, typeName string) ([]*model.TypeSchemaNode, error) {
+	typeSchemaNode, err := db.typeSchemaNodeOf(ctx, domain, typeName)
+	if err != nil || typeSchemaNode == nil {
+		return []*model.TypeSchemaNode{}, err
+	}
+	if typeSchemaNode.ParentTypeSchemaNodeID == nil {
+		return []*model.TypeSchemaNode{typeSchemaNode}, nil
+	}
+	types, err := db.GetTypeSchemaNodes(ctx, &typeSchemaNode.Domain)
+	if err != nil {
+		return nil, err
+	}
+	return append([]*model.TypeSchemaNode{typeSchemaNode}, TypeSchemaNodeAncestors(types.TypeSchemaNodes, typeSchemaNode)...), nil
+}
+
+// supertypes returns the names of the ancestors of the type of the object nodes of domain and typeName, which
+// they store in utils.SupertypesKey and carry the labels of
+func (db *Neo4jDatabase) supertypes(ctx context.Context, domain string, typeName string) ([]string, error) {
+	chain, err := db.typeSchemaNodeChain(ctx, domain, typeName)
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for i := 1; i < len(chain); i++ {
+		names = append(names, chain[i].Name)
+	}
+	return names, nil
+}
+
+// typeNames returns the names of types
+func typeNames(types []*model.TypeSchemaNode) []string {
+	names := []string{}
+	for _, t := range types {
+		names = append(names, t.Name)
+	}
+	return names
+}
+
+// backfillSupertypes stores the ancestor names of the object nodes of subtypes which do not have them yet, those
+// created before they were stored
+func (db *Neo4jDatabase) backfillSupertypes(ctx context.Context, session neo4j.SessionWithContext, types []*model.TypeSchemaNode) error {
+	query := fmt.Sprintf(`
+		MATCH (objectNode {_domain: $domain})
+		WHERE replace(objectNode._type, ' ', '_') = $type AND objectNode.%[1]s IS NULL
+			AND NOT objectNode:RELATIONSHIP_SCHEMA AND NOT objectNode:DOMAIN_SCHEMA AND NOT objectNode:TYPE_SCHEMA
+		SET objectNode.%[1]s = $supertypes
+	`, utils.SupertypesKey)
+	for _, typeSchemaNode := range types {
+		ancestors := TypeSchemaNodeAncestors(types, typeSchemaNode)
+		if len(ancestors) == 0 {
+			continue
+		}
+		parameters := map[string]any{
+			"domain":     typeSchemaNode.Domain,
+			"type":       typeSchemaNode.Name,
+			"supertypes": typeNames(ancestors),
+		}
+		if _, err := writeQuery(ctx, session, query, parameters); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// subtypes returns the type schema nodes among types extending typeSchemaNode, directly or not
+func subtypes(types []*model.TypeSchemaNode, typeSchemaNode *model.TypeSchemaNode) []*model.TypeSchemaNode {
+	result := []*model.TypeSchemaNode{}
+	for _, t := range types {
+		for _, ancestor := range TypeSchemaNodeAncestors(types, t) {
+			if ancestor.ID == typeSchemaNode.ID {
+				result = append(result, t)
+				break
+			}
+		}
+	}
+	return result
+}
+
+// SetParentOnTypeSchemaNode makes the type schema node extend the parent type schema node of the same domain, a nil
+// parent removes it. Object nodes of the type and of its subtypes trade the labels of the previous ancestors for
+// those of the new ones.
+func (db *Neo4jDatabase) SetParentOnTypeSchemaNode(ctx context.Context, id string, parentTypeSchemaNodeId *string) (*model.TypeSchemaNodeResponse, error) {
+	ctx, done := instrument(ctx, "SetParentOnTypeSchemaNode")
+	defer done()
+
+	current, err := db.GetTypeSchemaNode(ctx, id)
+	if err != nil || !current.Success {
+		return current, err
+	}
+	typeSchemaNode := current.TypeSchemaNode
+	types, err := db.GetTypeSchemaNodes(ctx, &typeSchemaNode.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	previousAncestors := TypeSchemaNodeAncestors(types.TypeSchemaNodes, typeSchemaNode)
+	previousLabels := []string{}
+	for _, ancestor := range previousAncestors {
+		previousLabels = append(previousLabels, typeLabel(ancestor.Name))
+	}
+	labels := []string{}
+	ancestors := []*model.TypeSchemaNode{}
+	var parent *model.TypeSchemaNode
+	if parentTypeSchemaNodeId != nil {
+		for _, t := range types.TypeSchemaNodes {
+			if t.ID == *parentTypeSchemaNodeId {
+				parent = t
+			}
+		}
+		if parent == nil || parent.ID == typeSchemaNode.ID {
+			message := fmt.Sprintf("Type schema node with id %s was not found in domain %s", *parentTypeSchemaNodeId, typeSchemaNode.Domain)
+			return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
+		}
+		for _, t := range append([]*model.TypeSchemaNode{parent}, TypeSchemaNodeAncestors(types.TypeSchemaNodes, parent)...) {
+			if t.ID == typeSchemaNode.ID {
+				message := fmt.Sprintf("Type schema node %s cannot extend %s, which already extends it", typeSchemaNode.Name, parent.Name)
+				return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
+			}
+			labels = append(labels, typeLabel(t.Name))
+			ancestors = append(ancestors, t)
+		}
+	}
+	if err := db.ensureLabelConstraints(ctx, labels...); err != nil {
+		return nil, err
+	}
+
+	objectTypes := append([]string{typeSchemaNode.Name}, typeNames(subtypes(types.TypeSchemaNodes, typeSchemaNode))...)
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
+	defer session.Close(ctx)
+
+	query := fmt.Sprintf(`
+		MATCH (typeSchemaNode:TYPE_SCHEMA {_id: $id})
+		SET typeSchemaNode.%s = $parentTypeSchemaNodeId
+		WITH typeSchemaNode
+		OPTIONAL MATCH (objectNode {_domain: typeSchemaNode._domain})
+		WHERE replace(objectNode._type, ' ', '_') IN $types AND NOT objectNode:RELATIONSHIP_SCHEMA AND NOT objectNode:DOMAIN_SCHEMA AND NOT objectNode:TYPE_SCHEMA
+		SET objectNode.%[2]s = [name IN coalesce(objectNode.%[2]s, []) WHERE NOT name IN $previousAncestors] + $ancestors
+	`, utils.ParentTypeSchemaNodeIDKey, utils.SupertypesKey)
+	if len(previousLabels) > 0 {
+		query += " REMOVE objectNode" + labelsQuery(previousLabels)
+	}
+	if len(labels) > 0 {
+		query += " SET objectNode" + labelsQuery(labels)
+	}
+	query += " RETURN typeSchemaNode, count(objectNode) AS updatedCount"
+
+	parameters := map[string]any{
+		"id":                     id,
+		"parentTypeSchemaNodeId": utils.DereferenceOrNilString(parentTypeSchemaNodeId),
+		"types":                  objectTypes,
+		"previousAncestors":      typeNames(previousAncestors),
+		"ancestors":              typeNames(ancestors),
+	}
+
+	result, err := writeQuery(ctx, session, query, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Next(ctx) {
+		record := result.Record()
+		typeSchemaNode, ok := record.Get("typeSchemaNode")
+		if !ok {
+			return nil, fmt.Errorf("failed to retrieve the typeSchemaNode")
+		}
+		neo4jTypeSchemaNode, ok := typeSchemaNode.(dbtype.Node)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type for typeSchemaNode: %T", typeSchemaNode)
+		}
+		updatedCount, _, err := neo4j.GetRecordValue[int64](record, "updatedCount")
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the updatedCount")
+		}
+		data := &model.TypeSchemaNode{
+			ID:                     utils.PopString(neo4jTypeSchemaNode.Props, "_id"),
+			Domain:                 utils.PopString(neo4jTypeSchemaNode.Props, "_domain"),
+			Name:                   utils.PopString(neo4jTypeSchemaNode.Props, "_name"),
+			OriginalName:           utils.PopString(neo4jTypeSchemaNode.Props, "_originalName"),
+			Type:                   utils.PopString(neo4jTypeSchemaNode.Props, "_type"),
+			JSONSchemas:            utils.PopJSONSchemas(neo4jTypeSchemaNode.Props),
+			Constraints:            utils.PopPropertyConstraints(neo4jTypeSchemaNode.Props),
+			ComputedProperties:     utils.PopComputedProperties(neo4jTypeSchemaNode.Props),
+			ParentTypeSchemaNodeID: utils.PopParentTypeSchemaNodeID(neo4jTypeSchemaNode.Props),
+			Properties:             utils.ExtractPropertiesFromNeo4jNode(neo4jTypeSchemaNode.Props),
+			Labels:                 neo4jTypeSchemaNode.Labels,
+		}
+		message := fmt.Sprintf("Type schema node %s no longer extends a parent, %d object nodes updated", data.Name, updatedCount)
+		if parent != nil {
+			message = fmt.Sprintf("Type schema node %s now extends %s, %d object nodes updated", data.Name, parent.Name, updatedCount)
+		}
+		return &model.TypeSchemaNodeResponse{Success: true, Message: &message, TypeSchemaNode: data}, nil
+	}
+	if result.Err() != nil {
+		return nil, result.Err()
+	}
+	message := fmt.Sprintf("Type schema node with id %s was not found", id)
+	return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
+}
+
+// relabelSubtypes renames the ancestor previousName of the object nodes of the subtypes of typeSchemaNode, and moves
+// them to its new label, after typeSchemaNode was renamed
+func (db *Neo4jDatabase) relabelSubtypes(ctx context.Context, session neo4j.SessionWithContext, typeSchemaNode *model.TypeSchemaNode, previousName string) error {
+	types, err := db.GetTypeSchemaNodes(ctx, &typeSchemaNode.Domain)
+	if err != nil {
+		return err
+	}
+	objectTypes := typeNames(subtypes(types.TypeSchemaNodes, typeSchemaNode))
+	if len(objectTypes) == 0 {
+		return nil
+	}
+
+	query := fmt.Sprintf(`
+		MATCH (objectNode {_domain: $domain})
+		WHERE replace(objectNode._type, ' ', '_') IN $types AND $previousName IN objectNode.%[1]s
+		SET objectNode.%[1]s = [name IN objectNode.%[1]s | CASE name WHEN $previousName THEN $name ELSE name END]
+	`, utils.SupertypesKey)
+	if previousLabel, label := typeLabel(previousName), typeLabel(typeSchemaNode.Name); previousLabel != label {
+		query += fmt.Sprintf(" REMOVE objectNode:`%s` SET objectNode:`%s`", previousLabel, label)
+	}
+	parameters := map[string]any{
+		"domain":       typeSchemaNode.Domain,
+		"types":        objectTypes,
+		"previousName": previousName,
+		"name":         typeSchemaNode.Name,
+	}
+	result, err := writeQuery(ctx, session, query, parameters)
+	if err != nil {
+		return err
+	}
+	return result.Err()
+}
+
+// subtypeNames returns the names of the type schema nodes extending the type schema node id directly
+func (db *Neo4jDatabase) subtypeNames(ctx context.Context, id string) ([]string, error) {
+	session := db.newSession(ctx, neo4j.AccessModeRead)
+	defer session.Close(ctx)
+
+	query := fmt.Sprintf("MATCH (subtype:TYPE_SCHEMA {%s: $id}) RETURN subtype._name AS name ORDER BY name", utils.ParentTypeSchemaNodeIDKey)
+	result, err := readQuery(ctx, session, query, map[string]any{"id": id})
+	if err != nil {
+		return nil, err
+	}
+	names := []string{}
+	for result.Next(ctx) {
+		name, _, _ := neo4j.GetRecordValue[string](result.Record(), "name")
+		names = append(names, name)
+	}
+	return names, result.Err()
+}
+
+// labelsQuery returns labels as a Cypher label expression, :`A`:`B`
+func labelsQuery(labels []string) string {
+	var b strings.Builder
+	for _, label := range labels {
+		b.WriteString(":`" + label + "`")
+	}
+	return b.String()
+}
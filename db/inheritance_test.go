@@ -0,0 +1,76 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mike-jacks/neo/model"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestHasType(t *testing.T) {
+	tests := []struct {
+		objectType string
+		supertypes []string
+		typeName   string
+		want       bool
+	}{
+		{"SERVER", nil, "server", true},
+		{"WEB SERVER", nil, "web server", true},
+		{"WEB SERVER", nil, "WEB_SERVER", true},
+		{"NGINX", []string{"WEB-SERVER", "SERVER"}, "web-server", true},
+		{"NGINX", []string{"WEB-SERVER", "SERVER"}, "server", true},
+		{"NGINX", []string{"WEB-SERVER"}, "WEB_SERVER", false},
+		{"NGINX", nil, "server", false},
+	}
+	for _, test := range tests {
+		if got := hasType(test.objectType, test.supertypes, test.typeName); got != test.want {
+			t.Errorf("hasType(%q, %v, %q) = %v, want %v", test.objectType, test.supertypes, test.typeName, got, test.want)
+		}
+	}
+}
+
+func TestTypeSchemaNodeAncestors(t *testing.T) {
+	server := &model.TypeSchemaNode{ID: "server", Name: "SERVER"}
+	web := &model.TypeSchemaNode{ID: "web", Name: "WEB-SERVER", ParentTypeSchemaNodeID: &server.ID}
+	nginx := &model.TypeSchemaNode{ID: "nginx", Name: "NGINX", ParentTypeSchemaNodeID: &web.ID}
+	types := []*model.TypeSchemaNode{nginx, web, server}
+
+	if got := typeNames(TypeSchemaNodeAncestors(types, nginx)); strings.Join(got, ",") != "WEB-SERVER,SERVER" {
+		t.Errorf("got ancestors %v, want WEB-SERVER and SERVER", got)
+	}
+	if got := typeNames(subtypes(types, server)); strings.Join(got, ",") != "NGINX,WEB-SERVER" {
+		t.Errorf("got subtypes %v, want NGINX and WEB-SERVER", got)
+	}
+}
+
+func TestGetObjectNodesMatchesSubtypesBySupertypesNotLabels(t *testing.T) {
+	session := &fakeSession{maxAttempts: 1, runs: []func(string) ([]*neo4j.Record, error){
+		returning(),
+	}}
+	database := &Neo4jDatabase{Driver: &fakeDriver{session: session}}
+
+	domain, typeArg := "infra", " web-server "
+	response, err := database.GetObjectNodes(context.Background(), &domain, &typeArg, nil, nil)
+	if err != nil || !response.Success {
+		t.Fatalf("GetObjectNodes failed: %v %v", response, err)
+	}
+	query := session.queries[0]
+	if !strings.Contains(query, "$typeName IN coalesce(objectNode._supertypes, [])") {
+		t.Errorf("query %s does not match subtypes by their supertypes", query)
+	}
+	if strings.Contains(query, "objectNode:`") {
+		t.Errorf("query %s matches object nodes by a label", query)
+	}
+	if typeName := session.parameters[0]["typeName"]; typeName != "WEB-SERVER" {
+		t.Errorf("got typeName %v, want the type schema node name WEB-SERVER", typeName)
+	}
+}
+
+func TestObjectNodeOfTypeComparesNames(t *testing.T) {
+	condition := objectNodeOfType("objectNode", "typeSchemaNode")
+	if strings.Contains(condition, "labels(") || !strings.Contains(condition, "typeSchemaNode._name IN coalesce(objectNode._supertypes, [])") {
+		t.Errorf("got condition %s, want type names compared with the stored supertypes", condition)
+	}
+}
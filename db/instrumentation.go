@@ -0,0 +1,146 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/mike-jacks/neo/logging"
+	"github.com/mike-jacks/neo/metrics"
+	"github.com/mike-jacks/neo/tracing"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// instrument starts a span for the named Neo4jDatabase method and returns a function that ends it
+// and records the method latency. It is meant to be deferred at the top of every method.
+func instrument(ctx context.Context, method string) (context.Context, func()) {
+	start := time.Now()
+	ctx, span := tracing.Tracer().Start(ctx, "Neo4jDatabase."+method)
+	return ctx, func() {
+		span.End()
+		metrics.ObserveNeo4jQuery(method, start)
+	}
+}
+
+// readQuery runs query in a managed read transaction, which the driver routes to a follower in a
+// cluster and retries on transient errors
+func readQuery(ctx context.Context, session neo4j.SessionWithContext, query string, parameters map[string]any) (*records, error) {
+	return execute(ctx, session.ExecuteRead, query, parameters)
+}
+
+// writeQuery runs query in a managed write transaction, which the driver routes to the leader and
+// retries on transient errors
+func writeQuery(ctx context.Context, session neo4j.SessionWithContext, query string, parameters map[string]any) (*records, error) {
+	return execute(ctx, session.ExecuteWrite, query, parameters)
+}
+
+// writeTransaction runs work in a single managed write transaction, so the statements it runs through
+// transactionQuery commit or roll back together, and returns the records work returns. Like writeQuery, a
+// constraint violation comes back through the records. The driver retries work as a whole on transient
+// errors, work must not keep state from a failed attempt.
+func writeTransaction(ctx context.Context, session neo4j.SessionWithContext, work func(tx neo4j.ManagedTransaction) (*records, error)) (*records, error) {
+	result, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return work(tx)
+	})
+	if isConstraintViolation(err) {
+		return &records{err: err}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.(*records), nil
+}
+
+type executeFunc func(ctx context.Context, work neo4j.ManagedTransactionWork, configurers ...func(*neo4j.TransactionConfig)) (any, error)
+
+// execute runs a single Cypher statement in its own transaction through executor
+func execute(ctx context.Context, executor executeFunc, query string, parameters map[string]any) (*records, error) {
+	result, err := executor(ctx, func(tx neo4j.ManagedTransaction) (any, error) {
+		return transactionQuery(ctx, tx, query, parameters)
+	})
+	if isConstraintViolation(err) {
+		return &records{err: err}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return result.(*records), nil
+}
+
+// transactionQuery runs a Cypher statement in tx inside its own span, logging it when query logging is
+// enabled. Records are collected inside the transaction so a retried attempt never leaves a half consumed
+// result behind.
+func transactionQuery(ctx context.Context, tx neo4j.ManagedTransaction, query string, parameters map[string]any) (*records, error) {
+	logging.Query(ctx, query, parameters)
+
+	ctx, span := tracing.StartQuerySpan(ctx, query, parameters)
+	defer span.End()
+
+	result, err := tx.Run(ctx, query, parameters)
+	if err != nil {
+		tracing.RecordError(span, err)
+		return nil, err
+	}
+	collected, err := result.Collect(ctx)
+	tracing.RecordError(span, err)
+	if err != nil {
+		return nil, err
+	}
+	return &records{records: collected}, nil
+}
+
+// rejection is an error work returns to roll its transaction back for a reason callers report as an unsuccessful
+// response rather than an error
+type rejection struct {
+	message string
+}
+
+func (r *rejection) Error() string {
+	return r.message
+}
+
+func reject(format string, args ...any) error {
+	return &rejection{message: fmt.Sprintf(format, args...)}
+}
+
+// rejectionMessage returns the message of a rejection, false for other errors
+func rejectionMessage(err error) (*string, bool) {
+	var rejected *rejection
+	if errors.As(err, &rejected) {
+		return &rejected.message, true
+	}
+	return nil, false
+}
+
+// isConstraintViolation reports whether err was raised by a uniqueness or node key constraint
+func isConstraintViolation(err error) bool {
+	var neo4jError *neo4j.Neo4jError
+	return errors.As(err, &neo4jError) && neo4jError.Code == "Neo.ClientError.Schema.ConstraintValidationFailed"
+}
+
+// records is a fully fetched result read like a neo4j.ResultWithContext. A constraint violation
+// is reported through Err with no records, the way an auto-commit result fails while streaming,
+// so callers answer with their "already exists" messages.
+type records struct {
+	records []*neo4j.Record
+	current *neo4j.Record
+	err     error
+}
+
+func (r *records) Next(ctx context.Context) bool {
+	if len(r.records) == 0 {
+		r.current = nil
+		return false
+	}
+	r.current, r.records = r.records[0], r.records[1:]
+	return true
+}
+
+func (r *records) Record() *neo4j.Record {
+	return r.current
+}
+
+func (r *records) Err() error {
+	return r.err
+}
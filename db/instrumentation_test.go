@@ -0,0 +1,235 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// fakeSession runs transaction functions against fakeTransactions the way the driver does: a function
+// failing with a retryable error is run again in a new transaction, up to maxAttempts times
+type fakeSession struct {
+	neo4j.SessionWithContext
+	maxAttempts int
+	runs        []func(query string) ([]*neo4j.Record, error)
+	queries     []string
+	parameters  []map[string]any
+	attempts    int
+	reads       int
+	writes      int
+}
+
+func (s *fakeSession) ExecuteRead(ctx context.Context, work neo4j.ManagedTransactionWork, configurers ...func(*neo4j.TransactionConfig)) (any, error) {
+	s.reads++
+	return s.execute(work)
+}
+
+func (s *fakeSession) ExecuteWrite(ctx context.Context, work neo4j.ManagedTransactionWork, configurers ...func(*neo4j.TransactionConfig)) (any, error) {
+	s.writes++
+	return s.execute(work)
+}
+
+func (s *fakeSession) Close(ctx context.Context) error {
+	return nil
+}
+
+func (s *fakeSession) execute(work neo4j.ManagedTransactionWork) (any, error) {
+	var err error
+	for attempt := 0; attempt < s.maxAttempts; attempt++ {
+		var result any
+		result, err = work(&fakeTransaction{session: s})
+		if err == nil || !neo4j.IsRetryable(err) {
+			return result, err
+		}
+	}
+	return nil, err
+}
+
+type fakeTransaction struct {
+	neo4j.ManagedTransaction
+	session *fakeSession
+}
+
+func (tx *fakeTransaction) Run(ctx context.Context, query string, parameters map[string]any) (neo4j.ResultWithContext, error) {
+	run := tx.session.runs[tx.session.attempts]
+	tx.session.attempts++
+	tx.session.queries = append(tx.session.queries, query)
+	tx.session.parameters = append(tx.session.parameters, parameters)
+	records, err := run(query)
+	if err != nil {
+		return nil, err
+	}
+	return &fakeResult{records: records}, nil
+}
+
+type fakeResult struct {
+	neo4j.ResultWithContext
+	records []*neo4j.Record
+}
+
+func (r *fakeResult) Collect(ctx context.Context) ([]*neo4j.Record, error) {
+	return r.records, nil
+}
+
+// fakeDriver hands out session for every session
+type fakeDriver struct {
+	neo4j.DriverWithContext
+	session *fakeSession
+}
+
+func (d *fakeDriver) NewSession(ctx context.Context, config neo4j.SessionConfig) neo4j.SessionWithContext {
+	return d.session
+}
+
+func returning(records ...*neo4j.Record) func(string) ([]*neo4j.Record, error) {
+	return func(string) ([]*neo4j.Record, error) { return records, nil }
+}
+
+func failing(code string) func(string) ([]*neo4j.Record, error) {
+	return func(string) ([]*neo4j.Record, error) { return nil, &neo4j.Neo4jError{Code: code, Msg: code} }
+}
+
+func record(id string) *neo4j.Record {
+	return &neo4j.Record{Keys: []string{"id"}, Values: []any{id}}
+}
+
+const (
+	deadlockDetected           = "Neo.TransientError.Transaction.DeadlockDetected"
+	constraintValidationFailed = "Neo.ClientError.Schema.ConstraintValidationFailed"
+	syntaxError                = "Neo.ClientError.Statement.SyntaxError"
+)
+
+func TestWriteQueryRetriesTransientErrors(t *testing.T) {
+	session := &fakeSession{maxAttempts: 3, runs: []func(string) ([]*neo4j.Record, error){
+		failing(deadlockDetected),
+		failing(deadlockDetected),
+		returning(record("a"), record("b")),
+	}}
+
+	result, err := writeQuery(context.Background(), session, "MATCH (n) RETURN n._id AS id", nil)
+	if err != nil {
+		t.Fatalf("writeQuery failed: %v", err)
+	}
+	if session.attempts != 3 || session.writes != 1 {
+		t.Errorf("got %d attempts in %d write transactions, want 3 in 1", session.attempts, session.writes)
+	}
+	ids := []string{}
+	for result.Next(context.Background()) {
+		id, _, _ := neo4j.GetRecordValue[string](result.Record(), "id")
+		ids = append(ids, id)
+	}
+	if result.Err() != nil || len(ids) != 2 || ids[0] != "a" || ids[1] != "b" {
+		t.Errorf("got ids %v and error %v, want the records of the last attempt only", ids, result.Err())
+	}
+}
+
+func TestReadQueryReturnsTransientErrorsOnceRetriesAreExhausted(t *testing.T) {
+	session := &fakeSession{maxAttempts: 2, runs: []func(string) ([]*neo4j.Record, error){
+		failing(deadlockDetected),
+		failing(deadlockDetected),
+	}}
+
+	result, err := readQuery(context.Background(), session, "MATCH (n) RETURN n", nil)
+	if result != nil || !neo4j.IsRetryable(err) {
+		t.Fatalf("got result %v and error %v, want the transient error", result, err)
+	}
+	if session.attempts != 2 || session.reads != 1 {
+		t.Errorf("got %d attempts in %d read transactions, want 2 in 1", session.attempts, session.reads)
+	}
+}
+
+func TestWriteQueryReportsConstraintViolationsThroughRecords(t *testing.T) {
+	session := &fakeSession{maxAttempts: 3, runs: []func(string) ([]*neo4j.Record, error){
+		failing(constraintValidationFailed),
+	}}
+
+	result, err := writeQuery(context.Background(), session, "CREATE (n:SERVER {_name: $name})", nil)
+	if err != nil {
+		t.Fatalf("writeQuery failed: %v", err)
+	}
+	if session.attempts != 1 {
+		t.Errorf("got %d attempts, want a constraint violation not to be retried", session.attempts)
+	}
+	if result.Next(context.Background()) {
+		t.Error("got a record from a constraint violation")
+	}
+	var neo4jError *neo4j.Neo4jError
+	if !errors.As(result.Err(), &neo4jError) || neo4jError.Code != constraintValidationFailed {
+		t.Errorf("got Err %v, want the constraint violation", result.Err())
+	}
+}
+
+func TestWriteQueryReturnsOtherClientErrors(t *testing.T) {
+	session := &fakeSession{maxAttempts: 3, runs: []func(string) ([]*neo4j.Record, error){
+		failing(syntaxError),
+	}}
+
+	result, err := writeQuery(context.Background(), session, "CREATE (n", nil)
+	var neo4jError *neo4j.Neo4jError
+	if result != nil || !errors.As(err, &neo4jError) || neo4jError.Code != syntaxError {
+		t.Fatalf("got result %v and error %v, want the syntax error", result, err)
+	}
+	if session.attempts != 1 {
+		t.Errorf("got %d attempts, want a client error not to be retried", session.attempts)
+	}
+}
+
+func TestWriteTransactionRetriesAllItsStatementsTogether(t *testing.T) {
+	session := &fakeSession{maxAttempts: 3, runs: []func(string) ([]*neo4j.Record, error){
+		returning(record("a")),
+		failing(deadlockDetected),
+		returning(record("b")),
+		returning(),
+	}}
+
+	queries := []string{}
+	result, err := writeTransaction(context.Background(), session, func(tx neo4j.ManagedTransaction) (*records, error) {
+		result, err := transactionQuery(context.Background(), tx, "SET objectNode.name = $name", nil)
+		if err != nil {
+			return nil, err
+		}
+		queries = append(queries, "SET")
+		if _, err := transactionQuery(context.Background(), tx, "CREATE (objectNode)-[reference]->(target)", nil); err != nil {
+			return nil, err
+		}
+		queries = append(queries, "CREATE")
+		return result, nil
+	})
+	if err != nil {
+		t.Fatalf("writeTransaction failed: %v", err)
+	}
+	if session.writes != 1 || session.attempts != 4 {
+		t.Errorf("got %d attempts in %d write transactions, want 4 in 1", session.attempts, session.writes)
+	}
+	if len(queries) != 3 || queries[1] != "SET" || queries[2] != "CREATE" {
+		t.Errorf("ran %v, want the whole transaction run again after the transient error", queries)
+	}
+	if !result.Next(context.Background()) {
+		t.Fatal("got no record from the transaction")
+	}
+	if id, _, _ := neo4j.GetRecordValue[string](result.Record(), "id"); id != "b" {
+		t.Errorf("got id %s, want the record of the attempt that committed", id)
+	}
+}
+
+func TestWriteTransactionReportsConstraintViolationsThroughRecords(t *testing.T) {
+	session := &fakeSession{maxAttempts: 3, runs: []func(string) ([]*neo4j.Record, error){
+		returning(record("a")),
+		failing(constraintValidationFailed),
+	}}
+
+	result, err := writeTransaction(context.Background(), session, func(tx neo4j.ManagedTransaction) (*records, error) {
+		if _, err := transactionQuery(context.Background(), tx, "CREATE (objectNode)", nil); err != nil {
+			return nil, err
+		}
+		return transactionQuery(context.Background(), tx, "CREATE (objectNode)-[reference]->(target)", nil)
+	})
+	if err != nil {
+		t.Fatalf("writeTransaction failed: %v", err)
+	}
+	if session.attempts != 2 || result.Next(context.Background()) || !isConstraintViolation(result.Err()) {
+		t.Errorf("got %d attempts and Err %v, want the constraint violation without retrying", session.attempts, result.Err())
+	}
+}
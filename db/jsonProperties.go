@@ -0,0 +1,244 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mike-jacks/neo/jsonschema"
+	"github.com/mike-jacks/neo/model"
+	"github.com/mike-jacks/neo/utils"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// SetJSONSchemaOnTypeSchemaNode stores the JSON Schema object node values of a JSON property are validated against,
+// a nil schema removes it. The message reports how many existing object node values do not match the new schema.
+func (db *Neo4jDatabase) SetJSONSchemaOnTypeSchemaNode(ctx context.Context, id string, property string, schema *string) (*model.TypeSchemaNodeResponse, error) {
+	ctx, done := instrument(ctx, "SetJSONSchemaOnTypeSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
+	defer session.Close(ctx)
+
+	property = utils.RemoveSpacesAndLowerCase(property)
+	if _, err := propertyReference("typeSchemaNode", property); err != nil {
+		message := err.Error()
+		return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
+	}
+
+	var compiled *jsonschema.Schema
+	if schema != nil {
+		var err error
+		if compiled, err = jsonschema.Compile(*schema); err != nil {
+			message := err.Error()
+			return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
+		}
+	}
+
+	query := fmt.Sprintf(`
+		MATCH (typeSchemaNode:TYPE_SCHEMA {_id: $id})
+		WHERE typeSchemaNode.`+"`%s`"+` STARTS WITH $marker
+		SET typeSchemaNode.`+"`%s`"+` = $schema
+		WITH typeSchemaNode
+		OPTIONAL MATCH (objectNode)
+		WHERE `+objectNodeOfType("objectNode", "typeSchemaNode")+` AND objectNode.`+"`%s`"+` IS NOT NULL AND NOT objectNode:RELATIONSHIP_SCHEMA AND NOT objectNode:DOMAIN_SCHEMA AND NOT objectNode:TYPE_SCHEMA
+		RETURN typeSchemaNode, collect(objectNode.`+"`%s`"+`) AS values
+	`, property, utils.JSONSchemaKey(property), property, property)
+
+	parameters := map[string]any{
+		"id":     id,
+		"marker": utils.JSONMarker,
+		"schema": utils.DereferenceOrNilString(schema),
+	}
+
+	result, err := writeQuery(ctx, session, query, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Next(ctx) {
+		record := result.Record()
+		typeSchemaNode, ok := record.Get("typeSchemaNode")
+		if !ok {
+			return nil, fmt.Errorf("failed to retrieve the typeSchemaNode")
+		}
+		neo4jTypeSchemaNode, ok := typeSchemaNode.(dbtype.Node)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type for typeSchemaNode: %T", typeSchemaNode)
+		}
+		values, _, err := neo4j.GetRecordValue[[]any](record, "values")
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the values")
+		}
+		data := &model.TypeSchemaNode{
+			ID:                     utils.PopString(neo4jTypeSchemaNode.Props, "_id"),
+			Domain:                 utils.PopString(neo4jTypeSchemaNode.Props, "_domain"),
+			Name:                   utils.PopString(neo4jTypeSchemaNode.Props, "_name"),
+			OriginalName:           utils.PopString(neo4jTypeSchemaNode.Props, "_originalName"),
+			Type:                   utils.PopString(neo4jTypeSchemaNode.Props, "_type"),
+			JSONSchemas:            utils.PopJSONSchemas(neo4jTypeSchemaNode.Props),
+			Constraints:            utils.PopPropertyConstraints(neo4jTypeSchemaNode.Props),
+			ComputedProperties:     utils.PopComputedProperties(neo4jTypeSchemaNode.Props),
+			ParentTypeSchemaNodeID: utils.PopParentTypeSchemaNodeID(neo4jTypeSchemaNode.Props),
+			Properties:             utils.ExtractPropertiesFromNeo4jNode(neo4jTypeSchemaNode.Props),
+			Labels:                 neo4jTypeSchemaNode.Labels,
+		}
+		if compiled == nil {
+			message := fmt.Sprintf("JSON Schema removed from property %s of type schema node %s", property, data.Name)
+			return &model.TypeSchemaNodeResponse{Success: true, Message: &message, TypeSchemaNode: data}, nil
+		}
+		invalid := 0
+		for _, value := range values {
+			if decoded, ok := utils.ExtractJSONProperty(value); !ok || compiled.Validate(decoded) != nil {
+				invalid++
+			}
+		}
+		message := fmt.Sprintf("JSON Schema set on property %s of type schema node %s, %d existing object nodes do not match it", property, data.Name, invalid)
+		return &model.TypeSchemaNodeResponse{Success: true, Message: &message, TypeSchemaNode: data}, nil
+	}
+	message := fmt.Sprintf("Type schema node with id %s was not found or has no JSON property %s", id, property)
+	return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
+}
+
+// validateJSONProperties checks the JSON properties among properties against the JSON Schemas of typeSchemaNode
+func validateJSONProperties(typeSchemaNode *model.TypeSchemaNode, properties []*model.PropertyInput) error {
+	schemas := map[string]string{}
+	for _, schema := range typeSchemaNode.JSONSchemas {
+		schemas[schema.Key] = schema.Schema
+	}
+	for _, property := range properties {
+		schema, ok := schemas[property.Key]
+		// A null value removes the property, only values are validated
+		if property.Type != model.PropertyTypeJSON || !ok || property.Value == nil {
+			continue
+		}
+		compiled, err := jsonschema.Compile(schema)
+		if err != nil {
+			return fmt.Errorf("property %s: stored %w", property.Key, err)
+		}
+		value, err := utils.DecodeJSONValue(fmt.Sprint(property.Value))
+		if err != nil {
+			return fmt.Errorf("property %s: %w", property.Key, err)
+		}
+		if err := compiled.Validate(value); err != nil {
+			return fmt.Errorf("property %s does not match its JSON Schema: %w", property.Key, err)
+		}
+	}
+	return nil
+}
+
+// jsonPathFilter is a filter on a value inside a JSON property, applied to object nodes once read
+type jsonPathFilter struct {
+	key      string
+	path     []any
+	operator model.FilterOperator
+	value    any
+}
+
+// splitJSONPathFilters separates the filters on JSON properties, which Cypher cannot look into, from the others
+func splitJSONPathFilters(filters []*model.PropertyFilter) ([]*model.PropertyFilter, []*jsonPathFilter, error) {
+	cypherFilters := []*model.PropertyFilter{}
+	pathFilters := []*jsonPathFilter{}
+	for _, filter := range filters {
+		if filter.Type != model.PropertyTypeJSON {
+			if filter.Path != nil {
+				return nil, nil, fmt.Errorf("filter on %v: path is only supported on JSON properties", filter.Key)
+			}
+			cypherFilters = append(cypherFilters, filter)
+			continue
+		}
+		path := ""
+		if filter.Path != nil {
+			path = *filter.Path
+		}
+		segments, err := utils.ParseJSONPath(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("filter on %v: %w", filter.Key, err)
+		}
+		normalized, err := utils.NormalizeJSONValue(filter.Value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("filter on %v: %w", filter.Key, err)
+		}
+		value, _ := utils.DecodeJSONValue(normalized)
+		pathFilters = append(pathFilters, &jsonPathFilter{
+			key:      utils.RemoveSpacesAndLowerCase(filter.Key),
+			path:     segments,
+			operator: filter.Operator,
+			value:    value,
+		})
+	}
+	return cypherFilters, pathFilters, nil
+}
+
+// matches reports whether the object node has the JSON property and the value at the path compares to the filter value,
+// ordering operators compare numbers with numbers and strings with strings
+func (f *jsonPathFilter) matches(objectNode *model.ObjectNode) bool {
+	for _, property := range objectNode.Properties {
+		if property.Key != f.key || property.Type != model.PropertyTypeJSON {
+			continue
+		}
+		value, ok := utils.LookupJSONPath(property.Value, f.path)
+		if !ok {
+			return false
+		}
+		switch f.operator {
+		case model.FilterOperatorEq:
+			return jsonschema.Equal(value, f.value)
+		case model.FilterOperatorNeq:
+			return !jsonschema.Equal(value, f.value)
+		}
+		comparison, ok := compareJSON(value, f.value)
+		if !ok {
+			return false
+		}
+		switch f.operator {
+		case model.FilterOperatorLt:
+			return comparison < 0
+		case model.FilterOperatorLte:
+			return comparison <= 0
+		case model.FilterOperatorGt:
+			return comparison > 0
+		case model.FilterOperatorGte:
+			return comparison >= 0
+		}
+		return false
+	}
+	return false
+}
+
+func compareJSON(a any, b any) (int, bool) {
+	if x, ok := a.(string); ok {
+		y, ok := b.(string)
+		return strings.Compare(x, y), ok
+	}
+	x, ok := jsonNumber(a)
+	if !ok {
+		return 0, false
+	}
+	y, ok := jsonNumber(b)
+	if !ok {
+		return 0, false
+	}
+	switch {
+	case x < y:
+		return -1, true
+	case x > y:
+		return 1, true
+	}
+	return 0, true
+}
+
+func jsonNumber(value any) (float64, bool) {
+	number, err := filterNumber(value)
+	if err != nil {
+		return 0, false
+	}
+	switch n := number.(type) {
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
@@ -0,0 +1,209 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// MigrationRecord is an entry of the _MIGRATION ledger, one per applied migration
+type MigrationRecord struct {
+	Version   int64
+	Name      string
+	Checksum  string
+	AppliedAt time.Time
+}
+
+const migrationLockId = "migrations"
+
+// migrationConstraints back the ledger and the lock, the lock relies on its uniqueness constraint so
+// two concurrent MERGE statements cannot both create it
+var migrationConstraints = map[string]string{
+	"migration_version_unique": `
+		CREATE CONSTRAINT migration_version_unique IF NOT EXISTS
+		FOR (n:_MIGRATION)
+		REQUIRE (n.version) IS UNIQUE
+	`,
+	"migration_lock_unique": `
+		CREATE CONSTRAINT migration_lock_unique IF NOT EXISTS
+		FOR (n:_MIGRATION_LOCK)
+		REQUIRE (n._id) IS UNIQUE
+	`,
+}
+
+// AcquireMigrationLock takes the migration lock for owner unless another owner holds an unexpired
+// one. It returns the owner holding the lock afterwards, which is owner when the lock was acquired.
+func (db *Neo4jDatabase) AcquireMigrationLock(ctx context.Context, owner string, ttl time.Duration) (string, error) {
+	ctx, done := instrument(ctx, "AcquireMigrationLock")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
+	defer session.Close(ctx)
+
+	if err := db.createConstraints(ctx, session, migrationConstraints); err != nil {
+		return "", err
+	}
+
+	query := `
+		MERGE (lock:_MIGRATION_LOCK {_id: $id})
+		ON CREATE SET lock.owner = $owner, lock.expiresAt = $expiresAt
+		WITH lock, lock.expiresAt < $now OR lock.owner = $owner AS available
+		SET lock.owner = CASE WHEN available THEN $owner ELSE lock.owner END,
+			lock.expiresAt = CASE WHEN available THEN $expiresAt ELSE lock.expiresAt END
+		RETURN lock.owner AS owner
+	`
+
+	now := time.Now()
+	parameters := map[string]any{
+		"id":        migrationLockId,
+		"owner":     owner,
+		"now":       now.UnixMilli(),
+		"expiresAt": now.Add(ttl).UnixMilli(),
+	}
+
+	result, err := writeQuery(ctx, session, query, parameters)
+	if err != nil {
+		return "", err
+	}
+	// Two instances creating the lock at once both MERGE, the uniqueness constraint fails the one
+	// committing last. Running the MERGE again matches the lock the other one created.
+	if isConstraintViolation(result.Err()) {
+		if result, err = writeQuery(ctx, session, query, parameters); err != nil {
+			return "", err
+		}
+	}
+	if result.Err() != nil {
+		return "", result.Err()
+	}
+	if !result.Next(ctx) {
+		return "", fmt.Errorf("failed to acquire the migration lock")
+	}
+	holder, _, err := neo4j.GetRecordValue[string](result.Record(), "owner")
+	if err != nil {
+		return "", fmt.Errorf("failed to retrieve the migration lock owner")
+	}
+	return holder, nil
+}
+
+// ReleaseMigrationLock releases the migration lock if owner holds it
+func (db *Neo4jDatabase) ReleaseMigrationLock(ctx context.Context, owner string) error {
+	ctx, done := instrument(ctx, "ReleaseMigrationLock")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
+	defer session.Close(ctx)
+
+	query := `
+		MATCH (lock:_MIGRATION_LOCK {_id: $id, owner: $owner})
+		DELETE lock
+	`
+
+	parameters := map[string]any{
+		"id":    migrationLockId,
+		"owner": owner,
+	}
+
+	_, err := writeQuery(ctx, session, query, parameters)
+	return err
+}
+
+// GetMigrationRecords returns the migration ledger ordered by version
+func (db *Neo4jDatabase) GetMigrationRecords(ctx context.Context) ([]*MigrationRecord, error) {
+	ctx, done := instrument(ctx, "GetMigrationRecords")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
+	defer session.Close(ctx)
+
+	query := `
+		MATCH (migration:_MIGRATION)
+		RETURN migration.version AS version, migration.name AS name, migration.checksum AS checksum, migration.appliedAt AS appliedAt
+		ORDER BY version
+	`
+
+	result, err := readQuery(ctx, session, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	records := []*MigrationRecord{}
+	for result.Next(ctx) {
+		record := result.Record()
+		version, _, err := neo4j.GetRecordValue[int64](record, "version")
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the migration version")
+		}
+		name, _, _ := neo4j.GetRecordValue[string](record, "name")
+		checksum, _, _ := neo4j.GetRecordValue[string](record, "checksum")
+		appliedAt, _, _ := neo4j.GetRecordValue[time.Time](record, "appliedAt")
+		records = append(records, &MigrationRecord{Version: version, Name: name, Checksum: checksum, AppliedAt: appliedAt})
+	}
+	return records, nil
+}
+
+// AddMigrationRecord adds an applied migration to the ledger
+func (db *Neo4jDatabase) AddMigrationRecord(ctx context.Context, record *MigrationRecord) error {
+	ctx, done := instrument(ctx, "AddMigrationRecord")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
+	defer session.Close(ctx)
+
+	query := `
+		CREATE (migration:_MIGRATION {version: $version, name: $name, checksum: $checksum, appliedAt: datetime()})
+	`
+
+	parameters := map[string]any{
+		"version":  record.Version,
+		"name":     record.Name,
+		"checksum": record.Checksum,
+	}
+
+	result, err := writeQuery(ctx, session, query, parameters)
+	if err != nil {
+		return err
+	}
+	if result.Err() != nil {
+		return fmt.Errorf("migration %d is already recorded: %w", record.Version, result.Err())
+	}
+	return nil
+}
+
+// RemoveMigrationRecord removes a rolled back migration from the ledger
+func (db *Neo4jDatabase) RemoveMigrationRecord(ctx context.Context, version int64) error {
+	ctx, done := instrument(ctx, "RemoveMigrationRecord")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
+	defer session.Close(ctx)
+
+	query := `
+		MATCH (migration:_MIGRATION {version: $version})
+		DELETE migration
+	`
+
+	parameters := map[string]any{
+		"version": version,
+	}
+
+	_, err := writeQuery(ctx, session, query, parameters)
+	return err
+}
+
+// RunMigrationStatement runs a single Cypher statement of a migration in its own write transaction,
+// schema statements such as CREATE INDEX cannot share a transaction with data changes
+func (db *Neo4jDatabase) RunMigrationStatement(ctx context.Context, statement string) error {
+	ctx, done := instrument(ctx, "RunMigrationStatement")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
+	defer session.Close(ctx)
+
+	result, err := writeQuery(ctx, session, statement, nil)
+	if err != nil {
+		return err
+	}
+	return result.Err()
+}
@@ -0,0 +1,30 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestAcquireMigrationLockReturnsTheHolderAfterLosingTheRaceToCreateIt(t *testing.T) {
+	holder := &neo4j.Record{Keys: []string{"owner"}, Values: []any{"another instance"}}
+	session := &fakeSession{maxAttempts: 1, runs: []func(string) ([]*neo4j.Record, error){
+		failing(constraintValidationFailed),
+		returning(holder),
+	}}
+	database := &Neo4jDatabase{Driver: &fakeDriver{session: session}}
+	database.constraints.add("migration_version_unique", "migration_lock_unique")
+
+	owner, err := database.AcquireMigrationLock(context.Background(), "this instance", time.Minute)
+	if err != nil {
+		t.Fatalf("AcquireMigrationLock failed: %v", err)
+	}
+	if owner != "another instance" {
+		t.Errorf("got owner %q, want the instance that created the lock", owner)
+	}
+	if len(session.queries) != 2 {
+		t.Errorf("ran %d queries, want the MERGE retried once", len(session.queries))
+	}
+}
@@ -2,24 +2,43 @@ package db
 
 import (
 	"context"
+	"crypto/x509"
 	"fmt"
-	"log"
+	"log/slog"
 	"os"
 	"strings"
 
+	"github.com/mike-jacks/neo/config"
 	"github.com/mike-jacks/neo/model"
 	"github.com/mike-jacks/neo/utils"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	neo4jconfig "github.com/neo4j/neo4j-go-driver/v5/neo4j/config"
 	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
 )
 
-// SetupNeo4jDriver creates a new Neo4j driver with the given context
-func SetupNeo4jDriver() (neo4j.DriverWithContext, error) {
-	dbUri := os.Getenv("NEO4J_URI")
-	dbUser := os.Getenv("NEO4J_USERNAME")
-	dbPassword := os.Getenv("NEO4J_PASSWORD")
+// SetupNeo4jDriver creates a new Neo4j driver with the pool, retry and TLS settings from cfg
+func SetupNeo4jDriver(cfg config.Neo4jConfig) (neo4j.DriverWithContext, error) {
+	var rootCAs *x509.CertPool
+	if cfg.CACertFile != "" {
+		pem, err := os.ReadFile(cfg.CACertFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read CA certificate file: %w", err)
+		}
+		rootCAs = x509.NewCertPool()
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no PEM certificates found in %s", cfg.CACertFile)
+		}
+	}
 
-	driver, err := neo4j.NewDriverWithContext(dbUri, neo4j.BasicAuth(dbUser, dbPassword, ""))
+	driver, err := neo4j.NewDriverWithContext(cfg.URI, neo4j.BasicAuth(cfg.Username, cfg.Password, ""), func(driverConfig *neo4jconfig.Config) {
+		driverConfig.MaxConnectionPoolSize = cfg.MaxConnectionPoolSize
+		driverConfig.ConnectionAcquisitionTimeout = cfg.ConnectionAcquisitionTimeout
+		driverConfig.MaxConnectionLifetime = cfg.MaxConnectionLifetime
+		driverConfig.MaxTransactionRetryTime = cfg.MaxTransactionRetryTime
+		if rootCAs != nil {
+			driverConfig.RootCAs = rootCAs
+		}
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -28,12 +47,38 @@ func SetupNeo4jDriver() (neo4j.DriverWithContext, error) {
 		return nil, err
 	}
 
-	log.Println("Neo4j connection established")
+	slog.Info("Neo4j connection established", slog.String("database", cfg.Database))
 	return driver, nil
 }
 
 type Neo4jDatabase struct {
 	Driver neo4j.DriverWithContext
+	// constraints caches the constraint names known to exist, see BootstrapSchema
+	constraints constraintCache
+	// Database is the target database of every session, empty uses the user's home database
+	Database string
+	// BookmarkManager is shared by every session so each one starts after the writes of the
+	// previous ones, nil disables causal chaining
+	BookmarkManager neo4j.BookmarkManager
+}
+
+// NewNeo4jDatabase wraps driver, targeting the configured database and sharing bookmarks between
+// sessions when causal consistency is enabled
+func NewNeo4jDatabase(driver neo4j.DriverWithContext, cfg config.Neo4jConfig) *Neo4jDatabase {
+	neo4jDatabase := &Neo4jDatabase{Driver: driver, Database: cfg.Database}
+	if cfg.CausalConsistency {
+		neo4jDatabase.BookmarkManager = neo4j.NewBookmarkManager(neo4j.BookmarkManagerConfig{})
+	}
+	return neo4jDatabase
+}
+
+// newSession opens a session against the configured database
+func (db *Neo4jDatabase) newSession(ctx context.Context, accessMode neo4j.AccessMode) neo4j.SessionWithContext {
+	return db.Driver.NewSession(ctx, neo4j.SessionConfig{
+		AccessMode:      accessMode,
+		DatabaseName:    db.Database,
+		BookmarkManager: db.BookmarkManager,
+	})
 }
 
 // Database interface implementation
@@ -42,7 +87,10 @@ func (db *Neo4jDatabase) GetDriver() neo4j.DriverWithContext {
 }
 
 func (db *Neo4jDatabase) CreateObjectNode(ctx context.Context, domain string, name string, typeArg string, labels []string, properties []*model.PropertyInput) (*model.ObjectNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "CreateObjectNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	id := utils.GenerateId()
@@ -61,68 +109,25 @@ func (db *Neo4jDatabase) CreateObjectNode(ctx context.Context, domain string, na
 			return &model.ObjectNodeResponse{Success: false, Message: &message, ObjectNode: nil}, nil
 		}
 	}
-	query := fmt.Sprintf(`
-	CREATE CONSTRAINT object_node_%s_key IF NOT EXISTS
-	FOR (n:%v) REQUIRE (n._id) IS NODE KEY
-	`, utils.SanitizeStringToLower(labelFromTypeArg), utils.SanitizeStringToUpper(labelFromTypeArg))
-
-	fmt.Println(query)
-
-	_, err := session.Run(ctx, query, nil)
+	properties, err := db.validateObjectProperties(ctx, domain, typeArg, "", properties)
 	if err != nil {
-		return nil, err
+		message := err.Error()
+		return &model.ObjectNodeResponse{Success: false, Message: &message, ObjectNode: nil}, nil
 	}
-
-	query = fmt.Sprintf(`
-		CREATE CONSTRAINT object_node_%s_unique IF NOT EXISTS
-		FOR (n:%v)
-		REQUIRE (n._name, n._type, n._domain) IS UNIQUE
-		`, utils.SanitizeStringToLower(labelFromTypeArg), utils.SanitizeStringToUpper(labelFromTypeArg))
-
-	fmt.Println(query)
-
-	_, err = session.Run(ctx, query, nil)
+	supertypes, err := db.supertypes(ctx, domain, typeArg)
 	if err != nil {
 		return nil, err
 	}
-
-	for _, label := range labels {
-		query = fmt.Sprintf(`
-		CREATE CONSTRAINT object_node_%s_key IF NOT EXISTS
-		FOR (n:%v) REQUIRE (n._id) IS NODE KEY
-		`, utils.SanitizeStringToLower(label), utils.SanitizeStringToUpper(label))
-
-		fmt.Println(query)
-
-		_, err = session.Run(ctx, query, nil)
-		if err != nil {
-			return nil, err
-		}
-
-		query = fmt.Sprintf(`
-		CREATE CONSTRAINT object_node_%s_unique IF NOT EXISTS
-		FOR (n:%v)
-		REQUIRE (n._name, n._type, n._domain) IS UNIQUE
-		`, utils.SanitizeStringToLower(label), utils.SanitizeStringToUpper(label))
-
-		fmt.Println(query)
-
-		_, err = session.Run(ctx, query, nil)
-		if err != nil {
-			return nil, err
-		}
+	for _, supertype := range supertypes {
+		labels = append(labels, typeLabel(supertype))
 	}
-
-	query = fmt.Sprintf("CREATE (objectNode:%v", utils.SanitizeStringToUpper(labelFromTypeArg))
+	constraintLabels := []string{utils.SanitizeStringToUpper(labelFromTypeArg)}
 	for _, label := range labels {
-		query += fmt.Sprintf(":%v", utils.SanitizeStringToUpper(label))
+		constraintLabels = append(constraintLabels, utils.SanitizeStringToUpper(label))
+	}
+	if err := db.ensureLabelConstraints(ctx, constraintLabels...); err != nil {
+		return nil, err
 	}
-	query += " {_id: $id, _name: $name, _type: $typeArg, _domain: $domain, _originalName: $originalName, "
-	query = utils.CreatePropertiesQuery(query, properties)
-	query = strings.TrimSuffix(query, ", ")
-	query += "}) RETURN objectNode"
-
-	fmt.Println(query)
 
 	parameters := map[string]any{
 		"id":           id,
@@ -130,9 +135,28 @@ func (db *Neo4jDatabase) CreateObjectNode(ctx context.Context, domain string, na
 		"typeArg":      typeArg,
 		"domain":       domain,
 		"originalName": originalName,
+		"supertypes":   supertypes,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	query := fmt.Sprintf("CREATE (objectNode:%v", utils.SanitizeStringToUpper(labelFromTypeArg))
+	for _, label := range labels {
+		query += fmt.Sprintf(":%v", utils.SanitizeStringToUpper(label))
+	}
+	query += fmt.Sprintf(" {_id: $id, _name: $name, _type: $typeArg, _domain: $domain, _originalName: $originalName, %s: $supertypes, ", utils.SupertypesKey)
+	query = utils.CreatePropertiesQuery(query, parameters, properties)
+	query = strings.TrimSuffix(query, ", ")
+	query += "}) RETURN objectNode"
+
+	result, err := writeTransaction(ctx, session, func(tx neo4j.ManagedTransaction) (*records, error) {
+		result, err := transactionQuery(ctx, tx, query, parameters)
+		if err != nil || len(result.records) == 0 {
+			return result, err
+		}
+		return result, db.writeReferences(ctx, tx, id, properties)
+	})
+	if message, ok := rejectionMessage(err); ok {
+		return &model.ObjectNodeResponse{Success: false, Message: message, ObjectNode: nil}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -172,20 +196,24 @@ func (db *Neo4jDatabase) CreateObjectNode(ctx context.Context, domain string, na
 }
 
 func (db *Neo4jDatabase) RenameObjectNode(ctx context.Context, id string, newName string) (*model.ObjectNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "RenameObjectNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	newOriginalName := strings.TrimSpace(newName)
 	newName = strings.TrimSpace(strings.ToUpper(newName))
 
-	query := fmt.Sprintf("MATCH (objectNode{_id: $id}) SET objectNode._name = \"%s\", objectNode._originalName = \"%s\" RETURN objectNode;", newName, newOriginalName)
-	fmt.Println(query)
+	query := "MATCH (objectNode{_id: $id}) SET objectNode._name = $newName, objectNode._originalName = $newOriginalName RETURN objectNode;"
 
 	parameters := map[string]any{
-		"id": id,
+		"id":              id,
+		"newName":         newName,
+		"newOriginalName": newOriginalName,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		message := "Failed to update object node"
 		return &model.ObjectNodeResponse{Success: false, Message: &message, ObjectNode: nil}, err
@@ -223,7 +251,10 @@ func (db *Neo4jDatabase) RenameObjectNode(ctx context.Context, id string, newNam
 }
 
 func (db *Neo4jDatabase) DeleteObjectNode(ctx context.Context, id string) (*model.ObjectNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "DeleteObjectNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	query := "MATCH (objectNode{_id: $id}) WITH objectNode, count(objectNode) as deletedCount, objectNode._id as id DETACH DELETE objectNode RETURN id, deletedCount"
@@ -231,9 +262,12 @@ func (db *Neo4jDatabase) DeleteObjectNode(ctx context.Context, id string) (*mode
 		"id": id,
 	}
 
-	fmt.Println(query)
-
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeTransaction(ctx, session, func(tx neo4j.ManagedTransaction) (*records, error) {
+		if err := db.clearReferencesTo(ctx, tx, id); err != nil {
+			return nil, err
+		}
+		return transactionQuery(ctx, tx, query, parameters)
+	})
 	if err != nil {
 		message := "Failed to delete object node"
 		return &model.ObjectNodeResponse{Success: false, Message: &message, ObjectNode: nil}, err
@@ -273,7 +307,10 @@ func (db *Neo4jDatabase) DeleteObjectNode(ctx context.Context, id string) (*mode
 }
 
 func (db *Neo4jDatabase) AddLabelsOnObjectNode(ctx context.Context, id string, labels []string) (*model.ObjectNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "AddLabelsOnObjectNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	for i, label := range labels {
@@ -285,6 +322,10 @@ func (db *Neo4jDatabase) AddLabelsOnObjectNode(ctx context.Context, id string, l
 		return &model.ObjectNodeResponse{Success: false, Message: &message, ObjectNode: nil}, nil
 	}
 
+	if err := db.ensureLabelConstraints(ctx, labels...); err != nil {
+		return nil, err
+	}
+
 	query := "MATCH (objectNode{_id: $id}) SET "
 	for _, label := range labels {
 		query += fmt.Sprintf("objectNode:%s, ", label)
@@ -296,9 +337,7 @@ func (db *Neo4jDatabase) AddLabelsOnObjectNode(ctx context.Context, id string, l
 		"id": id,
 	}
 
-	fmt.Println(query)
-
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		message := "Failed to add labels to object node"
 		return &model.ObjectNodeResponse{Success: false, Message: &message, ObjectNode: nil}, err
@@ -331,7 +370,10 @@ func (db *Neo4jDatabase) AddLabelsOnObjectNode(ctx context.Context, id string, l
 }
 
 func (db *Neo4jDatabase) RemoveLabelsFromObjectNode(ctx context.Context, id string, labels []string) (*model.ObjectNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "RemoveLabelsFromObjectNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	if len(labels) == 0 {
@@ -343,9 +385,7 @@ func (db *Neo4jDatabase) RemoveLabelsFromObjectNode(ctx context.Context, id stri
 		"id": id,
 	}
 
-	fmt.Println(query)
-
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -381,8 +421,7 @@ func (db *Neo4jDatabase) RemoveLabelsFromObjectNode(ctx context.Context, id stri
 		"id": id,
 	}
 
-	fmt.Println(query)
-	result, err = session.Run(ctx, query, parameters)
+	result, err = writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		message := "Failed to add labels to object node"
 		return &model.ObjectNodeResponse{Success: false, Message: &message, ObjectNode: nil}, err
@@ -415,33 +454,40 @@ func (db *Neo4jDatabase) RemoveLabelsFromObjectNode(ctx context.Context, id stri
 }
 
 func (db *Neo4jDatabase) UpdatePropertiesOnObjectNode(ctx context.Context, id string, properties []*model.PropertyInput) (*model.ObjectNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "UpdatePropertiesOnObjectNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	if err := utils.CleanUpPropertyObjects(&properties); err != nil {
 		message := err.Error()
 		return &model.ObjectNodeResponse{Success: false, Message: &message, ObjectNode: nil}, nil
 	}
-
-	query := "MATCH (objectNode{_id: $id}) SET "
-
-	for _, property := range properties {
-		if property.Type == model.PropertyTypeString {
-			query += fmt.Sprintf("objectNode.%v = \"%v\", ", property.Key, property.Value)
-		} else {
-			query += fmt.Sprintf("objectNode.%v = %v, ", property.Key, property.Value)
-		}
+	if err := db.validateObjectNodeProperties(ctx, id, properties); err != nil {
+		message := err.Error()
+		return &model.ObjectNodeResponse{Success: false, Message: &message, ObjectNode: nil}, nil
 	}
-	query = strings.TrimSuffix(query, ", ")
-	query += " RETURN objectNode"
 
 	parameters := map[string]any{
 		"id": id,
 	}
 
-	fmt.Println(query)
+	query := "MATCH (objectNode{_id: $id}) SET "
+	query = utils.CreatePropertiesQuery(query, parameters, properties, "objectNode")
+	query = strings.TrimSuffix(query, ", ")
+	query += " RETURN objectNode"
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeTransaction(ctx, session, func(tx neo4j.ManagedTransaction) (*records, error) {
+		result, err := transactionQuery(ctx, tx, query, parameters)
+		if err != nil || len(result.records) == 0 {
+			return result, err
+		}
+		return result, db.writeReferences(ctx, tx, id, properties)
+	})
+	if message, ok := rejectionMessage(err); ok {
+		return &model.ObjectNodeResponse{Success: false, Message: message, ObjectNode: nil}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -473,7 +519,10 @@ func (db *Neo4jDatabase) UpdatePropertiesOnObjectNode(ctx context.Context, id st
 }
 
 func (db *Neo4jDatabase) RemovePropertiesFromObjectNode(ctx context.Context, id string, properties []string) (*model.ObjectNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "RemovePropertiesFromObjectNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	if err := utils.CleanUpPropertyKeys(&properties); err != nil {
@@ -484,17 +533,19 @@ func (db *Neo4jDatabase) RemovePropertiesFromObjectNode(ctx context.Context, id
 	query := "MATCH (objectNode{_id: $id}) REMOVE "
 
 	for _, property := range properties {
-		query += fmt.Sprintf("objectNode.%v, ", property)
+		query += fmt.Sprintf("objectNode.%v, objectNode.%v, ", property, utils.ArrayTypeKey(property))
 	}
 	query = strings.TrimSuffix(query, ", ")
-	query += " RETURN objectNode"
+	// Removing a RELATIONSHIP property removes the object relationship it maintains
+	query += " WITH objectNode OPTIONAL MATCH (objectNode)-[reference]->() WHERE reference._referenceKey IN $properties DELETE reference"
+	query += " WITH DISTINCT objectNode RETURN objectNode"
 
 	parameters := map[string]any{
-		"id": id,
+		"id":         id,
+		"properties": properties,
 	}
 
-	fmt.Println(query)
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -525,19 +576,118 @@ func (db *Neo4jDatabase) RemovePropertiesFromObjectNode(ctx context.Context, id
 	return nil, fmt.Errorf("failed to remove properties from object node")
 }
 
+// UpdateObjectNode renames the object node id when newName is set, sets properties and removes removedProperties in
+// a single transaction, so either every change is made or none is
+func (db *Neo4jDatabase) UpdateObjectNode(ctx context.Context, id string, newName *string, properties []*model.PropertyInput, removedProperties []string) (*model.ObjectNodeResponse, error) {
+	ctx, done := instrument(ctx, "UpdateObjectNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
+	defer session.Close(ctx)
+
+	if len(properties) > 0 {
+		if err := utils.CleanUpPropertyObjects(&properties); err != nil {
+			message := err.Error()
+			return &model.ObjectNodeResponse{Success: false, Message: &message, ObjectNode: nil}, nil
+		}
+		if err := db.validateObjectNodeProperties(ctx, id, properties); err != nil {
+			message := err.Error()
+			return &model.ObjectNodeResponse{Success: false, Message: &message, ObjectNode: nil}, nil
+		}
+	}
+	if len(removedProperties) > 0 {
+		if err := utils.CleanUpPropertyKeys(&removedProperties); err != nil {
+			message := err.Error()
+			return &model.ObjectNodeResponse{Success: false, Message: &message, ObjectNode: nil}, nil
+		}
+	}
+
+	parameters := map[string]any{
+		"id":                id,
+		"removedProperties": removedProperties,
+	}
+
+	query := "MATCH (objectNode{_id: $id})"
+	if newName != nil {
+		query += " SET objectNode._name = $newName, objectNode._originalName = $newOriginalName"
+		parameters["newName"] = strings.TrimSpace(strings.ToUpper(*newName))
+		parameters["newOriginalName"] = strings.TrimSpace(*newName)
+	}
+	if len(properties) > 0 {
+		query = utils.CreatePropertiesQuery(query+" SET ", parameters, properties, "objectNode")
+		query = strings.TrimSuffix(query, ", ")
+	}
+	if len(removedProperties) > 0 {
+		query += " REMOVE "
+		for _, property := range removedProperties {
+			query += fmt.Sprintf("objectNode.%v, objectNode.%v, ", property, utils.ArrayTypeKey(property))
+		}
+		query = strings.TrimSuffix(query, ", ")
+		// Removing a RELATIONSHIP property removes the object relationship it maintains
+		query += " WITH objectNode OPTIONAL MATCH (objectNode)-[reference]->() WHERE reference._referenceKey IN $removedProperties DELETE reference"
+		query += " WITH DISTINCT objectNode"
+	}
+	query += " RETURN objectNode"
+
+	result, err := writeTransaction(ctx, session, func(tx neo4j.ManagedTransaction) (*records, error) {
+		result, err := transactionQuery(ctx, tx, query, parameters)
+		if err != nil || len(result.records) == 0 {
+			return result, err
+		}
+		return result, db.writeReferences(ctx, tx, id, properties)
+	})
+	if message, ok := rejectionMessage(err); ok {
+		return &model.ObjectNodeResponse{Success: false, Message: message, ObjectNode: nil}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Next(ctx) {
+		record := result.Record()
+		node, ok := record.Get("objectNode")
+		if !ok {
+			return nil, fmt.Errorf("failed to retrieve the updated node")
+		}
+		neo4jNode, ok := node.(dbtype.Node)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type for node: %T", node)
+		}
+
+		data := &model.ObjectNode{
+			ID:           utils.PopString(neo4jNode.Props, "_id"),
+			Name:         utils.PopString(neo4jNode.Props, "_name"),
+			Type:         utils.PopString(neo4jNode.Props, "_type"),
+			Domain:       utils.PopString(neo4jNode.Props, "_domain"),
+			OriginalName: utils.PopString(neo4jNode.Props, "_originalName"),
+			Labels:       neo4jNode.Labels,
+			Properties:   utils.ExtractPropertiesFromNeo4jNode(neo4jNode.Props),
+		}
+		message := "Object node updated successfully"
+		return &model.ObjectNodeResponse{Success: true, Message: &message, ObjectNode: data}, nil
+	}
+	if result.Err() != nil {
+		message := fmt.Sprintf("Failed to update object node: %s", result.Err())
+		return &model.ObjectNodeResponse{Success: false, Message: &message, ObjectNode: nil}, nil
+	}
+	message := fmt.Sprintf("Object node with id %s was not found", id)
+	return &model.ObjectNodeResponse{Success: false, Message: &message, ObjectNode: nil}, nil
+}
+
 func (db *Neo4jDatabase) GetObjectNode(ctx context.Context, id string) (*model.ObjectNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	ctx, done := instrument(ctx, "GetObjectNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
 	defer session.Close(ctx)
 
 	query := "MATCH (objectNode{_id: $id}) RETURN objectNode"
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id": id,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := readQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -562,14 +712,20 @@ func (db *Neo4jDatabase) GetObjectNode(ctx context.Context, id string) (*model.O
 			Labels:       neo4jNode.Labels,
 			Properties:   utils.ExtractPropertiesFromNeo4jNode(neo4jNode.Props),
 		}
+		if err := db.addComputedProperties(ctx, data); err != nil {
+			return nil, err
+		}
 		message := "Object node retrieved successfully"
 		return &model.ObjectNodeResponse{Success: true, Message: &message, ObjectNode: data}, nil
 	}
 	return nil, fmt.Errorf("failed to get object node")
 }
 
-func (db *Neo4jDatabase) GetObjectNodes(ctx context.Context, domain *string, typeArg *string) (*model.ObjectNodesResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+func (db *Neo4jDatabase) GetObjectNodes(ctx context.Context, domain *string, typeArg *string, where []*model.PropertyFilter, orderBy []*model.PropertySort) (*model.ObjectNodesResponse, error) {
+	ctx, done := instrument(ctx, "GetObjectNodes")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
 	defer session.Close(ctx)
 
 	if domain != nil {
@@ -586,25 +742,46 @@ func (db *Neo4jDatabase) GetObjectNodes(ctx context.Context, domain *string, typ
 		query += "_domain: $domain, "
 	}
 
+	query = strings.TrimSuffix(query, ", ")
+	query += "}) WHERE NOT objectNode:RELATIONSHIP_SCHEMA AND NOT objectNode:DOMAIN_SCHEMA AND NOT objectNode:TYPE_SCHEMA AND NOT objectNode:_MIGRATION AND NOT objectNode:_MIGRATION_LOCK"
+
+	// Object nodes of subtypes list the type among their supertypes
 	if typeArg != nil {
-		query += "_type: $typeArg, "
+		query += fmt.Sprintf(" AND (objectNode._type = $typeArg OR $typeName IN coalesce(objectNode.%s, []))", utils.SupertypesKey)
 	}
 
-	query = strings.TrimSuffix(query, ", ")
-	query += "}) WHERE NOT objectNode:RELATIONSHIP_SCHEMA AND NOT objectNode:DOMAIN_SCHEMA AND NOT objectNode:TYPE_SCHEMA RETURN objectNode"
+	parameters := map[string]any{}
 
-	fmt.Println(query)
+	where, pathFilters, err := splitJSONPathFilters(where)
+	if err != nil {
+		message := err.Error()
+		return &model.ObjectNodesResponse{Success: false, Message: &message, ObjectNodes: nil}, nil
+	}
+	conditions, err := propertyFilterQuery("objectNode", where, parameters)
+	if err != nil {
+		message := err.Error()
+		return &model.ObjectNodesResponse{Success: false, Message: &message, ObjectNodes: nil}, nil
+	}
+	if conditions != "" {
+		query += " AND " + conditions
+	}
+	ordering, err := propertySortQuery("objectNode", orderBy)
+	if err != nil {
+		message := err.Error()
+		return &model.ObjectNodesResponse{Success: false, Message: &message, ObjectNodes: nil}, nil
+	}
+	query += " RETURN objectNode" + ordering
 
-	parameters := map[string]any{}
 	if domain != nil {
 		parameters["domain"] = *domain
 	}
 
 	if typeArg != nil {
 		parameters["typeArg"] = *typeArg
+		parameters["typeName"] = utils.RemoveSpacesAndUpperCase(*typeArg)
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := readQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -621,7 +798,7 @@ func (db *Neo4jDatabase) GetObjectNodes(ctx context.Context, domain *string, typ
 			return nil, fmt.Errorf("unexpected type for node: %T", node)
 		}
 
-		data = append(data, &model.ObjectNode{
+		objectNode := &model.ObjectNode{
 			ID:           utils.PopString(neo4jNode.Props, "_id"),
 			Name:         utils.PopString(neo4jNode.Props, "_name"),
 			Type:         utils.PopString(neo4jNode.Props, "_type"),
@@ -629,7 +806,18 @@ func (db *Neo4jDatabase) GetObjectNodes(ctx context.Context, domain *string, typ
 			OriginalName: utils.PopString(neo4jNode.Props, "_originalName"),
 			Labels:       neo4jNode.Labels,
 			Properties:   utils.ExtractPropertiesFromNeo4jNode(neo4jNode.Props),
-		})
+		}
+		// JSON properties are stored serialized, filters on paths inside them apply once decoded
+		matches := true
+		for _, filter := range pathFilters {
+			matches = matches && filter.matches(objectNode)
+		}
+		if matches {
+			data = append(data, objectNode)
+		}
+	}
+	if err := db.addComputedProperties(ctx, data...); err != nil {
+		return nil, err
 	}
 	message := "Object nodes retrieved successfully"
 	return &model.ObjectNodesResponse{Success: true, Message: &message, ObjectNodes: data}, nil
@@ -637,10 +825,10 @@ func (db *Neo4jDatabase) GetObjectNodes(ctx context.Context, domain *string, typ
 
 func (db *Neo4jDatabase) CypherQuery(ctx context.Context, cypherStatement string) (*model.ObjectNodesOrRelationshipNodesResponse, error) {
 	return nil, nil
-	// session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	// session := db.newSession(ctx, neo4j.AccessModeRead)
 	// defer session.Close(ctx)
 
-	// result, err := session.Run(ctx, cypherStatement, nil)
+	// result, err := readQuery(ctx, session, cypherStatement, nil)
 	// if err != nil {
 	// 	return nil, err
 	// }
@@ -689,10 +877,10 @@ func (db *Neo4jDatabase) CypherQuery(ctx context.Context, cypherStatement string
 
 func (db *Neo4jDatabase) CypherMutation(ctx context.Context, cypherStatement string) (*model.ObjectNodesOrRelationshipNodesResponse, error) {
 	return nil, nil
-	// session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	// session := db.newSession(ctx, neo4j.AccessModeRead)
 	// defer session.Close(ctx)
 
-	// result, err := session.Run(ctx, cypherStatement, nil)
+	// result, err := writeQuery(ctx, session, cypherStatement, nil)
 	// if err != nil {
 	// 	return nil, err
 	// }
@@ -741,7 +929,10 @@ func (db *Neo4jDatabase) CypherMutation(ctx context.Context, cypherStatement str
 }
 
 func (db *Neo4jDatabase) CreateObjectRelationship(ctx context.Context, name string, properties []*model.PropertyInput, fromObjectNodeId string, toObjectNodeId string) (*model.ObjectRelationshipResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "CreateObjectRelationship")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	id := utils.GenerateId()
@@ -757,16 +948,12 @@ func (db *Neo4jDatabase) CreateObjectRelationship(ctx context.Context, name stri
 			message := fmt.Sprintf("Unable to clean up properties. Error: %s", err.Error())
 			return &model.ObjectRelationshipResponse{Success: false, Message: &message, ObjectRelationship: nil}, nil
 		}
+		if err := rejectReferences(properties); err != nil {
+			message := err.Error()
+			return &model.ObjectRelationshipResponse{Success: false, Message: &message, ObjectRelationship: nil}, nil
+		}
 	}
 
-	query := fmt.Sprintf("MATCH (fromObjectNode{_id: $fromObjectNodeId}), (toObjectNode{_id: $toObjectNodeId}) MERGE (fromObjectNode)-[relationship:%v {_id: $id, _name: $name, _originalName: $originalName, _fromObjectNodeId: $fromObjectNodeId, _toObjectNodeId: $toObjectNodeId}]->(toObjectNode)", name)
-	if len(properties) > 0 {
-		query += " SET "
-		query = utils.CreatePropertiesQuery(query, properties, "relationship")
-		query = strings.TrimSuffix(query, ", ")
-	}
-	query += " WITH relationship RETURN relationship"
-
 	parameters := map[string]any{
 		"id":               id,
 		"name":             name,
@@ -775,9 +962,25 @@ func (db *Neo4jDatabase) CreateObjectRelationship(ctx context.Context, name stri
 		"toObjectNodeId":   toObjectNodeId,
 	}
 
-	fmt.Println(query)
+	query := "MATCH (fromObjectNode{_id: $fromObjectNodeId}), (toObjectNode{_id: $toObjectNodeId})" + lockObjectNodes + "WITH fromObjectNode, toObjectNode, $name AS name" + cardinalityCreateGuard
+	query += fmt.Sprintf("MERGE (fromObjectNode)-[relationship:%v {_id: $id, _name: $name, _originalName: $originalName, _fromObjectNodeId: $fromObjectNodeId, _toObjectNodeId: $toObjectNodeId}]->(toObjectNode)", name)
+	if len(properties) > 0 {
+		query += " SET "
+		query = utils.CreatePropertiesQuery(query, parameters, properties, "relationship")
+		query = strings.TrimSuffix(query, ", ")
+	}
+	query += " WITH relationship RETURN relationship"
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeTransaction(ctx, session, func(tx neo4j.ManagedTransaction) (*records, error) {
+		result, err := transactionQuery(ctx, tx, query, parameters)
+		if err != nil || len(result.records) > 0 {
+			return result, err
+		}
+		return result, db.checkCardinalityOnCreate(ctx, tx, name, fromObjectNodeId, toObjectNodeId)
+	})
+	if message, ok := rejectionMessage(err); ok {
+		return &model.ObjectRelationshipResponse{Success: false, Message: message, ObjectRelationship: nil}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -809,26 +1012,31 @@ func (db *Neo4jDatabase) CreateObjectRelationship(ctx context.Context, name stri
 }
 
 func (db *Neo4jDatabase) UpdatePropertiesOnObjectRelationship(ctx context.Context, id string, properties []*model.PropertyInput) (*model.ObjectRelationshipResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "UpdatePropertiesOnObjectRelationship")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	if err := utils.CleanUpPropertyObjects(&properties); err != nil {
 		message := fmt.Sprintf("Unable to update properties. Error: %s", err.Error())
 		return &model.ObjectRelationshipResponse{Success: false, Message: &message, ObjectRelationship: nil}, nil
 	}
-
-	query := "MATCH (fromObjectNode)-[relationship]->(toObjectNode) WHERE relationship._id = $id SET "
-	query = utils.CreatePropertiesQuery(query, properties, "relationship")
-	query = strings.TrimSuffix(query, ", ")
-	query += " WITH relationship RETURN relationship"
-
-	fmt.Println(query)
+	if err := rejectReferences(properties); err != nil {
+		message := err.Error()
+		return &model.ObjectRelationshipResponse{Success: false, Message: &message, ObjectRelationship: nil}, nil
+	}
 
 	parameters := map[string]any{
 		"id": id,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	query := "MATCH (fromObjectNode)-[relationship]->(toObjectNode) WHERE relationship._id = $id SET "
+	query = utils.CreatePropertiesQuery(query, parameters, properties, "relationship")
+	query = strings.TrimSuffix(query, ", ")
+	query += " WITH relationship RETURN relationship"
+
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -860,7 +1068,10 @@ func (db *Neo4jDatabase) UpdatePropertiesOnObjectRelationship(ctx context.Contex
 }
 
 func (db *Neo4jDatabase) RemovePropertiesFromObjectRelationship(ctx context.Context, id string, properties []string) (*model.ObjectRelationshipResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "RemovePropertiesFromObjectRelationship")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	if err := utils.CleanUpPropertyKeys(&properties); err != nil {
@@ -873,13 +1084,11 @@ func (db *Neo4jDatabase) RemovePropertiesFromObjectRelationship(ctx context.Cont
 	query = strings.TrimSuffix(query, ", ")
 	query += " WITH relationship RETURN relationship"
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id": id,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -912,23 +1121,37 @@ func (db *Neo4jDatabase) RemovePropertiesFromObjectRelationship(ctx context.Cont
 }
 
 func (db *Neo4jDatabase) DeleteObjectRelationship(ctx context.Context, id string) (*model.ObjectRelationshipResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "DeleteObjectRelationship")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	query := `
-		MATCH (fromObjectNode)-[relationship]-(toObjectNode)
+		MATCH (fromObjectNode)-[relationship {_id: $id}]->(toObjectNode)` + lockObjectNodes + `
+		WITH fromObjectNode, toObjectNode, relationship, relationship._name AS name` + cardinalityDeleteGuard + `
 		WITH relationship, properties(relationship) as properties, fromObjectNode._id as fromObjectNodeId, toObjectNode._id as toObjectNodeId
 		DELETE relationship
 		RETURN properties, fromObjectNodeId, toObjectNodeId
 	`
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id": id,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeTransaction(ctx, session, func(tx neo4j.ManagedTransaction) (*records, error) {
+		if err := db.clearReferenceProperty(ctx, tx, id); err != nil {
+			return nil, err
+		}
+		result, err := transactionQuery(ctx, tx, query, parameters)
+		if err != nil || len(result.records) > 0 {
+			return result, err
+		}
+		return result, db.checkCardinalityOnDelete(ctx, tx, id)
+	})
+	if message, ok := rejectionMessage(err); ok {
+		return &model.ObjectRelationshipResponse{Success: false, Message: message, ObjectRelationship: nil}, nil
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -959,18 +1182,19 @@ func (db *Neo4jDatabase) DeleteObjectRelationship(ctx context.Context, id string
 }
 
 func (db *Neo4jDatabase) GetObjectNodeRelationship(ctx context.Context, id string) (*model.ObjectRelationshipResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	ctx, done := instrument(ctx, "GetObjectNodeRelationship")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
 	defer session.Close(ctx)
 
 	query := `MATCH () - [relationship {_id: $id}]-> () RETURN relationship`
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id": id,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := readQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -1002,18 +1226,19 @@ func (db *Neo4jDatabase) GetObjectNodeRelationship(ctx context.Context, id strin
 }
 
 func (db *Neo4jDatabase) GetObjectNodeOutgoingRelationships(ctx context.Context, fromObjectNodeId string) (*model.ObjectRelationshipsResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	ctx, done := instrument(ctx, "GetObjectNodeOutgoingRelationships")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
 	defer session.Close(ctx)
 
 	query := ` MATCH (fromObjectNode {_id:$fromObjectNodeId}) - [relationship] -> () RETURN relationship`
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"fromObjectNodeId": fromObjectNodeId,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := readQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -1048,18 +1273,19 @@ func (db *Neo4jDatabase) GetObjectNodeOutgoingRelationships(ctx context.Context,
 }
 
 func (db *Neo4jDatabase) GetObjectNodeIncomingRelationships(ctx context.Context, toObjectNodeId string) (*model.ObjectRelationshipsResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	ctx, done := instrument(ctx, "GetObjectNodeIncomingRelationships")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
 	defer session.Close(ctx)
 
 	query := ` MATCH () - [relationship] -> (toObjectNode{_id:$toObjectNodeId}) RETURN relationship`
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"toObjectNodeId": toObjectNodeId,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := readQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -1094,18 +1320,19 @@ func (db *Neo4jDatabase) GetObjectNodeIncomingRelationships(ctx context.Context,
 }
 
 func (db *Neo4jDatabase) GetDomainSchemaNode(ctx context.Context, id string) (*model.DomainSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	ctx, done := instrument(ctx, "GetDomainSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
 	defer session.Close(ctx)
 
 	query := `MATCH (schemaDomainNode:DOMAIN_SCHEMA {_id: $id}) RETURN schemaDomainNode`
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id": id,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := readQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -1136,7 +1363,10 @@ func (db *Neo4jDatabase) GetDomainSchemaNode(ctx context.Context, id string) (*m
 }
 
 func (db *Neo4jDatabase) GetDomainSchemaNodes(ctx context.Context) (*model.DomainSchemaNodesResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	ctx, done := instrument(ctx, "GetDomainSchemaNodes")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
 	defer session.Close(ctx)
 
 	query := `
@@ -1144,7 +1374,7 @@ func (db *Neo4jDatabase) GetDomainSchemaNodes(ctx context.Context) (*model.Domai
 		RETURN schemaDomainNode
 	`
 
-	result, err := session.Run(ctx, query, nil)
+	result, err := readQuery(ctx, session, query, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1178,47 +1408,26 @@ func (db *Neo4jDatabase) GetDomainSchemaNodes(ctx context.Context) (*model.Domai
 }
 
 func (db *Neo4jDatabase) CreateDomainSchemaNode(ctx context.Context, domain string) (*model.DomainSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "CreateDomainSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	id := utils.GenerateId()
 	domain = strings.Trim(domain, " ")
 
 	query := `
-		CREATE CONSTRAINT domain_schema_node_key IF NOT EXISTS
-		FOR (n:DOMAIN_SCHEMA)
-		REQUIRE (n._id) IS NODE KEY
-		`
-
-	_, err := session.Run(ctx, query, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	query = `
-		CREATE CONSTRAINT domain_schema_node_unique IF NOT EXISTS
-		FOR (n:DOMAIN_SCHEMA)
-		REQUIRE (n._name, n._type, n._domain) IS UNIQUE
-	`
-
-	_, err = session.Run(ctx, query, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	query = `
 		CREATE (schemaDomainNode:DOMAIN_SCHEMA {_id: $id, _domain: $domain, _type: "DOMAIN SCHEMA", _name: $domain})
 		RETURN schemaDomainNode
 	`
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id":     id,
 		"domain": domain,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -1249,7 +1458,10 @@ func (db *Neo4jDatabase) CreateDomainSchemaNode(ctx context.Context, domain stri
 }
 
 func (db *Neo4jDatabase) RenameDomainSchemaNode(ctx context.Context, id string, newName string) (*model.DomainSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "RenameDomainSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	newName = strings.TrimSpace(newName)
@@ -1276,14 +1488,13 @@ func (db *Neo4jDatabase) RenameDomainSchemaNode(ctx context.Context, id string,
 		size(relationshipSchemaNodes) as relationshipSchemaNodeCount,
 		originalDomainName
 	`
-	fmt.Println(query)
 
 	parameters := map[string]any{
 		"id":      id,
 		"newName": newName,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -1353,8 +1564,25 @@ func (db *Neo4jDatabase) RenameDomainSchemaNode(ctx context.Context, id string,
 	return &model.DomainSchemaNodeResponse{Success: false, Message: &message, DomainSchemaNode: nil}, nil
 }
 
-func (db *Neo4jDatabase) DeleteDomainSchemaNode(ctx context.Context, id string) (*model.DomainSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+// DeleteDomainSchemaNode deletes the domain with everything in it, its external dependencies are handled by crossDomain
+func (db *Neo4jDatabase) DeleteDomainSchemaNode(ctx context.Context, id string, crossDomain model.CrossDomainRule) (*model.DomainSchemaNodeResponse, error) {
+	ctx, done := instrument(ctx, "DeleteDomainSchemaNode")
+	defer done()
+
+	current, err := db.GetDomainSchemaNode(ctx, id)
+	if err != nil || !current.Success {
+		return current, err
+	}
+	dependencies, err := db.domainDependencies(ctx, current.DomainSchemaNode.Domain)
+	if err != nil {
+		return nil, err
+	}
+	if len(dependencies) > 0 && crossDomain != model.CrossDomainRuleCascade {
+		message := fmt.Sprintf("Domain %s has %d external dependencies, delete them first or use crossDomain CASCADE", current.DomainSchemaNode.Domain, len(dependencies))
+		return &model.DomainSchemaNodeResponse{Success: false, Message: &message, DomainSchemaNode: nil}, nil
+	}
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	query := `
@@ -1391,9 +1619,14 @@ func (db *Neo4jDatabase) DeleteDomainSchemaNode(ctx context.Context, id string)
 		"id": id,
 	}
 
-	fmt.Println(query)
-
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeTransaction(ctx, session, func(tx neo4j.ManagedTransaction) (*records, error) {
+		if len(dependencies) > 0 {
+			if err := db.deleteExternalDependencies(ctx, tx, current.DomainSchemaNode.Domain); err != nil {
+				return nil, err
+			}
+		}
+		return transactionQuery(ctx, tx, query, parameters)
+	})
 	if err != nil {
 		message := fmt.Sprintf("Domain schema node with id %s deletion failed: Error: %s", id, err.Error())
 		return &model.DomainSchemaNodeResponse{Success: false, Message: &message, DomainSchemaNode: nil}, nil
@@ -1460,11 +1693,17 @@ func (db *Neo4jDatabase) DeleteDomainSchemaNode(ctx context.Context, id string)
 		return &model.DomainSchemaNodeResponse{Success: false, Message: &message, DomainSchemaNode: nil}, nil
 	}
 	message := fmt.Sprintf("Domain schema node %s deleted successfully. %d type nodes, %d relationship nodes, %d object nodes deleted.", data.Name, typeCountInt, relationshipCountInt, objectCountInt)
+	if len(dependencies) > 0 {
+		message = fmt.Sprintf("%s %d external dependencies deleted.", message, len(dependencies))
+	}
 	return &model.DomainSchemaNodeResponse{Success: true, Message: &message, DomainSchemaNode: data}, nil
 }
 
 func (db *Neo4jDatabase) CreateTypeSchemaNode(ctx context.Context, domain string, name string) (*model.TypeSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "CreateTypeSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	id := utils.GenerateId()
@@ -1473,32 +1712,9 @@ func (db *Neo4jDatabase) CreateTypeSchemaNode(ctx context.Context, domain string
 	name = utils.RemoveSpacesAndUpperCase(name)
 
 	query := `
-		CREATE CONSTRAINT type_schema_node_key IF NOT EXISTS
-		FOR (n:TYPE_SCHEMA)
-		REQUIRE (n._id) IS NODE KEY
-		`
-
-	_, err := session.Run(ctx, query, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	query = `
-	CREATE CONSTRAINT type_schema_node_unique IF NOT EXISTS
-	FOR (n:TYPE_SCHEMA)
-	REQUIRE (n._name, n._type, n._domain) IS UNIQUE
-	`
-
-	_, err = session.Run(ctx, query, nil)
-	if err != nil {
-		return nil, err
-	}
-
-	query = `
 		CREATE (schemaTypeNode:TYPE_SCHEMA {_id: $id, _domain: $domain, _type: "TYPE SCHEMA", _name: $name, _originalName: $originalName})
 		RETURN schemaTypeNode
 	`
-	fmt.Println(query)
 
 	parameters := map[string]any{
 		"id":           id,
@@ -1507,7 +1723,7 @@ func (db *Neo4jDatabase) CreateTypeSchemaNode(ctx context.Context, domain string
 		"originalName": originalName,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -1523,13 +1739,21 @@ func (db *Neo4jDatabase) CreateTypeSchemaNode(ctx context.Context, domain string
 			return nil, fmt.Errorf("unexpected type for schemaTypeNode: %T", schemaTypeNode)
 		}
 		data := &model.TypeSchemaNode{
-			ID:           utils.PopString(neo4jSchemaTypeNode.Props, "_id"),
-			Domain:       utils.PopString(neo4jSchemaTypeNode.Props, "_domain"),
-			Name:         utils.PopString(neo4jSchemaTypeNode.Props, "_name"),
-			OriginalName: utils.PopString(neo4jSchemaTypeNode.Props, "_originalName"),
-			Type:         utils.PopString(neo4jSchemaTypeNode.Props, "_type"),
-			Properties:   utils.ExtractPropertiesFromNeo4jNode(neo4jSchemaTypeNode.Props),
-			Labels:       neo4jSchemaTypeNode.Labels,
+			ID:                     utils.PopString(neo4jSchemaTypeNode.Props, "_id"),
+			Domain:                 utils.PopString(neo4jSchemaTypeNode.Props, "_domain"),
+			Name:                   utils.PopString(neo4jSchemaTypeNode.Props, "_name"),
+			OriginalName:           utils.PopString(neo4jSchemaTypeNode.Props, "_originalName"),
+			Type:                   utils.PopString(neo4jSchemaTypeNode.Props, "_type"),
+			JSONSchemas:            utils.PopJSONSchemas(neo4jSchemaTypeNode.Props),
+			Constraints:            utils.PopPropertyConstraints(neo4jSchemaTypeNode.Props),
+			ComputedProperties:     utils.PopComputedProperties(neo4jSchemaTypeNode.Props),
+			ParentTypeSchemaNodeID: utils.PopParentTypeSchemaNodeID(neo4jSchemaTypeNode.Props),
+			Properties:             utils.ExtractPropertiesFromNeo4jNode(neo4jSchemaTypeNode.Props),
+			Labels:                 neo4jSchemaTypeNode.Labels,
+		}
+		// Object nodes of the new type carry its label, create the label constraints before the first one is written
+		if err := db.ensureLabelConstraints(ctx, typeLabel(data.Name)); err != nil {
+			return nil, err
 		}
 		message := "Schema type node created successfully"
 		return &model.TypeSchemaNodeResponse{Success: true, Message: &message, TypeSchemaNode: data}, nil
@@ -1539,13 +1763,20 @@ func (db *Neo4jDatabase) CreateTypeSchemaNode(ctx context.Context, domain string
 }
 
 func (db *Neo4jDatabase) RenameTypeSchemaNode(ctx context.Context, id string, newName string) (*model.TypeSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "RenameTypeSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	originalNewName := strings.TrimSpace(newName)
 	newName = strings.ToUpper(originalNewName)
 	newLabel := utils.RemoveSpacesAndHyphens(newName)
 
+	if err := db.ensureLabelConstraints(ctx, newLabel); err != nil {
+		return nil, err
+	}
+
 	// Single query to check existence, update schema node and object nodes
 
 	query := fmt.Sprintf(`
@@ -1571,7 +1802,7 @@ func (db *Neo4jDatabase) RenameTypeSchemaNode(ctx context.Context, id string, ne
 		"originalNewName": originalNewName,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -1603,13 +1834,36 @@ func (db *Neo4jDatabase) RenameTypeSchemaNode(ctx context.Context, id string, ne
 			return nil, fmt.Errorf("failed to retrieve the previousName")
 		}
 		data := &model.TypeSchemaNode{
-			ID:           utils.PopString(neo4jTypeSchemaNode.Props, "_id"),
-			Domain:       utils.PopString(neo4jTypeSchemaNode.Props, "_domain"),
-			Name:         utils.PopString(neo4jTypeSchemaNode.Props, "_name"),
-			OriginalName: utils.PopString(neo4jTypeSchemaNode.Props, "_originalName"),
-			Type:         utils.PopString(neo4jTypeSchemaNode.Props, "_type"),
-			Properties:   utils.ExtractPropertiesFromNeo4jNode(neo4jTypeSchemaNode.Props),
-			Labels:       neo4jTypeSchemaNode.Labels,
+			ID:                     utils.PopString(neo4jTypeSchemaNode.Props, "_id"),
+			Domain:                 utils.PopString(neo4jTypeSchemaNode.Props, "_domain"),
+			Name:                   utils.PopString(neo4jTypeSchemaNode.Props, "_name"),
+			OriginalName:           utils.PopString(neo4jTypeSchemaNode.Props, "_originalName"),
+			Type:                   utils.PopString(neo4jTypeSchemaNode.Props, "_type"),
+			JSONSchemas:            utils.PopJSONSchemas(neo4jTypeSchemaNode.Props),
+			Constraints:            utils.PopPropertyConstraints(neo4jTypeSchemaNode.Props),
+			ComputedProperties:     utils.PopComputedProperties(neo4jTypeSchemaNode.Props),
+			ParentTypeSchemaNodeID: utils.PopParentTypeSchemaNodeID(neo4jTypeSchemaNode.Props),
+			Properties:             utils.ExtractPropertiesFromNeo4jNode(neo4jTypeSchemaNode.Props),
+			Labels:                 neo4jTypeSchemaNode.Labels,
+		}
+		if err := db.ensurePointIndexes(ctx, data); err != nil {
+			return nil, err
+		}
+		if previousNameString != data.Name {
+			if err := db.relabelSubtypes(ctx, session, data, previousNameString); err != nil {
+				return nil, err
+			}
+		}
+		for _, constraint := range data.Constraints {
+			if typeLabel(previousNameString) == typeLabel(data.Name) {
+				break
+			}
+			if err := db.dropUniqueConstraints(ctx, session, previousNameString, constraint.Key); err != nil {
+				return nil, err
+			}
+		}
+		if err := db.ensureUniqueConstraints(ctx, data); err != nil {
+			return nil, err
 		}
 		message := fmt.Sprintf("Schema type node renamed from %s to %s, %d object nodes updated", previousNameString, data.Name, updatedCountInt)
 		return &model.TypeSchemaNodeResponse{Success: true, Message: &message, TypeSchemaNode: data}, nil
@@ -1621,7 +1875,10 @@ func (db *Neo4jDatabase) RenameTypeSchemaNode(ctx context.Context, id string, ne
 }
 
 func (db *Neo4jDatabase) UpdatePropertiesOnTypeSchemaNode(ctx context.Context, id string, properties []*model.PropertyInput) (*model.TypeSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "UpdatePropertiesOnTypeSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	err := utils.CleanUpPropertyObjects(&properties)
@@ -1630,18 +1887,16 @@ func (db *Neo4jDatabase) UpdatePropertiesOnTypeSchemaNode(ctx context.Context, i
 		return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, err
 	}
 
-	query := `MATCH (typeSchemaNode:TYPE_SCHEMA {_id: $id}) SET `
-	query = utils.CreatePropertiesQuery(query, properties, "typeSchemaNode")
-	query = strings.TrimSuffix(query, ", ")
-	query += ` RETURN typeSchemaNode`
-
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id": id,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	query := `MATCH (typeSchemaNode:TYPE_SCHEMA {_id: $id}) SET `
+	query = utils.CreatePropertiesQuery(query, parameters, properties, "typeSchemaNode")
+	query = strings.TrimSuffix(query, ", ")
+	query += ` RETURN typeSchemaNode`
+
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -1657,13 +1912,20 @@ func (db *Neo4jDatabase) UpdatePropertiesOnTypeSchemaNode(ctx context.Context, i
 			return nil, fmt.Errorf("unexpected type for typeSchemaNode: %T", typeSchemaNode)
 		}
 		data := &model.TypeSchemaNode{
-			ID:           utils.PopString(neo4jTypeSchemaNode.Props, "_id"),
-			Domain:       utils.PopString(neo4jTypeSchemaNode.Props, "_domain"),
-			Name:         utils.PopString(neo4jTypeSchemaNode.Props, "_name"),
-			OriginalName: utils.PopString(neo4jTypeSchemaNode.Props, "_originalName"),
-			Type:         utils.PopString(neo4jTypeSchemaNode.Props, "_type"),
-			Properties:   utils.ExtractPropertiesFromNeo4jNode(neo4jTypeSchemaNode.Props),
-			Labels:       neo4jTypeSchemaNode.Labels,
+			ID:                     utils.PopString(neo4jTypeSchemaNode.Props, "_id"),
+			Domain:                 utils.PopString(neo4jTypeSchemaNode.Props, "_domain"),
+			Name:                   utils.PopString(neo4jTypeSchemaNode.Props, "_name"),
+			OriginalName:           utils.PopString(neo4jTypeSchemaNode.Props, "_originalName"),
+			Type:                   utils.PopString(neo4jTypeSchemaNode.Props, "_type"),
+			JSONSchemas:            utils.PopJSONSchemas(neo4jTypeSchemaNode.Props),
+			Constraints:            utils.PopPropertyConstraints(neo4jTypeSchemaNode.Props),
+			ComputedProperties:     utils.PopComputedProperties(neo4jTypeSchemaNode.Props),
+			ParentTypeSchemaNodeID: utils.PopParentTypeSchemaNodeID(neo4jTypeSchemaNode.Props),
+			Properties:             utils.ExtractPropertiesFromNeo4jNode(neo4jTypeSchemaNode.Props),
+			Labels:                 neo4jTypeSchemaNode.Labels,
+		}
+		if err := db.ensurePointIndexes(ctx, data); err != nil {
+			return nil, err
 		}
 		message := "Type schema node properties updated successfully"
 		return &model.TypeSchemaNodeResponse{Success: true, Message: &message, TypeSchemaNode: data}, nil
@@ -1673,7 +1935,19 @@ func (db *Neo4jDatabase) UpdatePropertiesOnTypeSchemaNode(ctx context.Context, i
 }
 
 func (db *Neo4jDatabase) DeleteTypeSchemaNode(ctx context.Context, id string) (*model.TypeSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "DeleteTypeSchemaNode")
+	defer done()
+
+	subtypeNames, err := db.subtypeNames(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if len(subtypeNames) > 0 {
+		message := fmt.Sprintf("Type schema node with id %s is extended by %s, remove their parent first", id, strings.Join(subtypeNames, ", "))
+		return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
+	}
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	query := `
@@ -1689,13 +1963,11 @@ func (db *Neo4jDatabase) DeleteTypeSchemaNode(ctx context.Context, id string) (*
 		RETURN objectNodesCount, typeSchemaNodeProperties, typeSchemaNodeLabels
 	`
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id": id,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -1731,13 +2003,17 @@ func (db *Neo4jDatabase) DeleteTypeSchemaNode(ctx context.Context, id string) (*
 			typeSchemaNodeLabelsSliceString[i] = label.(string)
 		}
 		data := &model.TypeSchemaNode{
-			ID:           utils.PopString(typeSchemaNodePropertiesMap, "_id"),
-			Domain:       utils.PopString(typeSchemaNodePropertiesMap, "_domain"),
-			Name:         utils.PopString(typeSchemaNodePropertiesMap, "_name"),
-			OriginalName: utils.PopString(typeSchemaNodePropertiesMap, "_originalName"),
-			Type:         utils.PopString(typeSchemaNodePropertiesMap, "_type"),
-			Properties:   utils.ExtractPropertiesFromNeo4jNode(typeSchemaNodePropertiesMap),
-			Labels:       typeSchemaNodeLabelsSliceString,
+			ID:                     utils.PopString(typeSchemaNodePropertiesMap, "_id"),
+			Domain:                 utils.PopString(typeSchemaNodePropertiesMap, "_domain"),
+			Name:                   utils.PopString(typeSchemaNodePropertiesMap, "_name"),
+			OriginalName:           utils.PopString(typeSchemaNodePropertiesMap, "_originalName"),
+			Type:                   utils.PopString(typeSchemaNodePropertiesMap, "_type"),
+			JSONSchemas:            utils.PopJSONSchemas(typeSchemaNodePropertiesMap),
+			Constraints:            utils.PopPropertyConstraints(typeSchemaNodePropertiesMap),
+			ComputedProperties:     utils.PopComputedProperties(typeSchemaNodePropertiesMap),
+			ParentTypeSchemaNodeID: utils.PopParentTypeSchemaNodeID(typeSchemaNodePropertiesMap),
+			Properties:             utils.ExtractPropertiesFromNeo4jNode(typeSchemaNodePropertiesMap),
+			Labels:                 typeSchemaNodeLabelsSliceString,
 		}
 		message := fmt.Sprintf("Type schema node '%s' deleted successfully. %v object nodes deleted successfully", data.Name, objectNodesCountInt)
 		return &model.TypeSchemaNodeResponse{Success: true, Message: &message, TypeSchemaNode: data}, nil
@@ -1747,30 +2023,41 @@ func (db *Neo4jDatabase) DeleteTypeSchemaNode(ctx context.Context, id string) (*
 
 }
 
-func (db *Neo4jDatabase) RemovePropertiesFromTypeSchemaNode(ctx context.Context, id string, properties []string) (*model.TypeSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+// RemovePropertiesFromTypeSchemaNode removes properties from the type schema node and, when propagate is
+// set, from every object node of its domain and type that has any of them
+func (db *Neo4jDatabase) RemovePropertiesFromTypeSchemaNode(ctx context.Context, id string, properties []string, propagate bool) (*model.TypeSchemaNodeResponse, error) {
+	ctx, done := instrument(ctx, "RemovePropertiesFromTypeSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	if err := utils.CleanUpPropertyKeys(&properties); err != nil {
 		return nil, err
 	}
 
-	query := `MATCH (schemaTypeNode:TYPE_SCHEMA {_id: $id}) `
-	query += `OPTIONAL MATCH (objectNodes {_domain: schemaTypeNode._domain, _type: schemaTypeNode._name}) WHERE NOT objectNodes:RELATIONSHIP_SCHEMA AND NOT objectNodes:DOMAIN_SCHEMA AND NOT objectNodes:TYPE_SCHEMA SET `
+	query := `MATCH (schemaTypeNode:TYPE_SCHEMA {_id: $id}) SET `
 	query = utils.RemovePropertiesQuery(query, properties, "schemaTypeNode")
+	for _, property := range properties {
+		query = utils.RemovePropertiesQuery(query, []string{utils.JSONSchemaKey(property), utils.PropertyConstraintKey(property)}, "schemaTypeNode")
+	}
+	query = strings.TrimSuffix(query, "SET ")
+	query = strings.TrimSuffix(query, ", ")
+	query += ` WITH schemaTypeNode`
+	query += ` OPTIONAL MATCH (objectNodes) WHERE ` + objectNodeOfType("objectNodes", "schemaTypeNode") + ` AND $propagate AND any(key IN $properties WHERE objectNodes[key] IS NOT NULL) AND NOT objectNodes:RELATIONSHIP_SCHEMA AND NOT objectNodes:DOMAIN_SCHEMA AND NOT objectNodes:TYPE_SCHEMA SET `
 	query = utils.RemovePropertiesQuery(query, properties, "objectNodes")
 	query = strings.TrimSuffix(query, "SET ")
 	query = strings.TrimSuffix(query, ", ")
 	query += ` WITH schemaTypeNode, count(objectNodes) as count`
 	query += ` RETURN schemaTypeNode, count`
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
-		"id": id,
+		"id":         id,
+		"properties": properties,
+		"propagate":  propagate,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -1795,15 +2082,22 @@ func (db *Neo4jDatabase) RemovePropertiesFromTypeSchemaNode(ctx context.Context,
 		}
 
 		data := &model.TypeSchemaNode{
-			ID:           utils.PopString(neo4jSchemaTypeNode.Props, "_id"),
-			Domain:       utils.PopString(neo4jSchemaTypeNode.Props, "_domain"),
-			Name:         utils.PopString(neo4jSchemaTypeNode.Props, "_name"),
-			OriginalName: utils.PopString(neo4jSchemaTypeNode.Props, "_originalName"),
-			Type:         utils.PopString(neo4jSchemaTypeNode.Props, "_type"),
-			Properties:   utils.ExtractPropertiesFromNeo4jNode(neo4jSchemaTypeNode.Props),
-			Labels:       neo4jSchemaTypeNode.Labels,
-		}
-		message := fmt.Sprintf("%v properties removed from schema type node of type %s. %v object nodes updated successfully", len(properties), data.Name, countInt)
+			ID:                     utils.PopString(neo4jSchemaTypeNode.Props, "_id"),
+			Domain:                 utils.PopString(neo4jSchemaTypeNode.Props, "_domain"),
+			Name:                   utils.PopString(neo4jSchemaTypeNode.Props, "_name"),
+			OriginalName:           utils.PopString(neo4jSchemaTypeNode.Props, "_originalName"),
+			Type:                   utils.PopString(neo4jSchemaTypeNode.Props, "_type"),
+			JSONSchemas:            utils.PopJSONSchemas(neo4jSchemaTypeNode.Props),
+			Constraints:            utils.PopPropertyConstraints(neo4jSchemaTypeNode.Props),
+			ComputedProperties:     utils.PopComputedProperties(neo4jSchemaTypeNode.Props),
+			ParentTypeSchemaNodeID: utils.PopParentTypeSchemaNodeID(neo4jSchemaTypeNode.Props),
+			Properties:             utils.ExtractPropertiesFromNeo4jNode(neo4jSchemaTypeNode.Props),
+			Labels:                 neo4jSchemaTypeNode.Labels,
+		}
+		if err := db.dropUniqueConstraints(ctx, session, data.Name, properties...); err != nil {
+			return nil, err
+		}
+		message := fmt.Sprintf("%v properties removed from schema type node of type %s, %s", len(properties), data.Name, propagatedMessage(propagate, countInt, "object nodes"))
 		return &model.TypeSchemaNodeResponse{Success: true, Message: &message, TypeSchemaNode: data}, nil
 	}
 	message := fmt.Sprintf("Unable to remove properties from schema type node %s", id)
@@ -1811,7 +2105,10 @@ func (db *Neo4jDatabase) RemovePropertiesFromTypeSchemaNode(ctx context.Context,
 }
 
 func (db *Neo4jDatabase) GetTypeSchemaNodes(ctx context.Context, domain *string) (*model.TypeSchemaNodesResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	ctx, done := instrument(ctx, "GetTypeSchemaNodes")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
 	defer session.Close(ctx)
 
 	query := ``
@@ -1829,13 +2126,11 @@ func (db *Neo4jDatabase) GetTypeSchemaNodes(ctx context.Context, domain *string)
 	`
 	}
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"domain": domain,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := readQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -1852,13 +2147,17 @@ func (db *Neo4jDatabase) GetTypeSchemaNodes(ctx context.Context, domain *string)
 			return nil, fmt.Errorf("unexpected type for schemaTypeNode: %T", schemaTypeNode)
 		}
 		data = append(data, &model.TypeSchemaNode{
-			ID:           utils.PopString(neo4jSchemaTypeNode.Props, "_id"),
-			Name:         utils.PopString(neo4jSchemaTypeNode.Props, "_name"),
-			Type:         utils.PopString(neo4jSchemaTypeNode.Props, "_type"),
-			Domain:       utils.PopString(neo4jSchemaTypeNode.Props, "_domain"),
-			OriginalName: utils.PopString(neo4jSchemaTypeNode.Props, "_originalName"),
-			Properties:   utils.ExtractPropertiesFromNeo4jNode(neo4jSchemaTypeNode.Props),
-			Labels:       neo4jSchemaTypeNode.Labels,
+			ID:                     utils.PopString(neo4jSchemaTypeNode.Props, "_id"),
+			Name:                   utils.PopString(neo4jSchemaTypeNode.Props, "_name"),
+			Type:                   utils.PopString(neo4jSchemaTypeNode.Props, "_type"),
+			Domain:                 utils.PopString(neo4jSchemaTypeNode.Props, "_domain"),
+			OriginalName:           utils.PopString(neo4jSchemaTypeNode.Props, "_originalName"),
+			JSONSchemas:            utils.PopJSONSchemas(neo4jSchemaTypeNode.Props),
+			Constraints:            utils.PopPropertyConstraints(neo4jSchemaTypeNode.Props),
+			ComputedProperties:     utils.PopComputedProperties(neo4jSchemaTypeNode.Props),
+			ParentTypeSchemaNodeID: utils.PopParentTypeSchemaNodeID(neo4jSchemaTypeNode.Props),
+			Properties:             utils.ExtractPropertiesFromNeo4jNode(neo4jSchemaTypeNode.Props),
+			Labels:                 neo4jSchemaTypeNode.Labels,
 		})
 	}
 	if len(data) == 0 {
@@ -1870,18 +2169,19 @@ func (db *Neo4jDatabase) GetTypeSchemaNodes(ctx context.Context, domain *string)
 }
 
 func (db *Neo4jDatabase) GetTypeSchemaNode(ctx context.Context, id string) (*model.TypeSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	ctx, done := instrument(ctx, "GetTypeSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
 	defer session.Close(ctx)
 
 	query := `MATCH (schemaTypeNode:TYPE_SCHEMA {_id: $id}) RETURN schemaTypeNode`
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id": id,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := readQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -1897,13 +2197,17 @@ func (db *Neo4jDatabase) GetTypeSchemaNode(ctx context.Context, id string) (*mod
 			return nil, fmt.Errorf("unexpected type for schemaTypeNode: %T", schemaTypeNode)
 		}
 		data := &model.TypeSchemaNode{
-			ID:           utils.PopString(neo4jSchemaTypeNode.Props, "_id"),
-			Name:         utils.PopString(neo4jSchemaTypeNode.Props, "_name"),
-			Type:         utils.PopString(neo4jSchemaTypeNode.Props, "_type"),
-			Domain:       utils.PopString(neo4jSchemaTypeNode.Props, "_domain"),
-			OriginalName: utils.PopString(neo4jSchemaTypeNode.Props, "_originalName"),
-			Properties:   utils.ExtractPropertiesFromNeo4jNode(neo4jSchemaTypeNode.Props),
-			Labels:       neo4jSchemaTypeNode.Labels,
+			ID:                     utils.PopString(neo4jSchemaTypeNode.Props, "_id"),
+			Name:                   utils.PopString(neo4jSchemaTypeNode.Props, "_name"),
+			Type:                   utils.PopString(neo4jSchemaTypeNode.Props, "_type"),
+			Domain:                 utils.PopString(neo4jSchemaTypeNode.Props, "_domain"),
+			OriginalName:           utils.PopString(neo4jSchemaTypeNode.Props, "_originalName"),
+			JSONSchemas:            utils.PopJSONSchemas(neo4jSchemaTypeNode.Props),
+			Constraints:            utils.PopPropertyConstraints(neo4jSchemaTypeNode.Props),
+			ComputedProperties:     utils.PopComputedProperties(neo4jSchemaTypeNode.Props),
+			ParentTypeSchemaNodeID: utils.PopParentTypeSchemaNodeID(neo4jSchemaTypeNode.Props),
+			Properties:             utils.ExtractPropertiesFromNeo4jNode(neo4jSchemaTypeNode.Props),
+			Labels:                 neo4jSchemaTypeNode.Labels,
 		}
 		message := "Schema type node retrieved successfully"
 		return &model.TypeSchemaNodeResponse{Success: true, Message: &message, TypeSchemaNode: data}, nil
@@ -1912,8 +2216,13 @@ func (db *Neo4jDatabase) GetTypeSchemaNode(ctx context.Context, id string) (*mod
 	return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
 }
 
-func (db *Neo4jDatabase) RenamePropertyOnTypeSchemaNode(ctx context.Context, id string, oldPropertyName string, newPropertyName string) (*model.TypeSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+// RenamePropertyOnTypeSchemaNode renames a property on the type schema node and, when propagate is set,
+// on every object node of its domain and type that has it
+func (db *Neo4jDatabase) RenamePropertyOnTypeSchemaNode(ctx context.Context, id string, oldPropertyName string, newPropertyName string, propagate bool) (*model.TypeSchemaNodeResponse, error) {
+	ctx, done := instrument(ctx, "RenamePropertyOnTypeSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	oldPropertyName = strings.ReplaceAll(strings.TrimSpace(strings.ToLower(oldPropertyName)), " ", "_")
@@ -1928,22 +2237,26 @@ func (db *Neo4jDatabase) RenamePropertyOnTypeSchemaNode(ctx context.Context, id
 	}
 
 	query := fmt.Sprintf(`MATCH (schemaTypeNode:TYPE_SCHEMA {_id: $id}) WHERE schemaTypeNode.%s IS NULL `, newPropertyName)
-	query += `OPTIONAL MATCH (objectNodes {_domain: schemaTypeNode._domain, _type: schemaTypeNode._name}) SET `
+	query += `SET `
 	query = utils.RenamePropertyQuery(query, oldPropertyName, newPropertyName, "schemaTypeNode")
+	query = utils.RenamePropertyQuery(query, utils.JSONSchemaKey(oldPropertyName), utils.JSONSchemaKey(newPropertyName), "schemaTypeNode")
+	query = utils.RenamePropertyQuery(query, utils.PropertyConstraintKey(oldPropertyName), utils.PropertyConstraintKey(newPropertyName), "schemaTypeNode")
+	query = strings.TrimSuffix(query, ", ")
+	query += ` WITH schemaTypeNode`
+	query += fmt.Sprintf(` OPTIONAL MATCH (objectNodes) WHERE `+objectNodeOfType("objectNodes", "schemaTypeNode")+` AND $propagate AND objectNodes.%s IS NOT NULL AND NOT objectNodes:RELATIONSHIP_SCHEMA AND NOT objectNodes:DOMAIN_SCHEMA AND NOT objectNodes:TYPE_SCHEMA SET `, oldPropertyName)
 	query = utils.RenamePropertyQuery(query, oldPropertyName, newPropertyName, "objectNodes")
 	query = strings.TrimSuffix(query, ", ")
 	query += ` WITH schemaTypeNode, count(objectNodes) as count`
 	query += ` RETURN schemaTypeNode, count`
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id":              id,
 		"oldPropertyName": oldPropertyName,
 		"newPropertyName": newPropertyName,
+		"propagate":       propagate,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -1968,15 +2281,28 @@ func (db *Neo4jDatabase) RenamePropertyOnTypeSchemaNode(ctx context.Context, id
 			return nil, fmt.Errorf("unexpected type for count: %T", count)
 		}
 		data := &model.TypeSchemaNode{
-			ID:           utils.PopString(neo4jSchemaTypeNode.GetProperties(), "_id"),
-			Name:         utils.PopString(neo4jSchemaTypeNode.GetProperties(), "_name"),
-			Type:         utils.PopString(neo4jSchemaTypeNode.GetProperties(), "_type"),
-			Domain:       utils.PopString(neo4jSchemaTypeNode.GetProperties(), "_domain"),
-			OriginalName: utils.PopString(neo4jSchemaTypeNode.GetProperties(), "_originalName"),
-			Properties:   utils.ExtractPropertiesFromNeo4jNode(neo4jSchemaTypeNode.Props),
-			Labels:       neo4jSchemaTypeNode.Labels,
-		}
-		message := fmt.Sprintf("%s property renamed to %s on schema type node of type %s. %v object nodes updated successfully", oldPropertyName, newPropertyName, data.Name, countInt)
+			ID:                     utils.PopString(neo4jSchemaTypeNode.GetProperties(), "_id"),
+			Name:                   utils.PopString(neo4jSchemaTypeNode.GetProperties(), "_name"),
+			Type:                   utils.PopString(neo4jSchemaTypeNode.GetProperties(), "_type"),
+			Domain:                 utils.PopString(neo4jSchemaTypeNode.GetProperties(), "_domain"),
+			OriginalName:           utils.PopString(neo4jSchemaTypeNode.GetProperties(), "_originalName"),
+			JSONSchemas:            utils.PopJSONSchemas(neo4jSchemaTypeNode.Props),
+			Constraints:            utils.PopPropertyConstraints(neo4jSchemaTypeNode.Props),
+			ComputedProperties:     utils.PopComputedProperties(neo4jSchemaTypeNode.Props),
+			ParentTypeSchemaNodeID: utils.PopParentTypeSchemaNodeID(neo4jSchemaTypeNode.Props),
+			Properties:             utils.ExtractPropertiesFromNeo4jNode(neo4jSchemaTypeNode.Props),
+			Labels:                 neo4jSchemaTypeNode.Labels,
+		}
+		if err := db.ensurePointIndexes(ctx, data); err != nil {
+			return nil, err
+		}
+		if err := db.dropUniqueConstraints(ctx, session, data.Name, oldPropertyName); err != nil {
+			return nil, err
+		}
+		if err := db.ensureUniqueConstraints(ctx, data); err != nil {
+			return nil, err
+		}
+		message := fmt.Sprintf("%s property renamed to %s on schema type node of type %s, %s", oldPropertyName, newPropertyName, data.Name, propagatedMessage(propagate, countInt, "object nodes"))
 		return &model.TypeSchemaNodeResponse{Success: true, Message: &message, TypeSchemaNode: data}, nil
 	}
 	message := fmt.Sprintf("Unable to rename property '%s' on schema type node with id '%s'. Either the new property '%s' already exists or the node id '%s' is not valid.", oldPropertyName, id, newPropertyName, id)
@@ -1984,7 +2310,10 @@ func (db *Neo4jDatabase) RenamePropertyOnTypeSchemaNode(ctx context.Context, id
 }
 
 func (db *Neo4jDatabase) CreateRelationshipSchemaNode(ctx context.Context, name string, domain string, fromTypeSchemaNodeId string, toTypeSchemaNodeId string) (*model.RelationshipSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "CreateRelationshipSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	id := utils.GenerateId()
@@ -1992,41 +2321,29 @@ func (db *Neo4jDatabase) CreateRelationshipSchemaNode(ctx context.Context, name
 	originalName := strings.TrimSpace(name)
 	name = utils.RemoveSpacesAndHyphens(strings.ToUpper(name))
 
-	query := `
-		CREATE CONSTRAINT relationship_schema_node_key IF NOT EXISTS
-		FOR (n:RELATIONSHIP_SCHEMA)
-		REQUIRE (n._id) IS NODE KEY
-		`
-
-	fmt.Println(query)
-
-	_, err := session.Run(ctx, query, nil)
+	// The relationship schema node belongs to the domain of one of its type schema nodes, the other one may be in another domain
+	fromDomain, toDomain, err := db.relationshipSchemaNodeDomains(ctx, fromTypeSchemaNodeId, toTypeSchemaNodeId)
 	if err != nil {
-		message := fmt.Sprintf("Unable to create relationship schema constraint. Error: %s", err.Error())
-		return &model.RelationshipSchemaNodeResponse{Success: false, Message: &message, RelationshipSchemaNode: nil}, err
+		return nil, err
 	}
-
-	query = `
-		CREATE CONSTRAINT relationship_schema_node_unique IF NOT EXISTS
-		FOR (n:RELATIONSHIP_SCHEMA)
-		REQUIRE (n._name, n._domain, n._fromTypeSchemaNodeId, n._toTypeSchemaNodeId) IS UNIQUE
-	`
-
-	fmt.Println(query)
-
-	_, err = session.Run(ctx, query, nil)
-	if err != nil {
-		message := fmt.Sprintf("Unable to create relationship schema constraint. Error: %s", err.Error())
-		return &model.RelationshipSchemaNodeResponse{Success: false, Message: &message, RelationshipSchemaNode: nil}, err
+	if fromDomain == "" || toDomain == "" {
+		missingId := fromTypeSchemaNodeId
+		if fromDomain != "" {
+			missingId = toTypeSchemaNodeId
+		}
+		message := fmt.Sprintf("Type schema node with id %s does not exist", missingId)
+		return &model.RelationshipSchemaNodeResponse{Success: false, Message: &message, RelationshipSchemaNode: nil}, nil
+	}
+	if domain != fromDomain && domain != toDomain {
+		message := fmt.Sprintf("Relationship schema %s must belong to domain %s or %s of its type schema nodes, not %s", name, fromDomain, toDomain, domain)
+		return &model.RelationshipSchemaNodeResponse{Success: false, Message: &message, RelationshipSchemaNode: nil}, nil
 	}
 
-	query = `
+	query := `
 		CREATE (relationshipSchemaNode:RELATIONSHIP_SCHEMA {_id: $id, _domain: $domain, _name: $name, _originalName: $originalName, _type: "RELATIONSHIP SCHEMA", _fromTypeSchemaNodeId: $fromTypeSchemaNodeId, _toTypeSchemaNodeId: $toTypeSchemaNodeId})
 		RETURN relationshipSchemaNode
 	`
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id":                   id,
 		"domain":               domain,
@@ -2036,7 +2353,7 @@ func (db *Neo4jDatabase) CreateRelationshipSchemaNode(ctx context.Context, name
 		"toTypeSchemaNodeId":   toTypeSchemaNodeId,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -2059,10 +2376,14 @@ func (db *Neo4jDatabase) CreateRelationshipSchemaNode(ctx context.Context, name
 			Type:                 utils.PopString(neo4jRelationshipSchemaNode.Props, "_type"),
 			FromTypeSchemaNodeID: utils.PopString(neo4jRelationshipSchemaNode.Props, "_fromTypeSchemaNodeId"),
 			ToTypeSchemaNodeID:   utils.PopString(neo4jRelationshipSchemaNode.Props, "_toTypeSchemaNodeId"),
+			Cardinality:          utils.PopRelationshipCardinality(neo4jRelationshipSchemaNode.Props),
 			Properties:           utils.ExtractPropertiesFromNeo4jNode(neo4jRelationshipSchemaNode.Props),
 			Labels:               neo4jRelationshipSchemaNode.Labels,
 		}
 		message := "Relationship schema created successfully"
+		if fromDomain != toDomain {
+			message = fmt.Sprintf("Cross-domain relationship schema created successfully from domain %s to domain %s", fromDomain, toDomain)
+		}
 		return &model.RelationshipSchemaNodeResponse{Success: true, Message: &message, RelationshipSchemaNode: data}, nil
 	}
 	message := "Unable to create relationship schema"
@@ -2070,7 +2391,10 @@ func (db *Neo4jDatabase) CreateRelationshipSchemaNode(ctx context.Context, name
 }
 
 func (db *Neo4jDatabase) RenameRelationshipSchemaNode(ctx context.Context, id string, newName string) (*model.RelationshipSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "RenameRelationshipSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	originalNewName := strings.TrimSpace(newName)
@@ -2099,15 +2423,13 @@ func (db *Neo4jDatabase) RenameRelationshipSchemaNode(ctx context.Context, id st
     RETURN relationshipSchemaNode, updatedCount, previousName
 `, newName)
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id":              id,
 		"newName":         newName,
 		"originalNewName": originalNewName,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -2146,6 +2468,7 @@ func (db *Neo4jDatabase) RenameRelationshipSchemaNode(ctx context.Context, id st
 			Type:                 utils.PopString(neo4jRelationshipSchemaNode.Props, "_type"),
 			FromTypeSchemaNodeID: utils.PopString(neo4jRelationshipSchemaNode.Props, "_fromTypeSchemaNodeId"),
 			ToTypeSchemaNodeID:   utils.PopString(neo4jRelationshipSchemaNode.Props, "_toTypeSchemaNodeId"),
+			Cardinality:          utils.PopRelationshipCardinality(neo4jRelationshipSchemaNode.Props),
 			Properties:           utils.ExtractPropertiesFromNeo4jNode(neo4jRelationshipSchemaNode.Props),
 			Labels:               neo4jRelationshipSchemaNode.Labels,
 		}
@@ -2160,7 +2483,10 @@ func (db *Neo4jDatabase) RenameRelationshipSchemaNode(ctx context.Context, id st
 }
 
 func (db *Neo4jDatabase) UpdatePropertiesOnRelationshipSchemaNode(ctx context.Context, id string, properties []*model.PropertyInput) (*model.RelationshipSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "UpdatePropertiesOnRelationshipSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	if err := utils.CleanUpPropertyObjects(&properties); err != nil {
@@ -2168,18 +2494,16 @@ func (db *Neo4jDatabase) UpdatePropertiesOnRelationshipSchemaNode(ctx context.Co
 		return &model.RelationshipSchemaNodeResponse{Success: false, Message: &message, RelationshipSchemaNode: nil}, nil
 	}
 
-	query := `MATCH (relationshipSchemaNode:RELATIONSHIP_SCHEMA {_id: $id}) SET `
-	query = utils.CreatePropertiesQuery(query, properties, "relationshipSchemaNode")
-	query = strings.TrimSuffix(query, ", ")
-	query += ` RETURN relationshipSchemaNode`
-
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id": id,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	query := `MATCH (relationshipSchemaNode:RELATIONSHIP_SCHEMA {_id: $id}) SET `
+	query = utils.CreatePropertiesQuery(query, parameters, properties, "relationshipSchemaNode")
+	query = strings.TrimSuffix(query, ", ")
+	query += ` RETURN relationshipSchemaNode`
+
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -2202,6 +2526,7 @@ func (db *Neo4jDatabase) UpdatePropertiesOnRelationshipSchemaNode(ctx context.Co
 			Type:                 utils.PopString(neo4jRelationshipSchemaNode.Props, "_type"),
 			FromTypeSchemaNodeID: utils.PopString(neo4jRelationshipSchemaNode.Props, "_fromTypeSchemaNodeId"),
 			ToTypeSchemaNodeID:   utils.PopString(neo4jRelationshipSchemaNode.Props, "_toTypeSchemaNodeId"),
+			Cardinality:          utils.PopRelationshipCardinality(neo4jRelationshipSchemaNode.Props),
 			Properties:           utils.ExtractPropertiesFromNeo4jNode(neo4jRelationshipSchemaNode.Props),
 			Labels:               neo4jRelationshipSchemaNode.Labels,
 		}
@@ -2215,8 +2540,13 @@ func (db *Neo4jDatabase) UpdatePropertiesOnRelationshipSchemaNode(ctx context.Co
 	return &model.RelationshipSchemaNodeResponse{Success: false, Message: &message, RelationshipSchemaNode: nil}, nil
 }
 
-func (db *Neo4jDatabase) RenamePropertyOnRelationshipSchemaNode(ctx context.Context, id string, oldPropertyName string, newPropertyName string) (*model.RelationshipSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+// RenamePropertyOnRelationshipSchemaNode renames a property on the relationship schema node and, when
+// propagate is set, on every object relationship it describes that has it
+func (db *Neo4jDatabase) RenamePropertyOnRelationshipSchemaNode(ctx context.Context, id string, oldPropertyName string, newPropertyName string, propagate bool) (*model.RelationshipSchemaNodeResponse, error) {
+	ctx, done := instrument(ctx, "RenamePropertyOnRelationshipSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	oldPropertyName = utils.RemoveSpacesAndLowerCase(oldPropertyName)
@@ -2235,26 +2565,26 @@ func (db *Neo4jDatabase) RenamePropertyOnRelationshipSchemaNode(ctx context.Cont
     WITH relationshipSchemaNode
     WHERE relationshipSchemaNode.%s IS NOT NULL
     WITH relationshipSchemaNode, relationshipSchemaNode.%s as oldValue
-    SET relationshipSchemaNode.%s = oldValue
-    REMOVE relationshipSchemaNode.%s
+    SET relationshipSchemaNode.%s = oldValue, relationshipSchemaNode.%s = relationshipSchemaNode.%s
+    REMOVE relationshipSchemaNode.%s, relationshipSchemaNode.%s
     WITH relationshipSchemaNode
-    OPTIONAL MATCH ()-[rel {_name: relationshipSchemaNode._name}]->()
+    `+relationshipSchemaNodeObjectRelationshipsQuery+`
+    WHERE $propagate AND rel.%s IS NOT NULL
     WITH relationshipSchemaNode, collect(rel) as relationships, count(rel) as updatedCount
     FOREACH (r IN relationships |
-        SET r.%s = r.%s
-        REMOVE r.%s
+        SET r.%s = r.%s, r.%s = r.%s
+        REMOVE r.%s, r.%s
     )
     RETURN relationshipSchemaNode, updatedCount
-`, oldPropertyName, oldPropertyName, newPropertyName, oldPropertyName,
-		newPropertyName, oldPropertyName, oldPropertyName)
-
-	fmt.Println(query)
+`, oldPropertyName, oldPropertyName, newPropertyName, utils.ArrayTypeKey(newPropertyName), utils.ArrayTypeKey(oldPropertyName), oldPropertyName, utils.ArrayTypeKey(oldPropertyName),
+		oldPropertyName, newPropertyName, oldPropertyName, utils.ArrayTypeKey(newPropertyName), utils.ArrayTypeKey(oldPropertyName), oldPropertyName, utils.ArrayTypeKey(oldPropertyName))
 
 	parameters := map[string]any{
-		"id": id,
+		"id":        id,
+		"propagate": propagate,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -2284,10 +2614,11 @@ func (db *Neo4jDatabase) RenamePropertyOnRelationshipSchemaNode(ctx context.Cont
 			Type:                 utils.PopString(neo4jRelationshipSchemaNode.Props, "_type"),
 			FromTypeSchemaNodeID: utils.PopString(neo4jRelationshipSchemaNode.Props, "_fromTypeSchemaNodeId"),
 			ToTypeSchemaNodeID:   utils.PopString(neo4jRelationshipSchemaNode.Props, "_toTypeSchemaNodeId"),
+			Cardinality:          utils.PopRelationshipCardinality(neo4jRelationshipSchemaNode.Props),
 			Properties:           utils.ExtractPropertiesFromNeo4jNode(neo4jRelationshipSchemaNode.Props),
 			Labels:               neo4jRelationshipSchemaNode.Labels,
 		}
-		message := fmt.Sprintf("%s relationship schema node property renamed to %s. %v object nodes updated successfully", oldPropertyName, newPropertyName, updatedCountInt)
+		message := fmt.Sprintf("%s relationship schema node property renamed to %s, %s", oldPropertyName, newPropertyName, propagatedMessage(propagate, updatedCountInt, "object relationships"))
 		return &model.RelationshipSchemaNodeResponse{Success: true, Message: &message, RelationshipSchemaNode: data}, nil
 	}
 	if result.Err() != nil {
@@ -2297,8 +2628,13 @@ func (db *Neo4jDatabase) RenamePropertyOnRelationshipSchemaNode(ctx context.Cont
 	return &model.RelationshipSchemaNodeResponse{Success: false, Message: &message, RelationshipSchemaNode: nil}, nil
 }
 
-func (db *Neo4jDatabase) RemovePropertiesFromRelationshipSchemaNode(ctx context.Context, id string, properties []string) (*model.RelationshipSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+// RemovePropertiesFromRelationshipSchemaNode removes properties from the relationship schema node and,
+// when propagate is set, from every object relationship it describes that has any of them
+func (db *Neo4jDatabase) RemovePropertiesFromRelationshipSchemaNode(ctx context.Context, id string, properties []string, propagate bool) (*model.RelationshipSchemaNodeResponse, error) {
+	ctx, done := instrument(ctx, "RemovePropertiesFromRelationshipSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	if err := utils.CleanUpPropertyKeys(&properties); err != nil {
@@ -2307,7 +2643,8 @@ func (db *Neo4jDatabase) RemovePropertiesFromRelationshipSchemaNode(ctx context.
 	}
 
 	query := `MATCH (relationshipSchemaNode:RELATIONSHIP_SCHEMA {_id: $id}) `
-	query += `OPTIONAL MATCH ()-[rel {_name: relationshipSchemaNode._name}]->() `
+	query += relationshipSchemaNodeObjectRelationshipsQuery
+	query += ` WHERE $propagate AND any(key IN $properties WHERE rel[key] IS NOT NULL) `
 	query += `WITH relationshipSchemaNode, collect(rel) as relationships, count(rel) as updatedCount `
 	query += `SET `
 	query = utils.RemovePropertiesQuery(query, properties, "relationshipSchemaNode")
@@ -2318,13 +2655,13 @@ func (db *Neo4jDatabase) RemovePropertiesFromRelationshipSchemaNode(ctx context.
 	query = strings.TrimSuffix(query, ", ")
 	query += `) RETURN relationshipSchemaNode, updatedCount`
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
-		"id": id,
+		"id":         id,
+		"properties": properties,
+		"propagate":  propagate,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -2355,10 +2692,11 @@ func (db *Neo4jDatabase) RemovePropertiesFromRelationshipSchemaNode(ctx context.
 			Type:                 utils.PopString(neo4jRelationshipSchemaNode.Props, "_type"),
 			FromTypeSchemaNodeID: utils.PopString(neo4jRelationshipSchemaNode.Props, "_fromTypeSchemaNodeId"),
 			ToTypeSchemaNodeID:   utils.PopString(neo4jRelationshipSchemaNode.Props, "_toTypeSchemaNodeId"),
+			Cardinality:          utils.PopRelationshipCardinality(neo4jRelationshipSchemaNode.Props),
 			Properties:           utils.ExtractPropertiesFromNeo4jNode(neo4jRelationshipSchemaNode.Props),
 			Labels:               neo4jRelationshipSchemaNode.Labels,
 		}
-		message := fmt.Sprintf("%v relationship schema node properties removed successfully. %v object nodes updated successfully", len(properties), updatedCountInt)
+		message := fmt.Sprintf("%v relationship schema node properties removed successfully, %s", len(properties), propagatedMessage(propagate, updatedCountInt, "object relationships"))
 		return &model.RelationshipSchemaNodeResponse{Success: true, Message: &message, RelationshipSchemaNode: data}, nil
 	}
 	if result.Err() != nil {
@@ -2369,7 +2707,10 @@ func (db *Neo4jDatabase) RemovePropertiesFromRelationshipSchemaNode(ctx context.
 }
 
 func (db *Neo4jDatabase) DeleteRelationshipSchemaNode(ctx context.Context, id string) (*model.RelationshipSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
+	ctx, done := instrument(ctx, "DeleteRelationshipSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
 	defer session.Close(ctx)
 
 	query := `
@@ -2383,13 +2724,11 @@ func (db *Neo4jDatabase) DeleteRelationshipSchemaNode(ctx context.Context, id st
     RETURN relationshipsCount, relationshipSchemaNodeProperties, relationshipSchemaNodeLabels
 `
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id": id,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := writeQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -2431,6 +2770,7 @@ func (db *Neo4jDatabase) DeleteRelationshipSchemaNode(ctx context.Context, id st
 			Type:                 utils.PopString(relationshipSchemaNodePropertiesMap, "_type"),
 			FromTypeSchemaNodeID: utils.PopString(relationshipSchemaNodePropertiesMap, "_fromTypeSchemaNodeId"),
 			ToTypeSchemaNodeID:   utils.PopString(relationshipSchemaNodePropertiesMap, "_toTypeSchemaNodeId"),
+			Cardinality:          utils.PopRelationshipCardinality(relationshipSchemaNodePropertiesMap),
 			Properties:           utils.ExtractPropertiesFromNeo4jNode(relationshipSchemaNodePropertiesMap),
 			Labels:               labels,
 		}
@@ -2446,7 +2786,10 @@ func (db *Neo4jDatabase) DeleteRelationshipSchemaNode(ctx context.Context, id st
 }
 
 func (db *Neo4jDatabase) GetTypeSchemaNodeOutgoingRelationships(ctx context.Context, id string) (*model.RelationshipSchemaNodesResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	ctx, done := instrument(ctx, "GetTypeSchemaNodeOutgoingRelationships")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
 	defer session.Close(ctx)
 
 	query := `
@@ -2464,13 +2807,11 @@ func (db *Neo4jDatabase) GetTypeSchemaNodeOutgoingRelationships(ctx context.Cont
         END as relationshipsCount
 	`
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id": id,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := readQuery(ctx, session, query, parameters)
 	if err != nil {
 		message := fmt.Sprintf("Unable to get type schema node outgoing relationships. Error: %s", err.Error())
 		return &model.RelationshipSchemaNodesResponse{Success: false, Message: &message, RelationshipSchemaNodes: nil}, nil
@@ -2507,6 +2848,7 @@ func (db *Neo4jDatabase) GetTypeSchemaNodeOutgoingRelationships(ctx context.Cont
 				Type:                 utils.PopString(neo4jRelationshipSchemaNode.Props, "_type"),
 				FromTypeSchemaNodeID: utils.PopString(neo4jRelationshipSchemaNode.Props, "_fromTypeSchemaNodeId"),
 				ToTypeSchemaNodeID:   utils.PopString(neo4jRelationshipSchemaNode.Props, "_toTypeSchemaNodeId"),
+				Cardinality:          utils.PopRelationshipCardinality(neo4jRelationshipSchemaNode.Props),
 				Properties:           utils.ExtractPropertiesFromNeo4jNode(neo4jRelationshipSchemaNode.Props),
 				Labels:               neo4jRelationshipSchemaNode.Labels,
 			})
@@ -2523,7 +2865,10 @@ func (db *Neo4jDatabase) GetTypeSchemaNodeOutgoingRelationships(ctx context.Cont
 }
 
 func (db *Neo4jDatabase) GetTypeSchemaNodeIncomingRelationships(ctx context.Context, id string) (*model.RelationshipSchemaNodesResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	ctx, done := instrument(ctx, "GetTypeSchemaNodeIncomingRelationships")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
 	defer session.Close(ctx)
 
 	query := `
@@ -2541,13 +2886,11 @@ func (db *Neo4jDatabase) GetTypeSchemaNodeIncomingRelationships(ctx context.Cont
         END as relationshipsCount
 	`
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id": id,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := readQuery(ctx, session, query, parameters)
 	if err != nil {
 		message := fmt.Sprintf("Unable to get type schema node outgoing relationships. Error: %s", err.Error())
 		return &model.RelationshipSchemaNodesResponse{Success: false, Message: &message, RelationshipSchemaNodes: nil}, nil
@@ -2584,6 +2927,7 @@ func (db *Neo4jDatabase) GetTypeSchemaNodeIncomingRelationships(ctx context.Cont
 				Type:                 utils.PopString(neo4jRelationshipSchemaNode.Props, "_type"),
 				FromTypeSchemaNodeID: utils.PopString(neo4jRelationshipSchemaNode.Props, "_fromTypeSchemaNodeId"),
 				ToTypeSchemaNodeID:   utils.PopString(neo4jRelationshipSchemaNode.Props, "_toTypeSchemaNodeId"),
+				Cardinality:          utils.PopRelationshipCardinality(neo4jRelationshipSchemaNode.Props),
 				Properties:           utils.ExtractPropertiesFromNeo4jNode(neo4jRelationshipSchemaNode.Props),
 				Labels:               neo4jRelationshipSchemaNode.Labels,
 			})
@@ -2600,7 +2944,10 @@ func (db *Neo4jDatabase) GetTypeSchemaNodeIncomingRelationships(ctx context.Cont
 }
 
 func (db *Neo4jDatabase) GetRelationshipSchemaNode(ctx context.Context, id string) (*model.RelationshipSchemaNodeResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	ctx, done := instrument(ctx, "GetRelationshipSchemaNode")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
 	defer session.Close(ctx)
 
 	query := `
@@ -2608,13 +2955,11 @@ func (db *Neo4jDatabase) GetRelationshipSchemaNode(ctx context.Context, id strin
 	RETURN relationshipSchemaNode
 	`
 
-	fmt.Println(query)
-
 	parameters := map[string]any{
 		"id": id,
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := readQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -2637,6 +2982,7 @@ func (db *Neo4jDatabase) GetRelationshipSchemaNode(ctx context.Context, id strin
 			Type:                 utils.PopString(neo4jRelationshipSchemaNode.Props, "_type"),
 			FromTypeSchemaNodeID: utils.PopString(neo4jRelationshipSchemaNode.Props, "_fromTypeSchemaNodeId"),
 			ToTypeSchemaNodeID:   utils.PopString(neo4jRelationshipSchemaNode.Props, "_toTypeSchemaNodeId"),
+			Cardinality:          utils.PopRelationshipCardinality(neo4jRelationshipSchemaNode.Props),
 			Properties:           utils.ExtractPropertiesFromNeo4jNode(neo4jRelationshipSchemaNode.Props),
 			Labels:               neo4jRelationshipSchemaNode.Labels,
 		}
@@ -2651,7 +2997,10 @@ func (db *Neo4jDatabase) GetRelationshipSchemaNode(ctx context.Context, id strin
 }
 
 func (db *Neo4jDatabase) GetRelationshipSchemaNodes(ctx context.Context, domain *string) (*model.RelationshipSchemaNodesResponse, error) {
-	session := db.Driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeRead})
+	ctx, done := instrument(ctx, "GetRelationshipSchemaNodes")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
 	defer session.Close(ctx)
 
 	var query string
@@ -2667,14 +3016,12 @@ func (db *Neo4jDatabase) GetRelationshipSchemaNodes(ctx context.Context, domain
 		`
 	}
 
-	fmt.Println(query)
-
 	parameters := map[string]any{}
 	if domain != nil {
 		parameters["domain"] = domain
 	}
 
-	result, err := session.Run(ctx, query, parameters)
+	result, err := readQuery(ctx, session, query, parameters)
 	if err != nil {
 		return nil, err
 	}
@@ -2698,6 +3045,7 @@ func (db *Neo4jDatabase) GetRelationshipSchemaNodes(ctx context.Context, domain
 			Type:                 utils.PopString(neo4jRelationshipSchemaNode.Props, "_type"),
 			FromTypeSchemaNodeID: utils.PopString(neo4jRelationshipSchemaNode.Props, "_fromTypeSchemaNodeId"),
 			ToTypeSchemaNodeID:   utils.PopString(neo4jRelationshipSchemaNode.Props, "_toTypeSchemaNodeId"),
+			Cardinality:          utils.PopRelationshipCardinality(neo4jRelationshipSchemaNode.Props),
 			Properties:           utils.ExtractPropertiesFromNeo4jNode(neo4jRelationshipSchemaNode.Props),
 			Labels:               neo4jRelationshipSchemaNode.Labels,
 		})
@@ -2715,3 +3063,18 @@ func (db *Neo4jDatabase) GetRelationshipSchemaNodes(ctx context.Context, domain
 	message := fmt.Sprintf("Relationship schema nodes retrieved successfully. %v relationships found", len(data))
 	return &model.RelationshipSchemaNodesResponse{Success: true, Message: &message, RelationshipSchemaNodes: data}, nil
 }
+
+// relationshipSchemaNodeObjectRelationshipsQuery matches, as rel, the object relationships a relationship
+// schema node describes: those of its name between object nodes of its from and to types, or their subtypes
+var relationshipSchemaNodeObjectRelationshipsQuery = `
+	OPTIONAL MATCH (fromTypeSchemaNode:TYPE_SCHEMA {_id: relationshipSchemaNode._fromTypeSchemaNodeId}), (toTypeSchemaNode:TYPE_SCHEMA {_id: relationshipSchemaNode._toTypeSchemaNodeId})
+	OPTIONAL MATCH (fromObjectNode)-[rel {_name: relationshipSchemaNode._name}]->(toObjectNode)
+	WHERE ` + objectNodeOfType("fromObjectNode", "fromTypeSchemaNode") + ` AND ` + objectNodeOfType("toObjectNode", "toTypeSchemaNode")
+
+// propagatedMessage completes a schema property change message with the number of objects changed
+func propagatedMessage(propagate bool, count int64, objects string) string {
+	if !propagate {
+		return fmt.Sprintf("%s left unchanged", objects)
+	}
+	return fmt.Sprintf("%d %s updated", count, objects)
+}
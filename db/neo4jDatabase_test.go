@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+func TestUpdateObjectNodeRenamesAndRemovesPropertiesInOneStatement(t *testing.T) {
+	updated := &neo4j.Record{Keys: []string{"objectNode"}, Values: []any{dbtype.Node{Props: map[string]any{"_id": "server", "_name": "WEB 2", "_originalName": "web 2"}}}}
+	session := &fakeSession{maxAttempts: 1, runs: []func(string) ([]*neo4j.Record, error){
+		returning(updated),
+	}}
+	database := &Neo4jDatabase{Driver: &fakeDriver{session: session}}
+
+	name := " web 2 "
+	response, err := database.UpdateObjectNode(context.Background(), "server", &name, nil, []string{"owner"})
+	if err != nil {
+		t.Fatalf("UpdateObjectNode failed: %v", err)
+	}
+	if !response.Success || response.ObjectNode.Name != "WEB 2" {
+		t.Errorf("got response %v, want the updated object node", response)
+	}
+	if session.writes != 1 || len(session.queries) != 1 {
+		t.Fatalf("ran %d queries in %d write transactions, want 1 in 1", len(session.queries), session.writes)
+	}
+	query := session.queries[0]
+	for _, part := range []string{"SET objectNode._name = $newName", "REMOVE objectNode.owner", "reference._referenceKey IN $removedProperties"} {
+		if !strings.Contains(query, part) {
+			t.Errorf("query %s does not contain %s", query, part)
+		}
+	}
+}
+
+func TestUpdateObjectNodeReportsMissingObjectNodes(t *testing.T) {
+	session := &fakeSession{maxAttempts: 1, runs: []func(string) ([]*neo4j.Record, error){
+		returning(),
+	}}
+	database := &Neo4jDatabase{Driver: &fakeDriver{session: session}}
+
+	name := "web"
+	response, err := database.UpdateObjectNode(context.Background(), "missing", &name, nil, nil)
+	if err != nil {
+		t.Fatalf("UpdateObjectNode failed: %v", err)
+	}
+	if response.Success || response.Message == nil || !strings.Contains(*response.Message, "was not found") {
+		t.Errorf("got response %v, want not found", response)
+	}
+}
@@ -0,0 +1,110 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// ObjectCounts is the number of objects a schema node describes and how many of them have each property
+type ObjectCounts struct {
+	Total      int64
+	Properties map[string]int64
+}
+
+// DomainObjectCounts holds the object counts of a domain, keyed by type schema node name for object
+// nodes and by relationship schema node id for object relationships
+type DomainObjectCounts struct {
+	Types         map[string]*ObjectCounts
+	Relationships map[string]*ObjectCounts
+}
+
+// Type returns the counts of a type, empty when it has no object nodes
+func (c *DomainObjectCounts) Type(name string) *ObjectCounts {
+	if counts, ok := c.Types[name]; ok {
+		return counts
+	}
+	return &ObjectCounts{Properties: map[string]int64{}}
+}
+
+// Relationship returns the counts of a relationship schema node, empty when it has no object relationships
+func (c *DomainObjectCounts) Relationship(id string) *ObjectCounts {
+	if counts, ok := c.Relationships[id]; ok {
+		return counts
+	}
+	return &ObjectCounts{Properties: map[string]int64{}}
+}
+
+// GetDomainObjectCounts counts the object nodes and object relationships of a domain per schema node
+// and per property, to tell how many objects a schema change affects
+func (db *Neo4jDatabase) GetDomainObjectCounts(ctx context.Context, domain string) (*DomainObjectCounts, error) {
+	ctx, done := instrument(ctx, "GetDomainObjectCounts")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
+	defer session.Close(ctx)
+
+	typesQuery := `
+		MATCH (objectNode {_domain: $domain})
+		WHERE NOT objectNode:DOMAIN_SCHEMA AND NOT objectNode:TYPE_SCHEMA AND NOT objectNode:RELATIONSHIP_SCHEMA
+		UNWIND keys(objectNode) AS key
+		RETURN objectNode._type AS owner, key, count(*) AS count
+	`
+	relationshipsQuery := `
+		MATCH (relationshipSchemaNode:RELATIONSHIP_SCHEMA {_domain: $domain})
+		` + relationshipSchemaNodeObjectRelationshipsQuery + `
+		WITH relationshipSchemaNode, rel
+		WHERE rel IS NOT NULL
+		UNWIND keys(rel) AS key
+		RETURN relationshipSchemaNode._id AS owner, key, count(*) AS count
+	`
+
+	parameters := map[string]any{
+		"domain": domain,
+	}
+
+	counts := &DomainObjectCounts{}
+	var err error
+	if counts.Types, err = objectCounts(ctx, session, typesQuery, parameters); err != nil {
+		return nil, err
+	}
+	if counts.Relationships, err = objectCounts(ctx, session, relationshipsQuery, parameters); err != nil {
+		return nil, err
+	}
+	return counts, nil
+}
+
+// objectCounts reads owner, key, count rows. Every object has an _id, so its count is the total.
+func objectCounts(ctx context.Context, session neo4j.SessionWithContext, query string, parameters map[string]any) (map[string]*ObjectCounts, error) {
+	result, err := readQuery(ctx, session, query, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]*ObjectCounts{}
+	for result.Next(ctx) {
+		record := result.Record()
+		owner, _, err := neo4j.GetRecordValue[string](record, "owner")
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the object count owner")
+		}
+		key, _, err := neo4j.GetRecordValue[string](record, "key")
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the object count key")
+		}
+		count, _, err := neo4j.GetRecordValue[int64](record, "count")
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the object count")
+		}
+		if counts[owner] == nil {
+			counts[owner] = &ObjectCounts{Properties: map[string]int64{}}
+		}
+		if key == "_id" {
+			counts[owner].Total = count
+		} else {
+			counts[owner].Properties[key] = count
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,403 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"unicode/utf8"
+
+	"github.com/mike-jacks/neo/model"
+	"github.com/mike-jacks/neo/utils"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// SetPropertyConstraintOnTypeSchemaNode stores the constraints object node values of a property of the type schema node
+// are checked against, a nil constraint removes them. A unique property gets a Neo4j constraint, which cannot be
+// created while object nodes share a value. The message reports how many existing object node values violate the
+// other constraints.
+func (db *Neo4jDatabase) SetPropertyConstraintOnTypeSchemaNode(ctx context.Context, id string, property string, constraint *model.PropertyConstraintInput) (*model.TypeSchemaNodeResponse, error) {
+	ctx, done := instrument(ctx, "SetPropertyConstraintOnTypeSchemaNode")
+	defer done()
+
+	property = utils.RemoveSpacesAndLowerCase(property)
+	if _, err := propertyReference("typeSchemaNode", property); err != nil {
+		message := err.Error()
+		return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
+	}
+
+	current, err := db.GetTypeSchemaNode(ctx, id)
+	if err != nil || !current.Success {
+		return current, err
+	}
+	var propertyType model.PropertyType
+	for _, typeProperty := range current.TypeSchemaNode.Properties {
+		if typeProperty.Key == property {
+			propertyType = typeProperty.Type
+		}
+	}
+	if propertyType == "" {
+		message := fmt.Sprintf("Type schema node %s has no property %s", current.TypeSchemaNode.Name, property)
+		return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
+	}
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
+	defer session.Close(ctx)
+
+	var stored *model.PropertyConstraint
+	encoded := any(nil)
+	if constraint != nil {
+		stored = &model.PropertyConstraint{
+			Key:           property,
+			AllowedValues: constraint.AllowedValues,
+			Min:           constraint.Min,
+			Max:           constraint.Max,
+			MinLength:     constraint.MinLength,
+			MaxLength:     constraint.MaxLength,
+			Pattern:       constraint.Pattern,
+			Unique:        constraint.Unique != nil && *constraint.Unique,
+			DefaultValue:  constraint.DefaultValue,
+		}
+		if err := normalizePropertyConstraint(stored, propertyType); err != nil {
+			message := fmt.Sprintf("property %s: %s", property, err)
+			return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
+		}
+		if encoded, err = utils.EncodePropertyConstraint(stored); err != nil {
+			return nil, err
+		}
+	}
+
+	name, query := uniqueConstraint(current.TypeSchemaNode.Name, property)
+	if stored != nil && stored.Unique {
+		if err := db.createConstraints(ctx, session, map[string]string{name: query}); err != nil {
+			message := fmt.Sprintf("property %s cannot be unique, object nodes of type %s may share a value: %s", property, current.TypeSchemaNode.Name, err)
+			return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
+		}
+	} else if err := db.dropUniqueConstraints(ctx, session, current.TypeSchemaNode.Name, property); err != nil {
+		return nil, err
+	}
+
+	query = fmt.Sprintf(`
+		MATCH (typeSchemaNode:TYPE_SCHEMA {_id: $id})
+		SET typeSchemaNode.`+"`%s`"+` = $constraint
+		WITH typeSchemaNode
+		OPTIONAL MATCH (objectNode)
+		WHERE `+objectNodeOfType("objectNode", "typeSchemaNode")+` AND objectNode.`+"`%s`"+` IS NOT NULL AND NOT objectNode:RELATIONSHIP_SCHEMA AND NOT objectNode:DOMAIN_SCHEMA AND NOT objectNode:TYPE_SCHEMA
+		RETURN typeSchemaNode, collect(objectNode.`+"`%s`"+`) AS values
+	`, utils.PropertyConstraintKey(property), property, property)
+
+	parameters := map[string]any{
+		"id":         id,
+		"constraint": encoded,
+	}
+
+	result, err := writeQuery(ctx, session, query, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Next(ctx) {
+		record := result.Record()
+		typeSchemaNode, ok := record.Get("typeSchemaNode")
+		if !ok {
+			return nil, fmt.Errorf("failed to retrieve the typeSchemaNode")
+		}
+		neo4jTypeSchemaNode, ok := typeSchemaNode.(dbtype.Node)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type for typeSchemaNode: %T", typeSchemaNode)
+		}
+		values, _, err := neo4j.GetRecordValue[[]any](record, "values")
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the values")
+		}
+		data := &model.TypeSchemaNode{
+			ID:                     utils.PopString(neo4jTypeSchemaNode.Props, "_id"),
+			Domain:                 utils.PopString(neo4jTypeSchemaNode.Props, "_domain"),
+			Name:                   utils.PopString(neo4jTypeSchemaNode.Props, "_name"),
+			OriginalName:           utils.PopString(neo4jTypeSchemaNode.Props, "_originalName"),
+			Type:                   utils.PopString(neo4jTypeSchemaNode.Props, "_type"),
+			JSONSchemas:            utils.PopJSONSchemas(neo4jTypeSchemaNode.Props),
+			Constraints:            utils.PopPropertyConstraints(neo4jTypeSchemaNode.Props),
+			ComputedProperties:     utils.PopComputedProperties(neo4jTypeSchemaNode.Props),
+			ParentTypeSchemaNodeID: utils.PopParentTypeSchemaNodeID(neo4jTypeSchemaNode.Props),
+			Properties:             utils.ExtractPropertiesFromNeo4jNode(neo4jTypeSchemaNode.Props),
+			Labels:                 neo4jTypeSchemaNode.Labels,
+		}
+		if stored == nil {
+			message := fmt.Sprintf("Constraints removed from property %s of type schema node %s", property, data.Name)
+			return &model.TypeSchemaNodeResponse{Success: true, Message: &message, TypeSchemaNode: data}, nil
+		}
+		invalid := 0
+		for _, value := range values {
+			extracted := utils.ExtractPropertiesFromNeo4jNode(map[string]any{property: value})
+			if len(extracted) != 1 || checkPropertyConstraint(stored, propertyType, extracted[0].Value) != nil {
+				invalid++
+			}
+		}
+		message := fmt.Sprintf("Constraints set on property %s of type schema node %s, %d existing object nodes do not satisfy them", property, data.Name, invalid)
+		return &model.TypeSchemaNodeResponse{Success: true, Message: &message, TypeSchemaNode: data}, nil
+	}
+	message := fmt.Sprintf("Type schema node with id %s was not found", id)
+	return &model.TypeSchemaNodeResponse{Success: false, Message: &message, TypeSchemaNode: nil}, nil
+}
+
+// uniqueConstraint returns the name and query of the constraint keeping a property of the object nodes of typeName
+// unique. Object nodes of the same type in other domains share the label, so the constraint includes the domain.
+func uniqueConstraint(typeName string, property string) (string, string) {
+	label := typeLabel(typeName)
+	name := fmt.Sprintf("object_node_%s_%s_unique_value", utils.SanitizeStringToLower(label), utils.SanitizeStringToLower(property))
+	return name, fmt.Sprintf(`
+		CREATE CONSTRAINT %s IF NOT EXISTS
+		FOR (n:`+"`%s`"+`)
+		REQUIRE (n._domain, n.`+"`%s`"+`) IS UNIQUE
+	`, name, label, property)
+}
+
+// uniqueConstraints returns the constraint of every unique property of typeSchemaNode, keyed by constraint name
+func uniqueConstraints(typeSchemaNode *model.TypeSchemaNode) map[string]string {
+	constraints := map[string]string{}
+	for _, constraint := range typeSchemaNode.Constraints {
+		if constraint.Unique {
+			name, query := uniqueConstraint(typeSchemaNode.Name, constraint.Key)
+			constraints[name] = query
+		}
+	}
+	return constraints
+}
+
+// ensureUniqueConstraints creates the unique property constraints of typeSchemaNodes that are not known to exist yet
+func (db *Neo4jDatabase) ensureUniqueConstraints(ctx context.Context, typeSchemaNodes ...*model.TypeSchemaNode) error {
+	missing := map[string]string{}
+	for _, typeSchemaNode := range typeSchemaNodes {
+		for name, query := range db.constraints.missing(uniqueConstraints(typeSchemaNode)) {
+			missing[name] = query
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
+	defer session.Close(ctx)
+
+	return db.createConstraints(ctx, session, missing)
+}
+
+// dropUniqueConstraints drops the unique property constraints of properties of the object nodes of typeName
+func (db *Neo4jDatabase) dropUniqueConstraints(ctx context.Context, session neo4j.SessionWithContext, typeName string, properties ...string) error {
+	for _, property := range properties {
+		name, query := uniqueConstraint(typeName, property)
+		if len(db.constraints.missing(map[string]string{name: query})) > 0 {
+			continue
+		}
+		if _, err := writeQuery(ctx, session, fmt.Sprintf("DROP CONSTRAINT %s IF EXISTS", name), nil); err != nil {
+			return fmt.Errorf("unable to drop constraint %s: %w", name, err)
+		}
+		db.constraints.remove(name)
+	}
+	return nil
+}
+
+// normalizePropertyConstraint checks the constraint fits a property of propertyType and normalizes its allowed
+// values and default value the way property values are
+func normalizePropertyConstraint(constraint *model.PropertyConstraint, propertyType model.PropertyType) error {
+	scalarType := propertyType
+	if elementType, ok := utils.ArrayElementType(propertyType); ok {
+		scalarType = elementType
+	}
+	isArray := scalarType != propertyType
+
+	for i, allowed := range constraint.AllowedValues {
+		_, normalized, err := utils.NormalizePropertyValue(scalarType, allowed)
+		if err != nil {
+			return fmt.Errorf("allowed value %d: %w", i, err)
+		}
+		constraint.AllowedValues[i] = normalized
+	}
+	if constraint.Min != nil || constraint.Max != nil {
+		if scalarType != model.PropertyTypeInteger && scalarType != model.PropertyTypeFloat {
+			return fmt.Errorf("min and max only apply to INTEGER and FLOAT values, the property is %s", propertyType)
+		}
+		if constraint.Min != nil && constraint.Max != nil && *constraint.Min > *constraint.Max {
+			return fmt.Errorf("min %v is greater than max %v", *constraint.Min, *constraint.Max)
+		}
+	}
+	if constraint.MinLength != nil || constraint.MaxLength != nil {
+		if propertyType != model.PropertyTypeString && !isArray {
+			return fmt.Errorf("minLength and maxLength only apply to STRING and array values, the property is %s", propertyType)
+		}
+		if (constraint.MinLength != nil && *constraint.MinLength < 0) || (constraint.MaxLength != nil && *constraint.MaxLength < 0) {
+			return fmt.Errorf("minLength and maxLength cannot be negative")
+		}
+		if constraint.MinLength != nil && constraint.MaxLength != nil && *constraint.MinLength > *constraint.MaxLength {
+			return fmt.Errorf("minLength %d is greater than maxLength %d", *constraint.MinLength, *constraint.MaxLength)
+		}
+	}
+	if constraint.Pattern != nil {
+		if scalarType != model.PropertyTypeString {
+			return fmt.Errorf("pattern only applies to STRING values, the property is %s", propertyType)
+		}
+		if _, err := compilePattern(*constraint.Pattern); err != nil {
+			return fmt.Errorf("invalid pattern: %w", err)
+		}
+	}
+	if constraint.DefaultValue != nil {
+		_, normalized, err := utils.NormalizePropertyValue(propertyType, constraint.DefaultValue)
+		if err != nil {
+			return fmt.Errorf("default value: %w", err)
+		}
+		if err := checkPropertyConstraint(constraint, propertyType, normalized); err != nil {
+			return fmt.Errorf("default value: %w", err)
+		}
+		constraint.DefaultValue = normalized
+	}
+	return nil
+}
+
+// compilePattern compiles a pattern values must match in full
+func compilePattern(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile("^(?:" + pattern + ")$")
+}
+
+// checkPropertyConstraint checks a normalized value of a property of propertyType satisfies the constraint,
+// uniqueness aside
+func checkPropertyConstraint(constraint *model.PropertyConstraint, propertyType model.PropertyType, value any) error {
+	length := -1
+	scalarType := propertyType
+	values := []any{value}
+	if elementType, ok := utils.ArrayElementType(propertyType); ok {
+		scalarType = elementType
+		values, _ = value.([]any)
+		length = len(values)
+	} else if s, ok := value.(string); ok && propertyType == model.PropertyTypeString {
+		length = utf8.RuneCountInString(s)
+	}
+
+	if length >= 0 {
+		if constraint.MinLength != nil && length < *constraint.MinLength {
+			return fmt.Errorf("length %d is less than the minimum length %d", length, *constraint.MinLength)
+		}
+		if constraint.MaxLength != nil && length > *constraint.MaxLength {
+			return fmt.Errorf("length %d is greater than the maximum length %d", length, *constraint.MaxLength)
+		}
+	}
+	for _, v := range values {
+		if err := checkScalarConstraint(constraint, scalarType, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkScalarConstraint(constraint *model.PropertyConstraint, scalarType model.PropertyType, value any) error {
+	if len(constraint.AllowedValues) > 0 {
+		allowed := false
+		for _, allowedValue := range constraint.AllowedValues {
+			_, normalized, err := utils.NormalizePropertyValue(scalarType, allowedValue)
+			if err == nil && fmt.Sprint(normalized) == fmt.Sprint(value) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return fmt.Errorf("%v is not one of the allowed values %v", value, constraint.AllowedValues)
+		}
+	}
+
+	number, isNumber := 0.0, true
+	switch v := value.(type) {
+	case int64:
+		number = float64(v)
+	case float64:
+		number = v
+	default:
+		isNumber = false
+	}
+	if isNumber && constraint.Min != nil && number < *constraint.Min {
+		return fmt.Errorf("%v is less than the minimum %v", value, *constraint.Min)
+	}
+	if isNumber && constraint.Max != nil && number > *constraint.Max {
+		return fmt.Errorf("%v is greater than the maximum %v", value, *constraint.Max)
+	}
+
+	if constraint.Pattern != nil {
+		pattern, err := compilePattern(*constraint.Pattern)
+		if err != nil {
+			return fmt.Errorf("stored pattern is invalid: %w", err)
+		}
+		if !pattern.MatchString(fmt.Sprint(value)) {
+			return fmt.Errorf("%q does not match the pattern %s", value, *constraint.Pattern)
+		}
+	}
+	return nil
+}
+
+// validatePropertyConstraints checks properties about to be written on an object node of typeSchemaNode against the
+// constraints of the type schema node. id is the object node written, empty for a new one, which may take any value
+// no other object node of the type and domain has. Null values remove properties and are not checked.
+func (db *Neo4jDatabase) validatePropertyConstraints(ctx context.Context, typeSchemaNode *model.TypeSchemaNode, id string, properties []*model.PropertyInput) error {
+	constraints := map[string]*model.PropertyConstraint{}
+	for _, constraint := range typeSchemaNode.Constraints {
+		constraints[constraint.Key] = constraint
+	}
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
+	defer session.Close(ctx)
+
+	for _, property := range properties {
+		constraint, ok := constraints[property.Key]
+		if !ok || property.Value == nil {
+			continue
+		}
+		if err := checkPropertyConstraint(constraint, property.Type, property.Value); err != nil {
+			return fmt.Errorf("property %s: %w", property.Key, err)
+		}
+		if !constraint.Unique {
+			continue
+		}
+
+		reference, err := propertyReference("other", property.Key)
+		if err != nil {
+			return err
+		}
+		// The unique constraint is on the label, which object nodes of subtypes carry too
+		expression, value := utils.PropertyParameter(property.Type, property.Value, "value")
+		query := fmt.Sprintf("MATCH (other:`%s` {_domain: $domain}) WHERE %s = %s AND other._id <> $id RETURN other._id AS id LIMIT 1",
+			typeLabel(typeSchemaNode.Name), reference, expression)
+		result, err := readQuery(ctx, session, query, map[string]any{"domain": typeSchemaNode.Domain, "id": id, "value": value})
+		if err != nil {
+			return err
+		}
+		if result.Next(ctx) {
+			other, _, _ := neo4j.GetRecordValue[string](result.Record(), "id")
+			return fmt.Errorf("property %s must be unique, object node %s already has the value %v", property.Key, other, property.Value)
+		}
+	}
+	return nil
+}
+
+// withDefaultValues returns properties with the default value of every constrained property of typeSchemaNode
+// they do not set, for a new object node
+func withDefaultValues(typeSchemaNode *model.TypeSchemaNode, properties []*model.PropertyInput) ([]*model.PropertyInput, error) {
+	set := map[string]bool{}
+	for _, property := range properties {
+		set[property.Key] = true
+	}
+	types := map[string]model.PropertyType{}
+	for _, property := range typeSchemaNode.Properties {
+		types[property.Key] = property.Type
+	}
+
+	for _, constraint := range typeSchemaNode.Constraints {
+		propertyType, ok := types[constraint.Key]
+		if set[constraint.Key] || constraint.DefaultValue == nil || !ok {
+			continue
+		}
+		normalizedType, value, err := utils.NormalizePropertyValue(propertyType, constraint.DefaultValue)
+		if err != nil {
+			return nil, fmt.Errorf("property %s: stored default value: %w", constraint.Key, err)
+		}
+		properties = append(properties, &model.PropertyInput{Key: constraint.Key, Type: normalizedType, Value: value})
+	}
+	return properties, nil
+}
@@ -0,0 +1,103 @@
+package db
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/mike-jacks/neo/model"
+	"github.com/mike-jacks/neo/utils"
+)
+
+var filterOperators = map[model.FilterOperator]string{
+	model.FilterOperatorEq:  "=",
+	model.FilterOperatorNeq: "<>",
+	model.FilterOperatorLt:  "<",
+	model.FilterOperatorLte: "<=",
+	model.FilterOperatorGt:  ">",
+	model.FilterOperatorGte: ">=",
+}
+
+var propertyKeyPattern = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+
+// propertyReference returns the Cypher reference to the property key of variable
+func propertyReference(variable string, key string) (string, error) {
+	key = utils.RemoveSpacesAndLowerCase(key)
+	if !propertyKeyPattern.MatchString(key) {
+		return "", fmt.Errorf("invalid property key %q", key)
+	}
+	return fmt.Sprintf("%v.`%v`", variable, key), nil
+}
+
+// propertyFilterQuery turns filters on variable into WHERE conditions joined by AND, their values are added to parameters
+func propertyFilterQuery(variable string, filters []*model.PropertyFilter, parameters map[string]any) (string, error) {
+	conditions := []string{}
+	for i, filter := range filters {
+		reference, err := propertyReference(variable, filter.Key)
+		if err != nil {
+			return "", err
+		}
+		operator, ok := filterOperators[filter.Operator]
+		if !ok {
+			return "", fmt.Errorf("unsupported filter operator %v", filter.Operator)
+		}
+
+		parameter := fmt.Sprintf("filter%d", i)
+		value := "$" + parameter
+		switch {
+		case utils.IsTemporalPropertyType(filter.Type):
+			normalized, err := utils.NormalizeTemporalValue(filter.Type, filter.Value)
+			if err != nil {
+				return "", fmt.Errorf("filter on %v: %w", filter.Key, err)
+			}
+			parameters[parameter] = normalized
+			value = fmt.Sprintf("%v(%v)", utils.TemporalFunction(filter.Type), value)
+		case filter.Type == model.PropertyTypePoint:
+			return "", fmt.Errorf("filter on %v: POINT properties are queried with objectNodesWithinDistance and objectNodesInBoundingBox", filter.Key)
+		case filter.Type == model.PropertyTypeNumber || filter.Type == model.PropertyTypeInteger || filter.Type == model.PropertyTypeFloat:
+			number, err := filterNumber(filter.Value)
+			if err != nil {
+				return "", fmt.Errorf("filter on %v: %w", filter.Key, err)
+			}
+			parameters[parameter] = number
+		default:
+			parameters[parameter] = filter.Value
+		}
+		conditions = append(conditions, fmt.Sprintf("%v %v %v", reference, operator, value))
+	}
+	return strings.Join(conditions, " AND "), nil
+}
+
+// propertySortQuery turns sorts on variable into an ORDER BY clause, empty when there is nothing to sort on
+func propertySortQuery(variable string, sorts []*model.PropertySort) (string, error) {
+	orderings := []string{}
+	for _, sort := range sorts {
+		reference, err := propertyReference(variable, sort.Key)
+		if err != nil {
+			return "", err
+		}
+		direction := model.SortDirectionAsc
+		if sort.Direction != nil {
+			direction = *sort.Direction
+		}
+		orderings = append(orderings, fmt.Sprintf("%v %v", reference, direction))
+	}
+	if len(orderings) == 0 {
+		return "", nil
+	}
+	return " ORDER BY " + strings.Join(orderings, ", "), nil
+}
+
+func filterNumber(value any) (any, error) {
+	switch v := value.(type) {
+	case int, int32, int64, float32, float64:
+		return v, nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, nil
+		}
+		return v.Float64()
+	}
+	return nil, fmt.Errorf("NUMBER value expected, got %T", value)
+}
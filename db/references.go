@@ -0,0 +1,202 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mike-jacks/neo/model"
+	"github.com/mike-jacks/neo/utils"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// RELATIONSHIP properties of an object node are references: the property holds the id of the referenced object node
+// and an object relationship named after the property, marked with _referenceKey, points at it. Setting the property
+// replaces the relationship, removing the property or deleting either end removes both.
+
+// validateReferences checks every RELATIONSHIP property references an existing object node, of the type the
+// RELATIONSHIP property of the same key on typeSchemaNode names or of a subtype, if any
+func (db *Neo4jDatabase) validateReferences(ctx context.Context, typeSchemaNode *model.TypeSchemaNode, properties []*model.PropertyInput) error {
+	targetTypes := map[string]string{}
+	for _, property := range typeSchemaNode.Properties {
+		if property.Type == model.PropertyTypeRelationship {
+			targetTypes[property.Key] = fmt.Sprint(property.Value)
+		}
+	}
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
+	defer session.Close(ctx)
+
+	for _, property := range properties {
+		// A null value removes the property and its object relationship, there is nothing to check
+		if property.Type != model.PropertyTypeRelationship || property.Value == nil {
+			continue
+		}
+		targetID := fmt.Sprint(property.Value)
+		if targetID == "" {
+			return fmt.Errorf("property %s: RELATIONSHIP value must be the id of an object node", property.Key)
+		}
+
+		query := `
+			MATCH (target {_id: $id})
+			WHERE NOT target:RELATIONSHIP_SCHEMA AND NOT target:DOMAIN_SCHEMA AND NOT target:TYPE_SCHEMA
+			RETURN target._type AS type, coalesce(target.` + utils.SupertypesKey + `, []) AS supertypes
+		`
+		result, err := readQuery(ctx, session, query, map[string]any{"id": targetID})
+		if err != nil {
+			return err
+		}
+		if !result.Next(ctx) {
+			return fmt.Errorf("property %s: object node with id %s does not exist", property.Key, targetID)
+		}
+		actualType, _, _ := neo4j.GetRecordValue[string](result.Record(), "type")
+		supertypes, _, _ := neo4j.GetRecordValue[[]any](result.Record(), "supertypes")
+
+		expectedType := targetTypes[property.Key]
+		if expectedType != "" && !hasType(actualType, toStrings(supertypes), expectedType) {
+			return fmt.Errorf("property %s must reference an object node of type %s, %s is of type %s", property.Key, utils.RemoveSpacesAndUpperCase(expectedType), targetID, actualType)
+		}
+	}
+	return nil
+}
+
+// writeReferences replaces the object relationship of every RELATIONSHIP property of the object node id, a null
+// RELATIONSHIP property only deletes it. It runs in the transaction writing the properties so a failure leaves
+// neither behind. Like any object relationship, a reference is rejected when creating or deleting it breaks the
+// cardinality of a relationship schema node of its name.
+func (db *Neo4jDatabase) writeReferences(ctx context.Context, tx neo4j.ManagedTransaction, id string, properties []*model.PropertyInput) error {
+	for _, property := range properties {
+		if property.Type != model.PropertyTypeRelationship {
+			continue
+		}
+		if property.Value == nil {
+			if err := db.deleteReference(ctx, tx, id, property.Key); err != nil {
+				return err
+			}
+			continue
+		}
+
+		name := utils.ReferenceName(property.Key)
+		query := `
+			MATCH (objectNode {_id: $id}), (target {_id: $targetId})
+			OPTIONAL MATCH (objectNode)-[previous {_referenceKey: $key}]->()
+			DELETE previous
+			WITH DISTINCT objectNode AS fromObjectNode, target AS toObjectNode` + lockObjectNodes + `
+			WITH fromObjectNode, toObjectNode, $name AS name` + cardinalityCreateGuard + `
+			CREATE (fromObjectNode)-[reference:` + fmt.Sprintf("`%s`", name) + ` {_id: $referenceId, _name: $name, _originalName: $key, _fromObjectNodeId: $id, _toObjectNodeId: $targetId, _referenceKey: $key}]->(toObjectNode)
+			RETURN reference._id AS id
+		`
+
+		parameters := map[string]any{
+			"id":          id,
+			"targetId":    property.Value,
+			"key":         property.Key,
+			"referenceId": utils.GenerateId(),
+			"name":        name,
+		}
+
+		result, err := transactionQuery(ctx, tx, query, parameters)
+		if err != nil {
+			return err
+		}
+		if !result.Next(ctx) {
+			if err := db.checkCardinalityOnCreate(ctx, tx, name, id, fmt.Sprint(property.Value)); err != nil {
+				return err
+			}
+			return fmt.Errorf("failed to create the %s reference of object node %s", property.Key, id)
+		}
+	}
+	return nil
+}
+
+// deleteReference deletes the object relationship of the RELATIONSHIP property key of the object node id, if any
+func (db *Neo4jDatabase) deleteReference(ctx context.Context, tx neo4j.ManagedTransaction, id string, key string) error {
+	query := `MATCH (objectNode {_id: $id})-[reference {_referenceKey: $key}]->() RETURN reference._id AS id`
+	result, err := transactionQuery(ctx, tx, query, map[string]any{"id": id, "key": key})
+	if err != nil || !result.Next(ctx) {
+		return err
+	}
+	referenceID, _, err := neo4j.GetRecordValue[string](result.Record(), "id")
+	if err != nil {
+		return fmt.Errorf("failed to retrieve the %s reference of object node %s", key, id)
+	}
+
+	query = `
+		MATCH (fromObjectNode)-[reference {_id: $id}]->(toObjectNode)` + lockObjectNodes + `
+		WITH fromObjectNode, toObjectNode, reference, reference._name AS name` + cardinalityDeleteGuard + `
+		DELETE reference
+		RETURN count(*) AS deleted
+	`
+	result, err = transactionQuery(ctx, tx, query, map[string]any{"id": referenceID})
+	if err != nil {
+		return err
+	}
+	if result.Next(ctx) {
+		if deleted, _, _ := neo4j.GetRecordValue[int64](result.Record(), "deleted"); deleted > 0 {
+			return nil
+		}
+	}
+	return db.checkCardinalityOnDelete(ctx, tx, referenceID)
+}
+
+// clearReferencesTo removes the RELATIONSHIP properties referencing the object node id, before it is deleted
+func (db *Neo4jDatabase) clearReferencesTo(ctx context.Context, tx neo4j.ManagedTransaction, id string) error {
+	query := `
+		MATCH (source)-[reference]->(target {_id: $id})
+		WHERE reference._referenceKey IS NOT NULL
+		RETURN DISTINCT reference._referenceKey AS key
+	`
+	return db.clearReferenceProperties(ctx, tx, query, `MATCH (source)-[reference {_referenceKey: $key}]->(target {_id: $id})`, map[string]any{"id": id})
+}
+
+// clearReferenceProperty removes the RELATIONSHIP property maintaining the object relationship id, if it is a reference
+func (db *Neo4jDatabase) clearReferenceProperty(ctx context.Context, tx neo4j.ManagedTransaction, id string) error {
+	query := `
+		MATCH (source)-[reference {_id: $id}]->()
+		WHERE reference._referenceKey IS NOT NULL
+		RETURN DISTINCT reference._referenceKey AS key
+	`
+	return db.clearReferenceProperties(ctx, tx, query, `MATCH (source)-[reference {_id: $id, _referenceKey: $key}]->()`, map[string]any{"id": id})
+}
+
+// clearReferenceProperties sets the property of every key keysQuery returns to null on the sources sourcesMatch matches,
+// both run with parameters and sourcesMatch with the key as $key too
+func (db *Neo4jDatabase) clearReferenceProperties(ctx context.Context, tx neo4j.ManagedTransaction, keysQuery string, sourcesMatch string, parameters map[string]any) error {
+	result, err := transactionQuery(ctx, tx, keysQuery, parameters)
+	if err != nil {
+		return err
+	}
+	keys := []string{}
+	for result.Next(ctx) {
+		key, _, err := neo4j.GetRecordValue[string](result.Record(), "key")
+		if err != nil {
+			return fmt.Errorf("failed to retrieve the reference key")
+		}
+		keys = append(keys, key)
+	}
+
+	for _, key := range keys {
+		reference, err := propertyReference("source", key)
+		if err != nil {
+			return err
+		}
+		query := fmt.Sprintf("%s SET %s = null", sourcesMatch, reference)
+		keyParameters := map[string]any{"key": key}
+		for name, value := range parameters {
+			keyParameters[name] = value
+		}
+		if _, err := transactionQuery(ctx, tx, query, keyParameters); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rejectReferences fails on RELATIONSHIP properties, which only object nodes can hold
+func rejectReferences(properties []*model.PropertyInput) error {
+	for _, property := range properties {
+		if property.Type == model.PropertyTypeRelationship {
+			return fmt.Errorf("property %s: RELATIONSHIP properties are only supported on object nodes", property.Key)
+		}
+	}
+	return nil
+}
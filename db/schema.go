@@ -0,0 +1,392 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mike-jacks/neo/model"
+	"github.com/mike-jacks/neo/utils"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+// systemConstraints are the constraints backing the schema node labels, keyed by constraint name
+var systemConstraints = map[string]string{
+	"domain_schema_node_key": `
+		CREATE CONSTRAINT domain_schema_node_key IF NOT EXISTS
+		FOR (n:DOMAIN_SCHEMA)
+		REQUIRE (n._id) IS NODE KEY
+	`,
+	"domain_schema_node_unique": `
+		CREATE CONSTRAINT domain_schema_node_unique IF NOT EXISTS
+		FOR (n:DOMAIN_SCHEMA)
+		REQUIRE (n._name, n._type, n._domain) IS UNIQUE
+	`,
+	"type_schema_node_key": `
+		CREATE CONSTRAINT type_schema_node_key IF NOT EXISTS
+		FOR (n:TYPE_SCHEMA)
+		REQUIRE (n._id) IS NODE KEY
+	`,
+	"type_schema_node_unique": `
+		CREATE CONSTRAINT type_schema_node_unique IF NOT EXISTS
+		FOR (n:TYPE_SCHEMA)
+		REQUIRE (n._name, n._type, n._domain) IS UNIQUE
+	`,
+	"relationship_schema_node_key": `
+		CREATE CONSTRAINT relationship_schema_node_key IF NOT EXISTS
+		FOR (n:RELATIONSHIP_SCHEMA)
+		REQUIRE (n._id) IS NODE KEY
+	`,
+	"relationship_schema_node_unique": `
+		CREATE CONSTRAINT relationship_schema_node_unique IF NOT EXISTS
+		FOR (n:RELATIONSHIP_SCHEMA)
+		REQUIRE (n._name, n._domain, n._fromTypeSchemaNodeId, n._toTypeSchemaNodeId) IS UNIQUE
+	`,
+}
+
+// schemaLabels are the labels of schema and bookkeeping nodes, which have their own constraints
+// rather than per label object node constraints
+var schemaLabels = map[string]bool{
+	"DOMAIN_SCHEMA":       true,
+	"TYPE_SCHEMA":         true,
+	"RELATIONSHIP_SCHEMA": true,
+	"_MIGRATION":          true,
+	"_MIGRATION_LOCK":     true,
+}
+
+// labelConstraints returns the constraints every object node label gets, keyed by constraint name
+func labelConstraints(label string) map[string]string {
+	name := utils.SanitizeStringToLower(label)
+	return map[string]string{
+		fmt.Sprintf("object_node_%s_key", name): fmt.Sprintf(`
+			CREATE CONSTRAINT object_node_%s_key IF NOT EXISTS
+			FOR (n:`+"`%s`"+`)
+			REQUIRE (n._id) IS NODE KEY
+		`, name, label),
+		fmt.Sprintf("object_node_%s_unique", name): fmt.Sprintf(`
+			CREATE CONSTRAINT object_node_%s_unique IF NOT EXISTS
+			FOR (n:`+"`%s`"+`)
+			REQUIRE (n._name, n._type, n._domain) IS UNIQUE
+		`, name, label),
+	}
+}
+
+// constraintCache remembers which constraints and indexes are known to exist, so a write only pays for a schema
+// transaction the first time one of its labels is seen by this process
+type constraintCache struct {
+	mu    sync.Mutex
+	names map[string]bool
+}
+
+func (c *constraintCache) missing(constraints map[string]string) map[string]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	missing := map[string]string{}
+	for name, query := range constraints {
+		if !c.names[name] {
+			missing[name] = query
+		}
+	}
+	return missing
+}
+
+func (c *constraintCache) add(names ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.names == nil {
+		c.names = map[string]bool{}
+	}
+	for _, name := range names {
+		c.names[name] = true
+	}
+}
+
+func (c *constraintCache) remove(names ...string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, name := range names {
+		delete(c.names, name)
+	}
+}
+
+func (c *constraintCache) reset(names map[string]bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.names = names
+}
+
+// BootstrapSchema runs once at startup. It loads the constraints that already exist, then creates
+// the schema node constraints, the constraints of every type schema node label, the point indexes
+// of every POINT property and the constraints of every unique property still missing. Object nodes of
+// subtypes created before their supertypes were stored get them.
+func (db *Neo4jDatabase) BootstrapSchema(ctx context.Context) error {
+	ctx, done := instrument(ctx, "BootstrapSchema")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
+	defer session.Close(ctx)
+
+	existing, err := constraintNames(ctx, session)
+	if err != nil {
+		return err
+	}
+	db.constraints.reset(existing)
+
+	if err := db.createConstraints(ctx, session, systemConstraints); err != nil {
+		return err
+	}
+
+	labels, err := typeSchemaLabels(ctx, session)
+	if err != nil {
+		return err
+	}
+	if err := db.ensureLabelConstraints(ctx, labels...); err != nil {
+		return err
+	}
+
+	typeSchemaNodes, err := db.GetTypeSchemaNodes(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := db.ensurePointIndexes(ctx, typeSchemaNodes.TypeSchemaNodes...); err != nil {
+		return err
+	}
+	if err := db.backfillSupertypes(ctx, session, typeSchemaNodes.TypeSchemaNodes); err != nil {
+		return err
+	}
+	return db.ensureUniqueConstraints(ctx, typeSchemaNodes.TypeSchemaNodes...)
+}
+
+// ensureLabelConstraints creates the object node constraints of labels that are not known to exist yet
+func (db *Neo4jDatabase) ensureLabelConstraints(ctx context.Context, labels ...string) error {
+	missing := map[string]string{}
+	for _, label := range labels {
+		if label == "" || schemaLabels[label] {
+			continue
+		}
+		for name, query := range db.constraints.missing(labelConstraints(label)) {
+			missing[name] = query
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
+	defer session.Close(ctx)
+
+	return db.createConstraints(ctx, session, missing)
+}
+
+func (db *Neo4jDatabase) createConstraints(ctx context.Context, session neo4j.SessionWithContext, constraints map[string]string) error {
+	for name, query := range db.constraints.missing(constraints) {
+		if _, err := writeQuery(ctx, session, query, nil); err != nil {
+			return fmt.Errorf("unable to create constraint %s: %w", name, err)
+		}
+		db.constraints.add(name)
+	}
+	return nil
+}
+
+// RebuildConstraints forgets the cached constraints, reloads them from the database and recreates any
+// schema node, object node label or unique property constraint that is missing, for example after a manual drop
+func (db *Neo4jDatabase) RebuildConstraints(ctx context.Context) (*model.IndexesResponse, error) {
+	ctx, done := instrument(ctx, "RebuildConstraints")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
+	defer session.Close(ctx)
+
+	existing, err := constraintNames(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	db.constraints.reset(existing)
+
+	if err := db.createConstraints(ctx, session, systemConstraints); err != nil {
+		message := err.Error()
+		return &model.IndexesResponse{Success: false, Message: &message, Indexes: nil}, nil
+	}
+
+	typeLabels, err := typeSchemaLabels(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	result, err := readQuery(ctx, session, "CALL db.labels() YIELD label RETURN label", nil)
+	if err != nil {
+		return nil, err
+	}
+	labels := typeLabels
+	for result.Next(ctx) {
+		label, ok := result.Record().Get("label")
+		if !ok {
+			return nil, fmt.Errorf("failed to retrieve the label")
+		}
+		if labelString, ok := label.(string); ok {
+			labels = append(labels, labelString)
+		}
+	}
+
+	before := len(existing)
+	if err := db.ensureLabelConstraints(ctx, labels...); err != nil {
+		message := err.Error()
+		return &model.IndexesResponse{Success: false, Message: &message, Indexes: nil}, nil
+	}
+	typeSchemaNodes, err := db.GetTypeSchemaNodes(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.ensureUniqueConstraints(ctx, typeSchemaNodes.TypeSchemaNodes...); err != nil {
+		message := err.Error()
+		return &model.IndexesResponse{Success: false, Message: &message, Indexes: nil}, nil
+	}
+
+	indexes, err := db.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	after, err := constraintNames(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	message := fmt.Sprintf("Constraints rebuilt successfully. %d constraints were created.", len(after)-before)
+	return &model.IndexesResponse{Success: true, Message: &message, Indexes: indexes.Indexes}, nil
+}
+
+// GetIndexes lists every index in the database, including those backing constraints
+func (db *Neo4jDatabase) GetIndexes(ctx context.Context) (*model.IndexesResponse, error) {
+	ctx, done := instrument(ctx, "GetIndexes")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
+	defer session.Close(ctx)
+
+	query := `
+		SHOW INDEXES
+		YIELD name, type, entityType, labelsOrTypes, properties, state, owningConstraint
+		RETURN name, type, entityType, labelsOrTypes, properties, state, owningConstraint
+		ORDER BY name
+	`
+
+	result, err := readQuery(ctx, session, query, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	data := []*model.Index{}
+	for result.Next(ctx) {
+		record := result.Record()
+		name, _, err := neo4j.GetRecordValue[string](record, "name")
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve the index name")
+		}
+		indexType, _, _ := neo4j.GetRecordValue[string](record, "type")
+		entityType, _, _ := neo4j.GetRecordValue[string](record, "entityType")
+		state, _, _ := neo4j.GetRecordValue[string](record, "state")
+		labelsOrTypes, _, _ := neo4j.GetRecordValue[[]any](record, "labelsOrTypes")
+		properties, _, _ := neo4j.GetRecordValue[[]any](record, "properties")
+		owningConstraint, isNil, _ := neo4j.GetRecordValue[string](record, "owningConstraint")
+
+		index := &model.Index{
+			Name:          name,
+			Type:          indexType,
+			EntityType:    entityType,
+			LabelsOrTypes: toStrings(labelsOrTypes),
+			Properties:    toStrings(properties),
+			State:         state,
+		}
+		if !isNil {
+			index.OwningConstraint = &owningConstraint
+		}
+		data = append(data, index)
+	}
+
+	message := fmt.Sprintf("%d indexes found", len(data))
+	return &model.IndexesResponse{Success: true, Message: &message, Indexes: data}, nil
+}
+
+// CheckSystemConstraints returns an error naming every schema node constraint missing from the database
+func (db *Neo4jDatabase) CheckSystemConstraints(ctx context.Context) error {
+	ctx, done := instrument(ctx, "CheckSystemConstraints")
+	defer done()
+
+	session := db.newSession(ctx, neo4j.AccessModeRead)
+	defer session.Close(ctx)
+
+	existing, err := constraintNames(ctx, session)
+	if err != nil {
+		return err
+	}
+
+	missing := []string{}
+	for name := range systemConstraints {
+		if !existing[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("missing constraints: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+func constraintNames(ctx context.Context, session neo4j.SessionWithContext) (map[string]bool, error) {
+	result, err := readQuery(ctx, session, "SHOW CONSTRAINTS YIELD name RETURN name", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := map[string]bool{}
+	for result.Next(ctx) {
+		name, ok := result.Record().Get("name")
+		if !ok {
+			return nil, fmt.Errorf("failed to retrieve the constraint name")
+		}
+		if nameString, ok := name.(string); ok {
+			existing[nameString] = true
+		}
+	}
+	return existing, nil
+}
+
+// typeSchemaLabels returns the object node label of every type schema node
+func typeSchemaLabels(ctx context.Context, session neo4j.SessionWithContext) ([]string, error) {
+	result, err := readQuery(ctx, session, "MATCH (typeSchemaNode:TYPE_SCHEMA) RETURN DISTINCT typeSchemaNode._name AS name", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	labels := []string{}
+	for result.Next(ctx) {
+		name, ok := result.Record().Get("name")
+		if !ok {
+			return nil, fmt.Errorf("failed to retrieve the type schema node name")
+		}
+		if nameString, ok := name.(string); ok {
+			labels = append(labels, typeLabel(nameString))
+		}
+	}
+	return labels, nil
+}
+
+// typeLabel returns the label object nodes of the given type carry
+func typeLabel(typeName string) string {
+	return utils.SanitizeStringToUpper(utils.RemoveSpacesAndHyphens(strings.ToUpper(typeName)))
+}
+
+func toStrings(values []any) []string {
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		if s, ok := value.(string); ok {
+			result = append(result, s)
+		}
+	}
+	return result
+}
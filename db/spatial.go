@@ -0,0 +1,204 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/mike-jacks/neo/model"
+	"github.com/mike-jacks/neo/utils"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// pointIndexes returns a point index for every POINT property of a type schema node, keyed by index name,
+// so distance and bounding box queries on its object nodes do not scan the label
+func pointIndexes(typeSchemaNode *model.TypeSchemaNode) map[string]string {
+	label := typeLabel(typeSchemaNode.Name)
+	indexes := map[string]string{}
+	for _, property := range typeSchemaNode.Properties {
+		if property.Type != model.PropertyTypePoint {
+			continue
+		}
+		name := fmt.Sprintf("object_node_%s_%s_point", utils.SanitizeStringToLower(label), utils.SanitizeStringToLower(property.Key))
+		indexes[name] = fmt.Sprintf(`
+			CREATE POINT INDEX %s IF NOT EXISTS
+			FOR (n:`+"`%s`"+`)
+			ON (n.`+"`%s`"+`)
+		`, name, label, property.Key)
+	}
+	return indexes
+}
+
+// ensurePointIndexes creates the point indexes of typeSchemaNodes that are not known to exist yet
+func (db *Neo4jDatabase) ensurePointIndexes(ctx context.Context, typeSchemaNodes ...*model.TypeSchemaNode) error {
+	missing := map[string]string{}
+	for _, typeSchemaNode := range typeSchemaNodes {
+		for name, query := range db.constraints.missing(pointIndexes(typeSchemaNode)) {
+			missing[name] = query
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+
+	session := db.newSession(ctx, neo4j.AccessModeWrite)
+	defer session.Close(ctx)
+
+	for name, query := range missing {
+		if _, err := writeQuery(ctx, session, query, nil); err != nil {
+			return fmt.Errorf("unable to create point index %s: %w", name, err)
+		}
+		db.constraints.add(name)
+	}
+	return nil
+}
+
+// pointParameter turns a point input into the map point() builds a native point from, returning its crs
+func pointParameter(name string, point model.PointInput) (map[string]any, string, error) {
+	switch {
+	case point.Latitude != nil && point.Longitude != nil && point.X == nil && point.Y == nil:
+		value, err := utils.NormalizePointValue(map[string]any{"latitude": *point.Latitude, "longitude": *point.Longitude})
+		if err != nil {
+			return nil, "", fmt.Errorf("%s: %w", name, err)
+		}
+		return map[string]any{"latitude": value["latitude"], "longitude": value["longitude"]}, utils.CRSWGS84, nil
+	case point.X != nil && point.Y != nil && point.Latitude == nil && point.Longitude == nil:
+		return map[string]any{"x": *point.X, "y": *point.Y}, utils.CRSCartesian, nil
+	}
+	return nil, "", fmt.Errorf("%s must have either latitude and longitude or x and y", name)
+}
+
+// spatialObjectNodesQuery is the start of a query matching the object nodes of a type having the POINT property,
+// it returns the query and the reference to the property
+func spatialObjectNodesQuery(typeArg string, property string) (string, string, error) {
+	reference, err := propertyReference("objectNode", property)
+	if err != nil {
+		return "", "", err
+	}
+	return fmt.Sprintf("MATCH (objectNode:`%s` {_domain: $domain, _type: $typeArg}) WHERE %s IS NOT NULL", typeLabel(typeArg), reference), reference, nil
+}
+
+func (db *Neo4jDatabase) ObjectNodesWithinDistance(ctx context.Context, domain string, typeArg string, property string, center model.PointInput, meters float64) (*model.ObjectNodesResponse, error) {
+	ctx, done := instrument(ctx, "ObjectNodesWithinDistance")
+	defer done()
+
+	domain = strings.TrimSpace(domain)
+	typeArg = strings.TrimSpace(strings.ToUpper(typeArg))
+
+	centerParameter, _, err := pointParameter("center", center)
+	if err != nil {
+		message := err.Error()
+		return &model.ObjectNodesResponse{Success: false, Message: &message, ObjectNodes: nil}, nil
+	}
+	if meters < 0 {
+		message := "meters must not be negative"
+		return &model.ObjectNodesResponse{Success: false, Message: &message, ObjectNodes: nil}, nil
+	}
+	query, reference, err := spatialObjectNodesQuery(typeArg, property)
+	if err != nil {
+		message := err.Error()
+		return &model.ObjectNodesResponse{Success: false, Message: &message, ObjectNodes: nil}, nil
+	}
+	// The distance is compared in the WHERE of the MATCH so the planner seeks the point index
+	query += fmt.Sprintf(` AND point.distance(%[1]s, point($center)) <= $meters
+		WITH objectNode, point.distance(%[1]s, point($center)) AS distance
+		RETURN objectNode
+		ORDER BY distance
+	`, reference)
+
+	parameters := map[string]any{
+		"domain":  domain,
+		"typeArg": typeArg,
+		"center":  centerParameter,
+		"meters":  meters,
+	}
+
+	data, err := db.readObjectNodes(ctx, query, parameters)
+	if err != nil {
+		return nil, err
+	}
+	message := fmt.Sprintf("%d object nodes found within %v of the center", len(data), meters)
+	return &model.ObjectNodesResponse{Success: true, Message: &message, ObjectNodes: data}, nil
+}
+
+func (db *Neo4jDatabase) ObjectNodesInBoundingBox(ctx context.Context, domain string, typeArg string, property string, lowerLeft model.PointInput, upperRight model.PointInput) (*model.ObjectNodesResponse, error) {
+	ctx, done := instrument(ctx, "ObjectNodesInBoundingBox")
+	defer done()
+
+	domain = strings.TrimSpace(domain)
+	typeArg = strings.TrimSpace(strings.ToUpper(typeArg))
+
+	lowerLeftParameter, lowerLeftCRS, err := pointParameter("lowerLeft", lowerLeft)
+	if err != nil {
+		message := err.Error()
+		return &model.ObjectNodesResponse{Success: false, Message: &message, ObjectNodes: nil}, nil
+	}
+	upperRightParameter, upperRightCRS, err := pointParameter("upperRight", upperRight)
+	if err != nil {
+		message := err.Error()
+		return &model.ObjectNodesResponse{Success: false, Message: &message, ObjectNodes: nil}, nil
+	}
+	if lowerLeftCRS != upperRightCRS {
+		message := "lowerLeft and upperRight must be points of the same kind"
+		return &model.ObjectNodesResponse{Success: false, Message: &message, ObjectNodes: nil}, nil
+	}
+	query, reference, err := spatialObjectNodesQuery(typeArg, property)
+	if err != nil {
+		message := err.Error()
+		return &model.ObjectNodesResponse{Success: false, Message: &message, ObjectNodes: nil}, nil
+	}
+	query += fmt.Sprintf(" AND point.withinBBox(%s, point($lowerLeft), point($upperRight)) RETURN objectNode", reference)
+
+	parameters := map[string]any{
+		"domain":     domain,
+		"typeArg":    typeArg,
+		"lowerLeft":  lowerLeftParameter,
+		"upperRight": upperRightParameter,
+	}
+
+	data, err := db.readObjectNodes(ctx, query, parameters)
+	if err != nil {
+		return nil, err
+	}
+	message := fmt.Sprintf("%d object nodes found in the bounding box", len(data))
+	return &model.ObjectNodesResponse{Success: true, Message: &message, ObjectNodes: data}, nil
+}
+
+// readObjectNodes runs a read query returning objectNode rows
+func (db *Neo4jDatabase) readObjectNodes(ctx context.Context, query string, parameters map[string]any) ([]*model.ObjectNode, error) {
+	session := db.newSession(ctx, neo4j.AccessModeRead)
+	defer session.Close(ctx)
+
+	result, err := readQuery(ctx, session, query, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	data := []*model.ObjectNode{}
+	for result.Next(ctx) {
+		record := result.Record()
+		node, ok := record.Get("objectNode")
+		if !ok {
+			return nil, fmt.Errorf("failed to retrieve the object node")
+		}
+		neo4jNode, ok := node.(dbtype.Node)
+		if !ok {
+			return nil, fmt.Errorf("unexpected type for node: %T", node)
+		}
+
+		data = append(data, &model.ObjectNode{
+			ID:           utils.PopString(neo4jNode.Props, "_id"),
+			Name:         utils.PopString(neo4jNode.Props, "_name"),
+			Type:         utils.PopString(neo4jNode.Props, "_type"),
+			Domain:       utils.PopString(neo4jNode.Props, "_domain"),
+			OriginalName: utils.PopString(neo4jNode.Props, "_originalName"),
+			Labels:       neo4jNode.Labels,
+			Properties:   utils.ExtractPropertiesFromNeo4jNode(neo4jNode.Props),
+		})
+	}
+	if err := result.Err(); err != nil {
+		return nil, err
+	}
+	return data, db.addComputedProperties(ctx, data...)
+}
@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mike-jacks/neo/model"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+)
+
+func TestObjectNodesWithinDistanceFiltersInTheWhereOfTheMatch(t *testing.T) {
+	session := &fakeSession{maxAttempts: 1, runs: []func(string) ([]*neo4j.Record, error){
+		returning(),
+	}}
+	database := &Neo4jDatabase{Driver: &fakeDriver{session: session}}
+
+	latitude, longitude := 52.37, 4.89
+	response, err := database.ObjectNodesWithinDistance(context.Background(), "infra", "site", "location", model.PointInput{Latitude: &latitude, Longitude: &longitude}, 1000)
+	if err != nil {
+		t.Fatalf("ObjectNodesWithinDistance failed: %v", err)
+	}
+	if !response.Success {
+		t.Fatalf("got response %v, want success", response)
+	}
+	if len(session.queries) != 1 {
+		t.Fatalf("ran %d queries, want 1", len(session.queries))
+	}
+
+	// A point index is only sought for a distance predicate in the WHERE directly after the MATCH, so
+	// nothing may come between them
+	query := strings.Join(strings.Fields(session.queries[0]), " ")
+	match := strings.Index(query, "MATCH (objectNode:")
+	predicate := strings.Index(query, "point.distance(objectNode.`location`, point($center)) <= $meters")
+	with := strings.Index(query, "WITH")
+	if match < 0 || predicate < 0 || with < 0 {
+		t.Fatalf("query %s does not match, filter by distance and compute the distance", query)
+	}
+	if strings.Count(query[match:predicate], "WHERE") != 1 || with < predicate {
+		t.Errorf("query %s filters by distance after the WHERE of the MATCH", query)
+	}
+	if !strings.HasSuffix(query, "ORDER BY distance") {
+		t.Errorf("query %s does not order by distance", query)
+	}
+}
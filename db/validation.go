@@ -0,0 +1,113 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mike-jacks/neo/model"
+	"github.com/mike-jacks/neo/utils"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// validateObjectProperties checks properties, already cleaned up, about to be written on the object node id of domain
+// and typeName against the type schema node of that type and its ancestors: computed properties, JSON Schemas,
+// references and property constraints. When id is empty the object node is about to be created and the returned
+// properties include the default values of the constrained properties it does not set, those of the type winning over
+// those of its ancestors. Types without a type schema node only get their references checked.
+func (db *Neo4jDatabase) validateObjectProperties(ctx context.Context, domain string, typeName string, id string, properties []*model.PropertyInput) ([]*model.PropertyInput, error) {
+	chain, err := db.typeSchemaNodeChain(ctx, domain, typeName)
+	if err != nil {
+		return nil, err
+	}
+	if len(chain) == 0 {
+		chain = []*model.TypeSchemaNode{{}}
+	}
+	for _, typeSchemaNode := range chain {
+		if id == "" {
+			if properties, err = withDefaultValues(typeSchemaNode, properties); err != nil {
+				return nil, err
+			}
+		}
+		if err := rejectComputedProperties(typeSchemaNode, properties); err != nil {
+			return nil, err
+		}
+		if err := validateJSONProperties(typeSchemaNode, properties); err != nil {
+			return nil, err
+		}
+		if err := db.validatePropertyConstraints(ctx, typeSchemaNode, id, properties); err != nil {
+			return nil, err
+		}
+		if err := db.validateReferences(ctx, typeSchemaNode, properties); err != nil {
+			return nil, err
+		}
+	}
+	return properties, nil
+}
+
+// validateObjectNodeProperties is validateObjectProperties for the existing object node id
+func (db *Neo4jDatabase) validateObjectNodeProperties(ctx context.Context, id string, properties []*model.PropertyInput) error {
+	domain, typeName, err := db.objectNodeType(ctx, id)
+	if err != nil {
+		return err
+	}
+	_, err = db.validateObjectProperties(ctx, domain, typeName, id, properties)
+	return err
+}
+
+// typeSchemaNodeOf returns the type schema node of the object nodes of domain and typeName, nil when there is none
+func (db *Neo4jDatabase) typeSchemaNodeOf(ctx context.Context, domain string, typeName string) (*model.TypeSchemaNode, error) {
+	session := db.newSession(ctx, neo4j.AccessModeRead)
+	defer session.Close(ctx)
+
+	query := `MATCH (typeSchemaNode:TYPE_SCHEMA {_domain: $domain, _name: $name}) RETURN typeSchemaNode`
+	parameters := map[string]any{
+		"domain": domain,
+		"name":   utils.RemoveSpacesAndUpperCase(typeName),
+	}
+	result, err := readQuery(ctx, session, query, parameters)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Next(ctx) {
+		return nil, result.Err()
+	}
+	node, ok := result.Record().Get("typeSchemaNode")
+	if !ok {
+		return nil, fmt.Errorf("failed to retrieve the typeSchemaNode")
+	}
+	neo4jTypeSchemaNode, ok := node.(dbtype.Node)
+	if !ok {
+		return nil, fmt.Errorf("unexpected type for typeSchemaNode: %T", node)
+	}
+	return &model.TypeSchemaNode{
+		ID:                     utils.PopString(neo4jTypeSchemaNode.Props, "_id"),
+		Domain:                 utils.PopString(neo4jTypeSchemaNode.Props, "_domain"),
+		Name:                   utils.PopString(neo4jTypeSchemaNode.Props, "_name"),
+		OriginalName:           utils.PopString(neo4jTypeSchemaNode.Props, "_originalName"),
+		Type:                   utils.PopString(neo4jTypeSchemaNode.Props, "_type"),
+		JSONSchemas:            utils.PopJSONSchemas(neo4jTypeSchemaNode.Props),
+		Constraints:            utils.PopPropertyConstraints(neo4jTypeSchemaNode.Props),
+		ComputedProperties:     utils.PopComputedProperties(neo4jTypeSchemaNode.Props),
+		ParentTypeSchemaNodeID: utils.PopParentTypeSchemaNodeID(neo4jTypeSchemaNode.Props),
+		Properties:             utils.ExtractPropertiesFromNeo4jNode(neo4jTypeSchemaNode.Props),
+		Labels:                 neo4jTypeSchemaNode.Labels,
+	}, nil
+}
+
+// objectNodeType returns the domain and type of an object node, empty when it does not exist
+func (db *Neo4jDatabase) objectNodeType(ctx context.Context, id string) (string, string, error) {
+	session := db.newSession(ctx, neo4j.AccessModeRead)
+	defer session.Close(ctx)
+
+	result, err := readQuery(ctx, session, `MATCH (objectNode {_id: $id}) RETURN objectNode._domain AS domain, objectNode._type AS type`, map[string]any{"id": id})
+	if err != nil {
+		return "", "", err
+	}
+	if !result.Next(ctx) {
+		return "", "", result.Err()
+	}
+	domain, _, _ := neo4j.GetRecordValue[string](result.Record(), "domain")
+	typeName, _, _ := neo4j.GetRecordValue[string](result.Record(), "type")
+	return domain, typeName, nil
+}
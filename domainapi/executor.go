@@ -0,0 +1,337 @@
+package domainapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/introspection"
+	"github.com/mike-jacks/neo/db"
+	"github.com/mike-jacks/neo/model"
+	"github.com/mike-jacks/neo/subscriptions"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// executableSchema runs operations against a generated domain schema. gqlgen parses and validates the
+// operation, this resolves it field by field as there is no generated code to do it.
+type executableSchema struct {
+	ds       *domainSchema
+	database db.Database
+	// publish sends the subscription events of the changes mutations make, like the resolvers of the main API
+	publish func(eventType subscriptions.EventType, data interface{})
+}
+
+func (e *executableSchema) Schema() *ast.Schema {
+	return e.ds.schema
+}
+
+func (e *executableSchema) Complexity(typeName, fieldName string, childComplexity int, args map[string]any) (int, bool) {
+	return 0, false
+}
+
+func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
+	opCtx := graphql.GetOperationContext(ctx)
+
+	var root *ast.Definition
+	switch opCtx.Operation.Operation {
+	case ast.Query:
+		root = e.ds.schema.Query
+	case ast.Mutation:
+		root = e.ds.schema.Mutation
+	default:
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "%s operations are not supported", opCtx.Operation.Operation))
+	}
+
+	ex := &execution{executableSchema: e, opCtx: opCtx}
+	data, _ := ex.selectObject(ctx, root, opCtx.Operation.SelectionSet, nil, nil)
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return graphql.OneShot(graphql.ErrorResponse(ctx, "unable to encode the response: %s", err))
+	}
+	return graphql.OneShot(&graphql.Response{Data: encoded, Errors: ex.errors})
+}
+
+// execution is the state of a single operation, fields are resolved one after the other which also
+// gives mutations their required serial execution
+type execution struct {
+	*executableSchema
+	opCtx  *graphql.OperationContext
+	errors gqlerror.List
+}
+
+// selectObject resolves the selected fields of parent, which is nil for the root types, a
+// *model.ObjectNode for generated types or an introspection value. It returns false when a non-null
+// field is null, making the object itself null.
+func (ex *execution) selectObject(ctx context.Context, definition *ast.Definition, selections ast.SelectionSet, parent any, path ast.Path) (any, bool) {
+	result := &orderedObject{}
+	for _, field := range graphql.CollectFields(ex.opCtx, selections, []string{definition.Name}) {
+		fieldPath := append(append(ast.Path{}, path...), ast.PathName(field.Alias))
+		if field.Name == "__typename" {
+			result.set(field.Alias, definition.Name)
+			continue
+		}
+
+		value, err := ex.resolveField(ctx, definition, parent, field)
+		if err != nil {
+			ex.errors = append(ex.errors, &gqlerror.Error{Message: err.Error(), Path: fieldPath})
+			value = nil
+		}
+		completed, ok := ex.complete(ctx, field.Definition.Type, field, value, fieldPath)
+		if !ok {
+			return nil, false
+		}
+		result.set(field.Alias, completed)
+	}
+	return result, true
+}
+
+// complete turns a resolved value into its response value according to its GraphQL type
+func (ex *execution) complete(ctx context.Context, typ *ast.Type, field graphql.CollectedField, value any, path ast.Path) (any, bool) {
+	if isNil(value) {
+		if typ.NonNull {
+			ex.errors = append(ex.errors, &gqlerror.Error{Message: "must not be null", Path: path})
+			return nil, false
+		}
+		return nil, true
+	}
+
+	if typ.Elem != nil {
+		items := reflect.ValueOf(value)
+		if items.Kind() != reflect.Slice {
+			return ex.nullOrFail(typ, fmt.Errorf("expected a list, got %T", value), path)
+		}
+		list := make([]any, 0, items.Len())
+		for i := 0; i < items.Len(); i++ {
+			item, ok := ex.complete(ctx, typ.Elem, field, items.Index(i).Interface(), append(append(ast.Path{}, path...), ast.PathIndex(i)))
+			if !ok {
+				return ex.nullOrFail(typ, nil, path)
+			}
+			list = append(list, item)
+		}
+		return list, true
+	}
+
+	definition := ex.ds.schema.Types[typ.NamedType]
+	switch definition.Kind {
+	case ast.Object:
+		object, ok := ex.selectObject(ctx, definition, field.Selections, value, path)
+		if !ok {
+			return ex.nullOrFail(typ, nil, path)
+		}
+		return object, true
+	default:
+		scalar, err := coerceScalar(typ.NamedType, value)
+		if err != nil {
+			return ex.nullOrFail(typ, err, path)
+		}
+		return scalar, true
+	}
+}
+
+// nullOrFail records err, if any, and returns null, which fails the parent when typ is non-null
+func (ex *execution) nullOrFail(typ *ast.Type, err error, path ast.Path) (any, bool) {
+	if err != nil {
+		ex.errors = append(ex.errors, &gqlerror.Error{Message: err.Error(), Path: path})
+	}
+	return nil, !typ.NonNull
+}
+
+func (ex *execution) resolveField(ctx context.Context, definition *ast.Definition, parent any, field graphql.CollectedField) (any, error) {
+	args := field.ArgumentMap(ex.opCtx.Variables)
+
+	if parent == nil {
+		switch field.Name {
+		case "__schema":
+			return introspection.WrapSchema(ex.ds.schema), nil
+		case "__type":
+			name, _ := args["name"].(string)
+			return introspection.WrapTypeFromDef(ex.ds.schema, ex.ds.schema.Types[name]), nil
+		case "domain":
+			return ex.ds.domain, nil
+		}
+		root := ex.ds.queries
+		if definition == ex.ds.schema.Mutation {
+			root = ex.ds.mutations
+		}
+		return ex.resolveRoot(ctx, root[field.Name], args)
+	}
+
+	if node, ok := parent.(*model.ObjectNode); ok {
+		return ex.resolveObjectField(ctx, ex.ds.types[definition.Name], node, field.Name)
+	}
+	return resolveIntrospectionField(parent, field.Name, args)
+}
+
+func (ex *execution) resolveObjectField(ctx context.Context, object *objectType, node *model.ObjectNode, field string) (any, error) {
+	switch field {
+	case "id":
+		return node.ID, nil
+	case "name":
+		return node.Name, nil
+	case "originalName":
+		return node.OriginalName, nil
+	}
+
+	if property, ok := object.properties[field]; ok {
+		for _, nodeProperty := range node.Properties {
+			if nodeProperty.Key == property.key {
+				return nodeProperty.Value, nil
+			}
+		}
+		return nil, nil
+	}
+
+	if relationship, ok := object.relationships[field]; ok {
+		return ex.related(ctx, node, relationship)
+	}
+	return nil, fmt.Errorf("unknown field %s", field)
+}
+
+// related returns the target object nodes of node's outgoing relationships of a relationship field
+func (ex *execution) related(ctx context.Context, node *model.ObjectNode, relationship *relationshipField) ([]*model.ObjectNode, error) {
+	response, err := ex.database.GetObjectNodeOutgoingRelationships(ctx, node.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := []*model.ObjectNode{}
+	for _, objectRelationship := range response.ObjectRelationships {
+		if objectRelationship.Name != relationship.name {
+			continue
+		}
+		target, err := ex.database.GetObjectNode(ctx, objectRelationship.ToObjectNodeID)
+		if err != nil {
+			return nil, err
+		}
+		// Object nodes of subtypes of the target type are targets too, as when they were added
+		if target.ObjectNode != nil && ex.isOfType(target.ObjectNode, relationship.target) {
+			targets = append(targets, target.ObjectNode)
+		}
+	}
+	return targets, nil
+}
+
+// resolveIntrospectionField reads a field of the gqlgen introspection types, which expose it either as
+// a method, taking includeDeprecated for some, or as a struct field
+func resolveIntrospectionField(parent any, field string, args map[string]any) (any, error) {
+	value := reflect.ValueOf(parent)
+	if value.Kind() != reflect.Pointer {
+		pointer := reflect.New(value.Type())
+		pointer.Elem().Set(value)
+		value = pointer
+	}
+
+	name := upperFirst(field)
+	if method := value.MethodByName(name); method.IsValid() {
+		in := []reflect.Value{}
+		if method.Type().NumIn() == 1 {
+			includeDeprecated, _ := args["includeDeprecated"].(bool)
+			in = append(in, reflect.ValueOf(includeDeprecated))
+		}
+		return method.Call(in)[0].Interface(), nil
+	}
+	if structField := value.Elem().FieldByName(name); structField.IsValid() {
+		return structField.Interface(), nil
+	}
+	return nil, fmt.Errorf("unknown introspection field %s", field)
+}
+
+func coerceScalar(typeName string, value any) (any, error) {
+	if pointer := reflect.ValueOf(value); pointer.Kind() == reflect.Pointer {
+		value = pointer.Elem().Interface()
+	}
+	switch typeName {
+	case "String", "ID":
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+		return fmt.Sprint(value), nil
+	case "Int":
+		if number, ok := toFloat(value); ok && number == math.Trunc(number) {
+			return int64(number), nil
+		}
+		return nil, fmt.Errorf("%v is not an Int", value)
+	case "Float":
+		if number, ok := toFloat(value); ok {
+			return number, nil
+		}
+		return nil, fmt.Errorf("%v is not a Float", value)
+	case "Boolean":
+		if b, ok := value.(bool); ok {
+			return b, nil
+		}
+		return nil, fmt.Errorf("%v is not a Boolean", value)
+	}
+	return value, nil
+}
+
+func toFloat(value any) (float64, bool) {
+	switch number := value.(type) {
+	case float64:
+		return number, true
+	case int64:
+		return float64(number), true
+	case int:
+		return float64(number), true
+	case json.Number:
+		f, err := number.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func isNil(value any) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Slice, reflect.Map, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
+
+// orderedObject is a response object keeping its fields in selection order
+type orderedObject struct {
+	keys   []string
+	values map[string]any
+}
+
+func (o *orderedObject) set(key string, value any) {
+	if o.values == nil {
+		o.values = map[string]any{}
+	}
+	if _, ok := o.values[key]; !ok {
+		o.keys = append(o.keys, key)
+	}
+	o.values[key] = value
+}
+
+func (o *orderedObject) MarshalJSON() ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, key := range o.keys {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		encodedKey, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		encodedValue, err := json.Marshal(o.values[key])
+		if err != nil {
+			return nil, err
+		}
+		b.Write(encodedKey)
+		b.WriteByte(':')
+		b.Write(encodedValue)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
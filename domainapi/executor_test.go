@@ -0,0 +1,63 @@
+package domainapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mike-jacks/neo/db"
+	"github.com/mike-jacks/neo/model"
+)
+
+// fakeDatabase serves object nodes and their outgoing object relationships from memory
+type fakeDatabase struct {
+	db.Database
+	objectNodes   map[string]*model.ObjectNode
+	relationships []*model.ObjectRelationship
+}
+
+func (d *fakeDatabase) GetObjectNode(ctx context.Context, id string) (*model.ObjectNodeResponse, error) {
+	objectNode, ok := d.objectNodes[id]
+	return &model.ObjectNodeResponse{Success: ok, ObjectNode: objectNode}, nil
+}
+
+func (d *fakeDatabase) GetObjectNodeOutgoingRelationships(ctx context.Context, fromObjectNodeId string) (*model.ObjectRelationshipsResponse, error) {
+	relationships := []*model.ObjectRelationship{}
+	for _, relationship := range d.relationships {
+		if relationship.FromObjectNodeID == fromObjectNodeId {
+			relationships = append(relationships, relationship)
+		}
+	}
+	return &model.ObjectRelationshipsResponse{Success: true, ObjectRelationships: relationships}, nil
+}
+
+func TestRelatedIncludesTargetsOfSubtypes(t *testing.T) {
+	asset := &objectType{name: "Asset", typeName: "ASSET", subtypeNames: map[string]bool{"SERVER": true, "LAPTOP": true}}
+	owns := &relationshipField{name: "OWNS", target: asset}
+	database := &fakeDatabase{
+		objectNodes: map[string]*model.ObjectNode{
+			"team":     {ID: "team", Domain: "infra", Type: "TEAM"},
+			"asset":    {ID: "asset", Domain: "infra", Type: "ASSET"},
+			"server":   {ID: "server", Domain: "infra", Type: "SERVER"},
+			"laptop":   {ID: "laptop", Domain: "infra", Type: "LAPTOP"},
+			"person":   {ID: "person", Domain: "infra", Type: "PERSON"},
+			"external": {ID: "external", Domain: "other", Type: "SERVER"},
+		},
+	}
+	for _, target := range []string{"asset", "server", "laptop", "person", "external"} {
+		database.relationships = append(database.relationships, &model.ObjectRelationship{Name: "OWNS", FromObjectNodeID: "team", ToObjectNodeID: target})
+	}
+	database.relationships = append(database.relationships, &model.ObjectRelationship{Name: "USES", FromObjectNodeID: "team", ToObjectNodeID: "server"})
+	ex := &execution{executableSchema: &executableSchema{ds: &domainSchema{domain: "infra"}, database: database}}
+
+	targets, err := ex.related(context.Background(), database.objectNodes["team"], owns)
+	if err != nil {
+		t.Fatalf("related failed: %v", err)
+	}
+	ids := []string{}
+	for _, target := range targets {
+		ids = append(ids, target.ID)
+	}
+	if len(ids) != 3 || ids[0] != "asset" || ids[1] != "server" || ids[2] != "laptop" {
+		t.Errorf("got targets %v, want asset, server and laptop", ids)
+	}
+}
@@ -0,0 +1,138 @@
+package domainapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/mike-jacks/neo/db"
+	"github.com/mike-jacks/neo/subscriptions"
+)
+
+// schemaMaxAge bounds how long a generated schema is served without being rebuilt. Schema node changes
+// made through this server rebuild it right away, this catches those made by another instance, a
+// migration or a Cypher mutation.
+const schemaMaxAge = time.Minute
+
+// schemaEvents are the subscription events after which the generated schemas are rebuilt
+var schemaEvents = []subscriptions.EventType{
+	subscriptions.DomainSchemaNodeCreated,
+	subscriptions.DomainSchemaNodeUpdated,
+	subscriptions.DomainSchemaNodeDeleted,
+	subscriptions.TypeSchemaNodeCreated,
+	subscriptions.TypeSchemaNodeUpdated,
+	subscriptions.TypeSchemaNodeDeleted,
+	subscriptions.RelationshipSchemaNodeCreated,
+	subscriptions.RelationshipSchemaNodeUpdated,
+	subscriptions.RelationshipSchemaNodeDeleted,
+}
+
+var errDomainNotFound = errors.New("domain not found")
+
+// Registry serves the generated API of every domain at /domains/{domain}/query, building each
+// domain's schema on first use and again after its schema nodes change
+type Registry struct {
+	database  db.Database
+	publish   func(eventType subscriptions.EventType, data interface{})
+	newServer func(schema graphql.ExecutableSchema) *handler.Server
+
+	mu      sync.Mutex
+	servers map[string]*domainServer
+	// generation counts the invalidations, a schema built across one is served but not cached
+	generation int
+}
+
+type domainServer struct {
+	server  *handler.Server
+	builtAt time.Time
+}
+
+// NewRegistry creates a Registry serving domains through servers created by newServer, which adds the
+// transports and extensions, and rebuilding them on the schema events of subscriptionManager. Mutations
+// publish their events to subscriptionManager too.
+func NewRegistry(database db.Database, subscriptionManager *subscriptions.SubscriptionManager, newServer func(schema graphql.ExecutableSchema) *handler.Server) *Registry {
+	r := &Registry{
+		database:  database,
+		publish:   subscriptionManager.Publish,
+		newServer: newServer,
+		servers:   map[string]*domainServer{},
+	}
+	for _, eventType := range schemaEvents {
+		subscriber := subscriptionManager.Subscribe(eventType)
+		go func() {
+			for range subscriber.Events {
+				r.Invalidate()
+			}
+		}()
+	}
+	return r
+}
+
+// Invalidate drops every generated schema, they are rebuilt on their next request
+func (r *Registry) Invalidate() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.servers = map[string]*domainServer{}
+	r.generation++
+}
+
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	domain := req.PathValue("domain")
+	server, err := r.server(req.Context(), domain)
+	if errors.Is(err, errDomainNotFound) {
+		http.Error(w, fmt.Sprintf("domain %s not found", domain), http.StatusNotFound)
+		return
+	}
+	if err != nil {
+		slog.Error("unable to build the domain API", slog.String("domain", domain), slog.Any("error", err))
+		http.Error(w, "unable to build the domain API", http.StatusInternalServerError)
+		return
+	}
+	server.ServeHTTP(w, req)
+}
+
+// server returns the cached server of domain, building it when it is missing or too old. Building reads
+// the schema nodes without holding the lock, so slow builds do not hold up the other domains.
+func (r *Registry) server(ctx context.Context, domain string) (*handler.Server, error) {
+	r.mu.Lock()
+	cached, ok := r.servers[domain]
+	generation := r.generation
+	r.mu.Unlock()
+
+	if ok && time.Since(cached.builtAt) < schemaMaxAge {
+		return cached.server, nil
+	}
+
+	domains, err := r.database.GetDomainSchemaNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	found := false
+	for _, domainSchemaNode := range domains.DomainSchemaNodes {
+		found = found || domainSchemaNode.Domain == domain
+	}
+	if !found {
+		return nil, errDomainNotFound
+	}
+
+	ds, err := buildSchema(ctx, r.database, domain)
+	if err != nil {
+		return nil, err
+	}
+	server := r.newServer(&executableSchema{ds: ds, database: r.database, publish: r.publish})
+	slog.Info("domain API schema built", slog.String("domain", domain), slog.Int("types", len(ds.types)))
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.generation == generation {
+		r.servers[domain] = &domainServer{server: server, builtAt: time.Now()}
+	}
+	return server, nil
+}
@@ -0,0 +1,195 @@
+package domainapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mike-jacks/neo/model"
+	"github.com/mike-jacks/neo/subscriptions"
+	"github.com/mike-jacks/neo/utils"
+)
+
+// resolveRoot runs a generated query or mutation through the generic object node operations
+func (ex *execution) resolveRoot(ctx context.Context, field *rootField, args map[string]any) (any, error) {
+	if field == nil {
+		return nil, fmt.Errorf("unknown field")
+	}
+	object := field.object
+	id, _ := args["id"].(string)
+
+	switch field.kind {
+	case rootGet:
+		response, err := ex.database.GetObjectNode(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if response.ObjectNode == nil || !ex.isOfType(response.ObjectNode, object) {
+			return nil, nil
+		}
+		return response.ObjectNode, nil
+
+	case rootList:
+		domain, typeName := ex.ds.domain, object.typeName
+		response, err := ex.database.GetObjectNodes(ctx, &domain, &typeName, nil, nil)
+		if err != nil {
+			return nil, err
+		}
+		if !response.Success {
+			return nil, failure(response.Message)
+		}
+		return response.ObjectNodes, nil
+
+	case rootCreate:
+		name, _ := args["name"].(string)
+		properties, _, err := toProperties(object, args["input"])
+		if err != nil {
+			return nil, err
+		}
+		if len(properties) == 0 {
+			properties = nil
+		}
+		response, err := ex.database.CreateObjectNode(ctx, ex.ds.domain, name, object.typeName, nil, properties)
+		if err != nil {
+			return nil, err
+		}
+		if !response.Success {
+			return nil, failure(response.Message)
+		}
+		ex.publish(subscriptions.ObjectNodeCreated, response)
+		return response.ObjectNode, nil
+
+	case rootUpdate:
+		if _, err := ex.load(ctx, id, object); err != nil {
+			return nil, err
+		}
+		var name *string
+		if value, ok := args["name"].(string); ok {
+			name = &value
+		}
+		properties, removed, err := toProperties(object, args["input"])
+		if err != nil {
+			return nil, err
+		}
+		response, err := ex.database.UpdateObjectNode(ctx, id, name, properties, removed)
+		if err != nil {
+			return nil, err
+		}
+		if !response.Success {
+			return nil, failure(response.Message)
+		}
+		ex.publish(subscriptions.ObjectNodeUpdated, response)
+		return response.ObjectNode, nil
+
+	case rootDelete:
+		if _, err := ex.load(ctx, id, object); err != nil {
+			return nil, err
+		}
+		response, err := ex.database.DeleteObjectNode(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if !response.Success {
+			return nil, failure(response.Message)
+		}
+		ex.publish(subscriptions.ObjectNodeDeleted, response)
+		return true, nil
+
+	case rootAdd:
+		targetID, _ := args["targetId"].(string)
+		if _, err := ex.load(ctx, id, object); err != nil {
+			return nil, err
+		}
+		if _, err := ex.load(ctx, targetID, field.relationship.target); err != nil {
+			return nil, err
+		}
+		response, err := ex.database.CreateObjectRelationship(ctx, field.relationship.name, nil, id, targetID)
+		if err != nil {
+			return nil, err
+		}
+		if !response.Success {
+			return nil, failure(response.Message)
+		}
+		ex.publish(subscriptions.ObjectRelationshipCreated, response)
+		return ex.load(ctx, id, object)
+
+	case rootRemove:
+		targetID, _ := args["targetId"].(string)
+		if _, err := ex.load(ctx, id, object); err != nil {
+			return nil, err
+		}
+		relationships, err := ex.database.GetObjectNodeOutgoingRelationships(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, relationship := range relationships.ObjectRelationships {
+			if relationship.Name != field.relationship.name || relationship.ToObjectNodeID != targetID {
+				continue
+			}
+			response, err := ex.database.DeleteObjectRelationship(ctx, relationship.ID)
+			if err != nil {
+				return nil, err
+			}
+			if !response.Success {
+				return nil, failure(response.Message)
+			}
+			ex.publish(subscriptions.ObjectRelationshipDeleted, response)
+		}
+		return ex.load(ctx, id, object)
+	}
+	return nil, fmt.Errorf("unsupported field")
+}
+
+// load returns the object node with id, failing unless it is of the given type in this domain
+func (ex *execution) load(ctx context.Context, id string, object *objectType) (*model.ObjectNode, error) {
+	response, err := ex.database.GetObjectNode(ctx, id)
+	if err != nil || response.ObjectNode == nil || !ex.isOfType(response.ObjectNode, object) {
+		return nil, fmt.Errorf("%s %s not found", object.name, id)
+	}
+	return response.ObjectNode, nil
+}
+
+func (ex *execution) isOfType(node *model.ObjectNode, object *objectType) bool {
+	return node.Domain == ex.ds.domain && (node.Type == object.typeName || object.subtypeNames[node.Type])
+}
+
+// toProperties converts an input object to the properties to set and the keys of those set to null
+func toProperties(object *objectType, input any) ([]*model.PropertyInput, []string, error) {
+	fields, _ := input.(map[string]any)
+	properties := []*model.PropertyInput{}
+	removed := []string{}
+	for _, field := range object.fieldOrder {
+		value, ok := fields[field]
+		if !ok {
+			continue
+		}
+		property := object.properties[field]
+		if value == nil {
+			removed = append(removed, property.key)
+			continue
+		}
+		converted, err := toPropertyValue(property.propertyType, value)
+		if err != nil {
+			return nil, nil, fmt.Errorf("%s: %w", field, err)
+		}
+		properties = append(properties, &model.PropertyInput{Key: property.key, Type: property.propertyType, Value: converted})
+	}
+	return properties, removed, nil
+}
+
+// toPropertyValue checks the shape of a field value, the database normalizes it to the property type
+func toPropertyValue(propertyType model.PropertyType, value any) (any, error) {
+	if utils.IsArrayPropertyType(propertyType) {
+		if _, ok := value.([]any); !ok {
+			return nil, fmt.Errorf("expected a list")
+		}
+	}
+	return value, nil
+}
+
+// failure is the error of an unsuccessful response
+func failure(message *string) error {
+	if message == nil {
+		return fmt.Errorf("unsuccessful response")
+	}
+	return fmt.Errorf("%s", *message)
+}
@@ -0,0 +1,386 @@
+// Package domainapi serves a typed GraphQL API per domain, generated from its type and relationship
+// schema nodes. A type schema node Server with a hostname STRING property and a RUNS_ON relationship
+// schema node to Host becomes
+//
+//	type Server {
+//	  id: ID!
+//	  name: String!
+//	  originalName: String!
+//	  hostname: String
+//	  runsOn: [Host!]!
+//	}
+//
+// with server(id) and servers queries and createServer, updateServer, deleteServer, addServerRunsOn and
+// removeServerRunsOn mutations, all backed by the generic object node operations of db.Database.
+package domainapi
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/mike-jacks/neo/db"
+	"github.com/mike-jacks/neo/model"
+	"github.com/vektah/gqlparser/v2"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// domainSchema is the generated schema of a domain and what its names stand for
+type domainSchema struct {
+	domain    string
+	schema    *ast.Schema
+	types     map[string]*objectType
+	queries   map[string]*rootField
+	mutations map[string]*rootField
+}
+
+// objectType is the GraphQL object type generated for a type schema node
+type objectType struct {
+	name     string
+	typeName string
+	// subtypeNames are the type names of the type schema nodes extending it, their object nodes are of this type too
+	subtypeNames  map[string]bool
+	properties    map[string]*propertyField
+	relationships map[string]*relationshipField
+	// fieldOrder lists property and relationship fields in declaration order
+	fieldOrder []string
+}
+
+type propertyField struct {
+	key          string
+	propertyType model.PropertyType
+}
+
+type relationshipField struct {
+	name   string
+	target *objectType
+}
+
+type rootKind int
+
+const (
+	rootGet rootKind = iota
+	rootList
+	rootCreate
+	rootUpdate
+	rootDelete
+	rootAdd
+	rootRemove
+)
+
+type rootField struct {
+	kind         rootKind
+	object       *objectType
+	relationship *relationshipField
+}
+
+var graphQLScalars = map[model.PropertyType]string{
+	model.PropertyTypeString:       "String",
+	model.PropertyTypeInteger:      "Int",
+	model.PropertyTypeFloat:        "Float",
+	model.PropertyTypeBoolean:      "Boolean",
+	model.PropertyTypeArrayString:  "[String!]",
+	model.PropertyTypeArrayInteger: "[Int!]",
+	model.PropertyTypeArrayFloat:   "[Float!]",
+	model.PropertyTypeArrayBoolean: "[Boolean!]",
+	// Temporal values are exchanged as ISO 8601 strings
+	model.PropertyTypeDate:          "String",
+	model.PropertyTypeDatetime:      "String",
+	model.PropertyTypeLocalDatetime: "String",
+	model.PropertyTypeDuration:      "String",
+}
+
+// reservedTypeNames cannot be generated, they are built in or used by the generated schema itself
+var reservedTypeNames = map[string]bool{
+	"Query": true, "Mutation": true, "Subscription": true,
+	"String": true, "Float": true, "Int": true, "Boolean": true, "ID": true,
+}
+
+var wordPattern = regexp.MustCompile(`[A-Za-z0-9]+`)
+
+// buildSchema generates the schema of domain from its stored schema nodes. Names that are not valid
+// GraphQL names or that collide with another generated name are skipped with a warning.
+func buildSchema(ctx context.Context, database db.Database, domain string) (*domainSchema, error) {
+	types, err := database.GetTypeSchemaNodes(ctx, &domain)
+	if err != nil {
+		return nil, err
+	}
+	relationships, err := database.GetRelationshipSchemaNodes(ctx, &domain)
+	if err != nil {
+		return nil, err
+	}
+
+	ds := &domainSchema{
+		domain:    domain,
+		types:     map[string]*objectType{},
+		queries:   map[string]*rootField{},
+		mutations: map[string]*rootField{},
+	}
+	byID := map[string]*objectType{}
+	typeNodes := types.TypeSchemaNodes
+	sort.Slice(typeNodes, func(i, j int) bool { return typeNodes[i].Name < typeNodes[j].Name })
+	for _, typeSchemaNode := range typeNodes {
+		originalName := typeSchemaNode.OriginalName
+		if originalName == "" {
+			originalName = typeSchemaNode.Name
+		}
+		name := pascalCase(originalName)
+		if name == "" || reservedTypeNames[name] || ds.types[name] != nil || ds.types[name+"Input"] != nil || ds.types[strings.TrimSuffix(name, "Input")] != nil {
+			slog.Warn("skipping type schema node in domain API", slog.String("domain", domain), slog.String("type", typeSchemaNode.Name))
+			continue
+		}
+		object := &objectType{
+			name:          name,
+			typeName:      typeSchemaNode.Name,
+			subtypeNames:  map[string]bool{},
+			properties:    map[string]*propertyField{},
+			relationships: map[string]*relationshipField{},
+		}
+		// A subtype inherits the properties of its ancestors it does not redefine
+		properties := append([]*model.Property{}, typeSchemaNode.Properties...)
+		keys := map[string]bool{}
+		for _, property := range properties {
+			keys[property.Key] = true
+		}
+		for _, ancestor := range db.TypeSchemaNodeAncestors(typeNodes, typeSchemaNode) {
+			for _, property := range ancestor.Properties {
+				if !keys[property.Key] {
+					keys[property.Key] = true
+					properties = append(properties, property)
+				}
+			}
+		}
+		sort.Slice(properties, func(i, j int) bool { return properties[i].Key < properties[j].Key })
+		for _, property := range properties {
+			field := camelCase(property.Key)
+			if _, ok := graphQLScalars[property.Type]; !ok || !object.addField(field) {
+				slog.Warn("skipping property in domain API", slog.String("domain", domain), slog.String("type", typeSchemaNode.Name), slog.String("property", property.Key))
+				continue
+			}
+			object.properties[field] = &propertyField{key: property.Key, propertyType: property.Type}
+		}
+		ds.types[name] = object
+		byID[typeSchemaNode.ID] = object
+	}
+
+	for _, typeSchemaNode := range typeNodes {
+		for _, ancestor := range db.TypeSchemaNodeAncestors(typeNodes, typeSchemaNode) {
+			if object := byID[ancestor.ID]; object != nil {
+				object.subtypeNames[typeSchemaNode.Name] = true
+			}
+		}
+	}
+
+	relationshipNodes := relationships.RelationshipSchemaNodes
+	sort.Slice(relationshipNodes, func(i, j int) bool { return relationshipNodes[i].Name < relationshipNodes[j].Name })
+	for _, relationship := range relationshipNodes {
+		from, to := byID[relationship.FromTypeSchemaNodeID], byID[relationship.ToTypeSchemaNodeID]
+		field := camelCase(relationship.Name)
+		if from == nil || to == nil || !from.addField(field) {
+			slog.Warn("skipping relationship schema node in domain API", slog.String("domain", domain), slog.String("relationship", relationship.Name))
+			continue
+		}
+		from.relationships[field] = &relationshipField{name: relationship.Name, target: to}
+
+		// Subtypes inherit the relationship schema nodes of their ancestors
+		for _, typeSchemaNode := range typeNodes {
+			subtype := byID[typeSchemaNode.ID]
+			if subtype == nil || subtype == from || !extends(typeNodes, typeSchemaNode, relationship.FromTypeSchemaNodeID) {
+				continue
+			}
+			if !subtype.addField(field) {
+				slog.Warn("skipping inherited relationship schema node in domain API", slog.String("domain", domain), slog.String("type", typeSchemaNode.Name), slog.String("relationship", relationship.Name))
+				continue
+			}
+			subtype.relationships[field] = &relationshipField{name: relationship.Name, target: to}
+		}
+	}
+
+	names := make([]string, 0, len(ds.types))
+	for name := range ds.types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		object := ds.types[name]
+		singular := lowerFirst(name)
+		ds.addRoot(ds.queries, singular, &rootField{kind: rootGet, object: object})
+		ds.addRoot(ds.queries, plural(singular), &rootField{kind: rootList, object: object})
+		ds.addRoot(ds.mutations, "create"+name, &rootField{kind: rootCreate, object: object})
+		ds.addRoot(ds.mutations, "update"+name, &rootField{kind: rootUpdate, object: object})
+		ds.addRoot(ds.mutations, "delete"+name, &rootField{kind: rootDelete, object: object})
+		for _, field := range object.fieldOrder {
+			if relationship, ok := object.relationships[field]; ok {
+				ds.addRoot(ds.mutations, "add"+name+upperFirst(field), &rootField{kind: rootAdd, object: object, relationship: relationship})
+				ds.addRoot(ds.mutations, "remove"+name+upperFirst(field), &rootField{kind: rootRemove, object: object, relationship: relationship})
+			}
+		}
+	}
+
+	schema, err := gqlparser.LoadSchema(&ast.Source{Name: domain, Input: ds.sdl()})
+	if err != nil {
+		return nil, fmt.Errorf("unable to generate the schema of domain %s: %w", domain, err)
+	}
+	ds.schema = schema
+	return ds, nil
+}
+
+// extends tells whether typeSchemaNode is a subtype of the type schema node id
+func extends(types []*model.TypeSchemaNode, typeSchemaNode *model.TypeSchemaNode, id string) bool {
+	for _, ancestor := range db.TypeSchemaNodeAncestors(types, typeSchemaNode) {
+		if ancestor.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// addField reserves a field name on the type, it fails for invalid or already used names
+func (o *objectType) addField(field string) bool {
+	if field == "" || field == "id" || field == "name" || field == "originalName" || strings.HasPrefix(field, "__") {
+		return false
+	}
+	if _, ok := o.properties[field]; ok {
+		return false
+	}
+	if _, ok := o.relationships[field]; ok {
+		return false
+	}
+	o.fieldOrder = append(o.fieldOrder, field)
+	return true
+}
+
+func (ds *domainSchema) addRoot(fields map[string]*rootField, name string, field *rootField) {
+	if _, ok := fields[name]; ok || name == "domain" {
+		slog.Warn("skipping colliding field in domain API", slog.String("domain", ds.domain), slog.String("field", name))
+		return
+	}
+	fields[name] = field
+}
+
+// sdl renders the schema definition, every domain schema has at least the domain query field
+func (ds *domainSchema) sdl() string {
+	var b strings.Builder
+
+	b.WriteString("type Query {\n  domain: String!\n")
+	for _, name := range sortedFields(ds.queries) {
+		field := ds.queries[name]
+		switch field.kind {
+		case rootGet:
+			fmt.Fprintf(&b, "  %s(id: ID!): %s\n", name, field.object.name)
+		case rootList:
+			fmt.Fprintf(&b, "  %s: [%s!]!\n", name, field.object.name)
+		}
+	}
+	b.WriteString("}\n")
+
+	if len(ds.mutations) > 0 {
+		b.WriteString("\ntype Mutation {\n")
+		for _, name := range sortedFields(ds.mutations) {
+			field := ds.mutations[name]
+			object := field.object.name
+			hasInput := len(field.object.properties) > 0
+			switch field.kind {
+			case rootCreate:
+				if hasInput {
+					fmt.Fprintf(&b, "  %s(name: String!, input: %sInput): %s!\n", name, object, object)
+				} else {
+					fmt.Fprintf(&b, "  %s(name: String!): %s!\n", name, object)
+				}
+			case rootUpdate:
+				if hasInput {
+					fmt.Fprintf(&b, "  %s(id: ID!, name: String, input: %sInput): %s!\n", name, object, object)
+				} else {
+					fmt.Fprintf(&b, "  %s(id: ID!, name: String): %s!\n", name, object)
+				}
+			case rootDelete:
+				fmt.Fprintf(&b, "  %s(id: ID!): Boolean!\n", name)
+			case rootAdd, rootRemove:
+				fmt.Fprintf(&b, "  %s(id: ID!, targetId: ID!): %s!\n", name, object)
+			}
+		}
+		b.WriteString("}\n")
+	}
+
+	for _, name := range sortedFields(ds.types) {
+		object := ds.types[name]
+		fmt.Fprintf(&b, "\ntype %s {\n  id: ID!\n  name: String!\n  originalName: String!\n", object.name)
+		for _, field := range object.fieldOrder {
+			if property, ok := object.properties[field]; ok {
+				fmt.Fprintf(&b, "  %s: %s\n", field, graphQLScalars[property.propertyType])
+			} else {
+				fmt.Fprintf(&b, "  %s: [%s!]!\n", field, object.relationships[field].target.name)
+			}
+		}
+		b.WriteString("}\n")
+
+		if len(object.properties) > 0 {
+			fmt.Fprintf(&b, "\ninput %sInput {\n", object.name)
+			for _, field := range object.fieldOrder {
+				if property, ok := object.properties[field]; ok {
+					fmt.Fprintf(&b, "  %s: %s\n", field, graphQLScalars[property.propertyType])
+				}
+			}
+			b.WriteString("}\n")
+		}
+	}
+	return b.String()
+}
+
+func sortedFields[V any](fields map[string]V) []string {
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// pascalCase turns a stored name such as web server, WEB_SERVER or webServer into WebServer
+func pascalCase(s string) string {
+	var b strings.Builder
+	for _, word := range wordPattern.FindAllString(s, -1) {
+		if strings.ToUpper(word) == word {
+			word = strings.ToLower(word)
+		}
+		b.WriteString(upperFirst(word))
+	}
+	name := b.String()
+	if name != "" && name[0] >= '0' && name[0] <= '9' {
+		name = "T" + name
+	}
+	return name
+}
+
+// camelCase turns a property key or relationship name such as email_address or RUNS_ON into emailAddress or runsOn
+func camelCase(s string) string {
+	return lowerFirst(pascalCase(s))
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// plural is a naive English plural, good enough for query names
+func plural(s string) string {
+	switch {
+	case strings.HasSuffix(s, "s"), strings.HasSuffix(s, "x"), strings.HasSuffix(s, "z"), strings.HasSuffix(s, "ch"), strings.HasSuffix(s, "sh"):
+		return s + "es"
+	case strings.HasSuffix(s, "y") && len(s) > 1 && !strings.ContainsAny(s[len(s)-2:len(s)-1], "aeiou"):
+		return s[:len(s)-1] + "ies"
+	}
+	return s + "s"
+}
@@ -0,0 +1,223 @@
+package domainschema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mike-jacks/neo/db"
+	"github.com/mike-jacks/neo/model"
+	"github.com/mike-jacks/neo/subscriptions"
+)
+
+// Apply runs the planned changes in order through database, stopping at the first failure. The
+// changes are not applied in a single transaction, it returns the changes applied before a failure.
+func (p *Plan) Apply(ctx context.Context, database db.Database, notify Notifier) ([]*model.SchemaChange, error) {
+	if notify == nil {
+		notify = func(subscriptions.EventType, interface{}) {}
+	}
+	state := &applyState{
+		database:        database,
+		notify:          notify,
+		typeIDs:         map[string]string{},
+		relationshipIDs: map[string]string{},
+	}
+	stored, err := loadStoredSchema(ctx, database, p.Domain)
+	if err != nil {
+		return nil, err
+	}
+	for key, typeSchemaNode := range stored.types {
+		state.typeIDs[key] = typeSchemaNode.ID
+	}
+	for identity, relationship := range stored.relationships {
+		state.relationshipIDs[identity] = relationship.ID
+	}
+
+	applied := []*model.SchemaChange{}
+	for _, step := range p.steps {
+		if err := step.apply(ctx, state); err != nil {
+			return applied, fmt.Errorf("%s %s %s failed: %w", step.change.Action, step.change.Kind, step.change.Target, err)
+		}
+		applied = append(applied, step.change)
+	}
+	return applied, nil
+}
+
+// ApplyDocument parses document, plans it against the stored schema and, unless dryRun is set, applies
+// the plan. Invalid documents and failed changes are reported as an unsuccessful response.
+func ApplyDocument(ctx context.Context, database db.Database, document []byte, dryRun bool, notify Notifier) (*model.SchemaPlanResponse, error) {
+	parsed, err := Parse(document)
+	if err != nil {
+		message := err.Error()
+		return &model.SchemaPlanResponse{Success: false, Message: &message, DryRun: dryRun, Changes: nil}, nil
+	}
+
+	plan, err := NewPlan(ctx, database, parsed)
+	if err != nil {
+		return nil, err
+	}
+	changes := plan.Changes()
+	if len(changes) == 0 {
+		message := fmt.Sprintf("Domain schema %s is up to date", plan.Domain)
+		return &model.SchemaPlanResponse{Success: true, Message: &message, DryRun: dryRun, Changes: changes}, nil
+	}
+	if dryRun {
+		message := fmt.Sprintf("%d changes planned for domain schema %s", len(changes), plan.Domain)
+		return &model.SchemaPlanResponse{Success: true, Message: &message, DryRun: dryRun, Changes: changes}, nil
+	}
+
+	applied, err := plan.Apply(ctx, database, notify)
+	if err != nil {
+		message := fmt.Sprintf("%d of %d changes applied to domain schema %s: %s", len(applied), len(changes), plan.Domain, err.Error())
+		return &model.SchemaPlanResponse{Success: false, Message: &message, DryRun: dryRun, Changes: applied}, nil
+	}
+	message := fmt.Sprintf("%d changes applied to domain schema %s", len(applied), plan.Domain)
+	return &model.SchemaPlanResponse{Success: true, Message: &message, DryRun: dryRun, Changes: applied}, nil
+}
+
+func createDomain(domain string) func(ctx context.Context, state *applyState) error {
+	return func(ctx context.Context, state *applyState) error {
+		response, err := state.database.CreateDomainSchemaNode(ctx, domain)
+		if err != nil {
+			return err
+		}
+		if !response.Success {
+			return failure(response.Message)
+		}
+		state.notify(subscriptions.DomainSchemaNodeCreated, response)
+		return nil
+	}
+}
+
+func createType(domain string, name string) func(ctx context.Context, state *applyState) error {
+	return func(ctx context.Context, state *applyState) error {
+		response, err := state.database.CreateTypeSchemaNode(ctx, domain, name)
+		if err != nil {
+			return err
+		}
+		if !response.Success {
+			return failure(response.Message)
+		}
+		state.typeIDs[response.TypeSchemaNode.Name] = response.TypeSchemaNode.ID
+		state.notify(subscriptions.TypeSchemaNodeCreated, response)
+		return nil
+	}
+}
+
+func updateTypeProperty(key string) func(property *Property) func(ctx context.Context, state *applyState) error {
+	return func(property *Property) func(ctx context.Context, state *applyState) error {
+		return func(ctx context.Context, state *applyState) error {
+			response, err := state.database.UpdatePropertiesOnTypeSchemaNode(ctx, state.typeIDs[key], []*model.PropertyInput{property.input()})
+			if err != nil {
+				return err
+			}
+			if !response.Success {
+				return failure(response.Message)
+			}
+			state.notify(subscriptions.TypeSchemaNodeUpdated, response)
+			return nil
+		}
+	}
+}
+
+func removeTypeProperty(key string) func(propertyKey string) func(ctx context.Context, state *applyState) error {
+	return func(propertyKey string) func(ctx context.Context, state *applyState) error {
+		return func(ctx context.Context, state *applyState) error {
+			response, err := state.database.RemovePropertiesFromTypeSchemaNode(ctx, state.typeIDs[key], []string{propertyKey}, false)
+			if err != nil {
+				return err
+			}
+			if !response.Success {
+				return failure(response.Message)
+			}
+			state.notify(subscriptions.TypeSchemaNodeUpdated, response)
+			return nil
+		}
+	}
+}
+
+func deleteType(id string) func(ctx context.Context, state *applyState) error {
+	return func(ctx context.Context, state *applyState) error {
+		response, err := state.database.DeleteTypeSchemaNode(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !response.Success {
+			return failure(response.Message)
+		}
+		state.notify(subscriptions.TypeSchemaNodeDeleted, response)
+		return nil
+	}
+}
+
+func createRelationship(domain string, name string, identity string, fromKey string, toKey string) func(ctx context.Context, state *applyState) error {
+	return func(ctx context.Context, state *applyState) error {
+		response, err := state.database.CreateRelationshipSchemaNode(ctx, name, domain, state.typeIDs[fromKey], state.typeIDs[toKey])
+		if err != nil {
+			return err
+		}
+		if !response.Success {
+			return failure(response.Message)
+		}
+		state.relationshipIDs[identity] = response.RelationshipSchemaNode.ID
+		state.notify(subscriptions.RelationshipSchemaNodeCreated, response)
+		return nil
+	}
+}
+
+func updateRelationshipProperty(identity string) func(property *Property) func(ctx context.Context, state *applyState) error {
+	return func(property *Property) func(ctx context.Context, state *applyState) error {
+		return func(ctx context.Context, state *applyState) error {
+			response, err := state.database.UpdatePropertiesOnRelationshipSchemaNode(ctx, state.relationshipIDs[identity], []*model.PropertyInput{property.input()})
+			if err != nil {
+				return err
+			}
+			if !response.Success {
+				return failure(response.Message)
+			}
+			state.notify(subscriptions.RelationshipSchemaNodeUpdated, response)
+			return nil
+		}
+	}
+}
+
+func removeRelationshipProperty(identity string) func(propertyKey string) func(ctx context.Context, state *applyState) error {
+	return func(propertyKey string) func(ctx context.Context, state *applyState) error {
+		return func(ctx context.Context, state *applyState) error {
+			response, err := state.database.RemovePropertiesFromRelationshipSchemaNode(ctx, state.relationshipIDs[identity], []string{propertyKey}, false)
+			if err != nil {
+				return err
+			}
+			if !response.Success {
+				return failure(response.Message)
+			}
+			state.notify(subscriptions.RelationshipSchemaNodeUpdated, response)
+			return nil
+		}
+	}
+}
+
+func deleteRelationship(id string) func(ctx context.Context, state *applyState) error {
+	return func(ctx context.Context, state *applyState) error {
+		response, err := state.database.DeleteRelationshipSchemaNode(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !response.Success {
+			return failure(response.Message)
+		}
+		state.notify(subscriptions.RelationshipSchemaNodeDeleted, response)
+		return nil
+	}
+}
+
+func (p *Property) input() *model.PropertyInput {
+	return &model.PropertyInput{Key: p.Key, Type: p.Type, Value: p.Value}
+}
+
+// failure is the error of an unsuccessful response
+func failure(message *string) error {
+	if message == nil {
+		return fmt.Errorf("unsuccessful response")
+	}
+	return fmt.Errorf("%s", *message)
+}
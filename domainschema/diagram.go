@@ -0,0 +1,188 @@
+package domainschema
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/mike-jacks/neo/db"
+	"github.com/mike-jacks/neo/model"
+)
+
+// Diagram renders the stored schema of domain, its types with their properties and its relationships,
+// in format. With includeCounts every type and relationship is labelled with its number of objects.
+func Diagram(ctx context.Context, database db.Database, domain string, format model.SchemaDiagramFormat, includeCounts bool) (*model.SchemaDiagramResponse, error) {
+	domain = strings.TrimSpace(domain)
+	stored, err := loadStoredSchema(ctx, database, domain)
+	if err != nil {
+		return nil, err
+	}
+	if !stored.domainExists {
+		message := fmt.Sprintf("Domain schema %s not found", domain)
+		return &model.SchemaDiagramResponse{Success: false, Message: &message, Format: format, Diagram: nil}, nil
+	}
+
+	diagram := newDiagram(stored, includeCounts)
+	var rendered string
+	switch format {
+	case model.SchemaDiagramFormatMermaidEr:
+		rendered = diagram.mermaidER()
+	case model.SchemaDiagramFormatMermaidClass:
+		rendered = diagram.mermaidClass()
+	case model.SchemaDiagramFormatDot:
+		rendered = diagram.dot(domain)
+	case model.SchemaDiagramFormatPlantuml:
+		rendered = diagram.plantUML(domain)
+	default:
+		message := fmt.Sprintf("Unsupported diagram format %s", format)
+		return &model.SchemaDiagramResponse{Success: false, Message: &message, Format: format, Diagram: nil}, nil
+	}
+
+	message := fmt.Sprintf("Schema diagram of domain %s with %d types and %d relationships", domain, len(diagram.types), len(diagram.relationships))
+	return &model.SchemaDiagramResponse{Success: true, Message: &message, Format: format, Diagram: &rendered}, nil
+}
+
+type diagram struct {
+	types         []*diagramType
+	relationships []*diagramRelationship
+	includeCounts bool
+}
+
+type diagramType struct {
+	id         string
+	label      string
+	properties []*model.Property
+	count      int64
+}
+
+type diagramRelationship struct {
+	from  string
+	to    string
+	name  string
+	count int64
+}
+
+var identifierPattern = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+
+// diagramID turns a type name into an identifier every format accepts
+func diagramID(name string) string {
+	return identifierPattern.ReplaceAllString(name, "_")
+}
+
+func newDiagram(stored *storedSchema, includeCounts bool) *diagram {
+	d := &diagram{includeCounts: includeCounts}
+	for _, key := range sortedKeys(stored.types) {
+		typeSchemaNode := stored.types[key]
+		label := typeSchemaNode.OriginalName
+		if label == "" {
+			label = typeSchemaNode.Name
+		}
+		properties := append([]*model.Property{}, typeSchemaNode.Properties...)
+		sort.Slice(properties, func(i, j int) bool { return properties[i].Key < properties[j].Key })
+		d.types = append(d.types, &diagramType{
+			id:         diagramID(key),
+			label:      label,
+			properties: properties,
+			count:      stored.counts.Type(key).Total,
+		})
+	}
+	for _, identity := range sortedKeys(stored.relationships) {
+		relationship := stored.relationships[identity]
+		d.relationships = append(d.relationships, &diagramRelationship{
+			from:  diagramID(typeName(stored, relationship.FromTypeSchemaNodeID)),
+			to:    diagramID(typeName(stored, relationship.ToTypeSchemaNodeID)),
+			name:  relationship.Name,
+			count: stored.counts.Relationship(relationship.ID).Total,
+		})
+	}
+	return d
+}
+
+func (d *diagram) typeLabel(t *diagramType) string {
+	if d.includeCounts {
+		return fmt.Sprintf("%s (%d)", t.label, t.count)
+	}
+	return t.label
+}
+
+func (d *diagram) relationshipLabel(r *diagramRelationship) string {
+	if d.includeCounts {
+		return fmt.Sprintf("%s (%d)", r.name, r.count)
+	}
+	return r.name
+}
+
+func (d *diagram) mermaidER() string {
+	var b strings.Builder
+	b.WriteString("erDiagram\n")
+	for _, t := range d.types {
+		fmt.Fprintf(&b, "    %s[%q] {\n", t.id, d.typeLabel(t))
+		for _, property := range t.properties {
+			fmt.Fprintf(&b, "        %s %s\n", strings.ToLower(string(property.Type)), property.Key)
+		}
+		b.WriteString("    }\n")
+	}
+	for _, r := range d.relationships {
+		fmt.Fprintf(&b, "    %s }o--o{ %s : %q\n", r.from, r.to, d.relationshipLabel(r))
+	}
+	return b.String()
+}
+
+func (d *diagram) mermaidClass() string {
+	var b strings.Builder
+	b.WriteString("classDiagram\n")
+	for _, t := range d.types {
+		fmt.Fprintf(&b, "    class %s[%q] {\n", t.id, d.typeLabel(t))
+		for _, property := range t.properties {
+			fmt.Fprintf(&b, "        +%s %s\n", property.Type, property.Key)
+		}
+		b.WriteString("    }\n")
+	}
+	for _, r := range d.relationships {
+		fmt.Fprintf(&b, "    %s --> %s : %s\n", r.from, r.to, d.relationshipLabel(r))
+	}
+	return b.String()
+}
+
+func (d *diagram) dot(domain string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %q {\n", domain)
+	b.WriteString("    rankdir=LR;\n    node [shape=record];\n")
+	for _, t := range d.types {
+		fields := ""
+		for _, property := range t.properties {
+			fields += escapeRecord(fmt.Sprintf("%s: %s", property.Key, property.Type)) + `\l`
+		}
+		fmt.Fprintf(&b, "    %q [label=\"{%s|%s}\"];\n", t.id, escapeRecord(d.typeLabel(t)), fields)
+	}
+	for _, r := range d.relationships {
+		fmt.Fprintf(&b, "    %q -> %q [label=%q];\n", r.from, r.to, d.relationshipLabel(r))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// escapeRecord escapes the characters with a meaning in a DOT record label
+func escapeRecord(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "{", `\{`, "}", `\}`, "|", `\|`, "<", `\<`, ">", `\>`).Replace(s)
+}
+
+func (d *diagram) plantUML(domain string) string {
+	var b strings.Builder
+	b.WriteString("@startuml\n")
+	fmt.Fprintf(&b, "title %s\n", domain)
+	for _, t := range d.types {
+		fmt.Fprintf(&b, "class %q as %s {\n", d.typeLabel(t), t.id)
+		for _, property := range t.properties {
+			fmt.Fprintf(&b, "  %s : %s\n", property.Key, property.Type)
+		}
+		b.WriteString("}\n")
+	}
+	for _, r := range d.relationships {
+		fmt.Fprintf(&b, "%s --> %s : %s\n", r.from, r.to, d.relationshipLabel(r))
+	}
+	b.WriteString("@enduml\n")
+	return b.String()
+}
@@ -0,0 +1,108 @@
+package domainschema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/mike-jacks/neo/db"
+	"github.com/mike-jacks/neo/model"
+)
+
+// Diff compares the stored schema of domain with either a document or the stored schema of another
+// domain, listing the changes that would make domain match it. A document is compared the way
+// applyDomainSchema would apply it, so removals are only listed when it sets prune. Another domain is
+// compared in full.
+func Diff(ctx context.Context, database db.Database, domain string, against model.SchemaDiffAgainst) (*model.SchemaDiffResponse, error) {
+	domain = strings.TrimSpace(domain)
+	if (against.Document == nil) == (against.Domain == nil) {
+		message := "Exactly one of document or domain is required to compare against"
+		return &model.SchemaDiffResponse{Success: false, Message: &message, Changes: nil}, nil
+	}
+
+	var document *Document
+	if against.Document != nil {
+		parsed, err := Parse([]byte(*against.Document))
+		if err != nil {
+			message := err.Error()
+			return &model.SchemaDiffResponse{Success: false, Message: &message, Changes: nil}, nil
+		}
+		document = parsed
+	} else {
+		snapshot, err := Snapshot(ctx, database, *against.Domain)
+		if err != nil {
+			return nil, err
+		}
+		if snapshot == nil {
+			message := fmt.Sprintf("Domain schema %s not found", strings.TrimSpace(*against.Domain))
+			return &model.SchemaDiffResponse{Success: false, Message: &message, Changes: nil}, nil
+		}
+		document = snapshot
+	}
+	document.Domain = domain
+
+	plan, err := NewPlan(ctx, database, document)
+	if err != nil {
+		return nil, err
+	}
+	changes := plan.Changes()
+	if len(changes) == 0 {
+		message := "No changes, the schemas match"
+		return &model.SchemaDiffResponse{Success: true, Message: &message, Changes: changes}, nil
+	}
+
+	compatibilities := map[model.SchemaChangeCompatibility]int{}
+	for _, change := range changes {
+		compatibilities[change.Compatibility]++
+	}
+	message := fmt.Sprintf("%d changes: %d additive, %d breaking, %d data migrating", len(changes),
+		compatibilities[model.SchemaChangeCompatibilityAdditive],
+		compatibilities[model.SchemaChangeCompatibilityBreaking],
+		compatibilities[model.SchemaChangeCompatibilityDataMigrating])
+	return &model.SchemaDiffResponse{Success: true, Message: &message, Changes: changes}, nil
+}
+
+// Snapshot returns the stored schema of domain as a document with prune set, or nil when the domain
+// does not exist. Relationships to types of other domains are left out, applying the document with
+// prune keeps them.
+func Snapshot(ctx context.Context, database db.Database, domain string) (*Document, error) {
+	domain = strings.TrimSpace(domain)
+	stored, err := loadStoredSchema(ctx, database, domain)
+	if err != nil {
+		return nil, err
+	}
+	if !stored.domainExists {
+		return nil, nil
+	}
+
+	document := &Document{Domain: domain, Prune: true}
+	for _, key := range sortedKeys(stored.types) {
+		document.Types = append(document.Types, &Type{Name: key, Properties: snapshotProperties(stored.types[key].Properties)})
+	}
+	for _, identity := range sortedKeys(stored.relationships) {
+		relationship := stored.relationships[identity]
+		// Documents only describe relationships between types of their own domain
+		if stored.crossesDomains(relationship) {
+			continue
+		}
+		document.Relationships = append(document.Relationships, &Relationship{
+			Name:       relationship.Name,
+			From:       typeName(stored, relationship.FromTypeSchemaNodeID),
+			To:         typeName(stored, relationship.ToTypeSchemaNodeID),
+			Properties: snapshotProperties(relationship.Properties),
+		})
+	}
+	return document, nil
+}
+
+func snapshotProperties(properties []*model.Property) []*Property {
+	result := make([]*Property, 0, len(properties))
+	for _, property := range properties {
+		result = append(result, &Property{Key: property.Key, Type: property.Type, Value: property.Value})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
@@ -0,0 +1,245 @@
+// Package domainschema describes a domain's types, properties and relationships as a YAML or JSON
+// document and applies it to the stored schema nodes, changing only what differs. For example
+//
+//	domain: Shop
+//	types:
+//	  - name: Customer
+//	    properties:
+//	      - key: email
+//	        type: STRING
+//	  - name: Order
+//	    properties:
+//	      - key: total
+//	        type: NUMBER
+//	        value: 0
+//	relationships:
+//	  - name: PLACED
+//	    from: Customer
+//	    to: Order
+//
+// A property value is the value stored on the schema node, it defaults to the zero value of its type.
+// Types, relationships and properties missing from the document are left alone unless the document
+// sets prune: true. Deleting a type schema node deletes its object nodes too, so prune is opt in.
+package domainschema
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/mike-jacks/neo/model"
+	"github.com/mike-jacks/neo/utils"
+	"gopkg.in/yaml.v3"
+)
+
+type Document struct {
+	Domain        string          `yaml:"domain"`
+	Prune         bool            `yaml:"prune"`
+	Types         []*Type         `yaml:"types"`
+	Relationships []*Relationship `yaml:"relationships"`
+}
+
+type Type struct {
+	Name       string      `yaml:"name"`
+	Properties []*Property `yaml:"properties"`
+}
+
+type Relationship struct {
+	Name       string      `yaml:"name"`
+	From       string      `yaml:"from"`
+	To         string      `yaml:"to"`
+	Properties []*Property `yaml:"properties"`
+}
+
+type Property struct {
+	Key   string             `yaml:"key"`
+	Type  model.PropertyType `yaml:"type"`
+	Value any                `yaml:"value"`
+}
+
+// Parse reads and validates a YAML or JSON document. Names and property keys are normalized the same
+// way the schema node mutations normalize them, so they can be compared with the stored schema.
+func Parse(data []byte) (*Document, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	document := &Document{}
+	if err := decoder.Decode(document); err != nil {
+		return nil, fmt.Errorf("invalid domain schema document: %w", err)
+	}
+	if err := document.validate(); err != nil {
+		return nil, err
+	}
+	return document, nil
+}
+
+func (d *Document) validate() error {
+	errs := []error{}
+
+	d.Domain = strings.TrimSpace(d.Domain)
+	if d.Domain == "" {
+		errs = append(errs, errors.New("domain is required"))
+	}
+
+	types := map[string]bool{}
+	for i, t := range d.Types {
+		t.Name = strings.TrimSpace(t.Name)
+		if t.Name == "" {
+			errs = append(errs, fmt.Errorf("types[%d]: name is required", i))
+			continue
+		}
+		if types[typeKey(t.Name)] {
+			errs = append(errs, fmt.Errorf("type %s is declared more than once", t.Name))
+		}
+		types[typeKey(t.Name)] = true
+		errs = append(errs, validateProperties("type "+t.Name, t.Properties)...)
+	}
+
+	relationships := map[string]bool{}
+	for i, relationship := range d.Relationships {
+		relationship.Name = strings.TrimSpace(relationship.Name)
+		relationship.From = strings.TrimSpace(relationship.From)
+		relationship.To = strings.TrimSpace(relationship.To)
+		if relationship.Name == "" {
+			errs = append(errs, fmt.Errorf("relationships[%d]: name is required", i))
+			continue
+		}
+		target := relationshipTarget(relationship.From, relationshipKey(relationship.Name), relationship.To)
+		if !types[typeKey(relationship.From)] {
+			errs = append(errs, fmt.Errorf("relationship %s: from type %q is not declared", target, relationship.From))
+		}
+		if !types[typeKey(relationship.To)] {
+			errs = append(errs, fmt.Errorf("relationship %s: to type %q is not declared", target, relationship.To))
+		}
+		key := relationshipIdentity(relationshipKey(relationship.Name), typeKey(relationship.From), typeKey(relationship.To))
+		if relationships[key] {
+			errs = append(errs, fmt.Errorf("relationship %s is declared more than once", target))
+		}
+		relationships[key] = true
+		errs = append(errs, validateProperties("relationship "+target, relationship.Properties)...)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid domain schema document:\n%w", errors.Join(errs...))
+	}
+	return nil
+}
+
+func validateProperties(owner string, properties []*Property) []error {
+	errs := []error{}
+	keys := map[string]bool{}
+	for i, property := range properties {
+		property.Key = utils.RemoveSpacesAndLowerCase(property.Key)
+		if property.Key == "" {
+			errs = append(errs, fmt.Errorf("%s: properties[%d]: key is required", owner, i))
+			continue
+		}
+		if strings.HasPrefix(property.Key, "_") || utils.SpecialProps[property.Key] {
+			errs = append(errs, fmt.Errorf("%s: property key %s is reserved", owner, property.Key))
+			continue
+		}
+		if keys[property.Key] {
+			errs = append(errs, fmt.Errorf("%s: property %s is declared more than once", owner, property.Key))
+		}
+		keys[property.Key] = true
+		if err := property.normalizeValue(); err != nil {
+			errs = append(errs, fmt.Errorf("%s: property %s: %w", owner, property.Key, err))
+		}
+	}
+	return errs
+}
+
+// normalizeValue fills in the zero value of the property type and checks the value has that type
+func (p *Property) normalizeValue() error {
+	if !p.Type.IsValid() {
+		return fmt.Errorf("type %q is not a valid property type", p.Type)
+	}
+
+	switch p.Type {
+	case model.PropertyTypeString, model.PropertyTypeNumber, model.PropertyTypeInteger, model.PropertyTypeFloat, model.PropertyTypeBoolean,
+		model.PropertyTypeArrayString, model.PropertyTypeArrayNumber, model.PropertyTypeArrayInteger, model.PropertyTypeArrayFloat, model.PropertyTypeArrayBoolean:
+		if p.Value == nil {
+			p.Value = zeroValues[p.Type]
+		}
+		// NUMBER resolves to the type the value is read back with
+		propertyType, value, err := utils.NormalizePropertyValue(p.Type, p.Value)
+		if err != nil {
+			return err
+		}
+		p.Type, p.Value = propertyType, value
+	case model.PropertyTypeDate, model.PropertyTypeDatetime, model.PropertyTypeLocalDatetime, model.PropertyTypeDuration:
+		// YAML reads unquoted timestamps as times
+		if t, ok := p.Value.(time.Time); ok {
+			p.Value = t.Format(time.RFC3339Nano)
+			if p.Type == model.PropertyTypeDate {
+				p.Value = t.Format("2006-01-02")
+			}
+		}
+		value, err := utils.NormalizeTemporalValue(p.Type, p.Value)
+		if err != nil {
+			return err
+		}
+		p.Value = value
+	case model.PropertyTypeRelationship:
+		// The type referenced object nodes must have, empty for any type
+		if p.Value == nil {
+			p.Value = ""
+		}
+		value, err := utils.NormalizeReferenceValue(p.Value)
+		if err != nil {
+			return err
+		}
+		p.Value = value
+	case model.PropertyTypeJSON:
+		normalized, err := utils.NormalizeJSONValue(p.Value)
+		if err != nil {
+			return err
+		}
+		// Compare with stored values in the form they are read back
+		if p.Value, err = utils.DecodeJSONValue(normalized); err != nil {
+			return err
+		}
+	case model.PropertyTypePoint:
+		point, err := utils.NormalizePointValue(p.Value)
+		if err != nil {
+			return err
+		}
+		p.Value = point
+	default:
+		return fmt.Errorf("type %s is not supported in domain schema documents", p.Type)
+	}
+	return nil
+}
+
+var zeroValues = map[model.PropertyType]any{
+	model.PropertyTypeString:       "",
+	model.PropertyTypeNumber:       0,
+	model.PropertyTypeInteger:      0,
+	model.PropertyTypeFloat:        0.0,
+	model.PropertyTypeBoolean:      false,
+	model.PropertyTypeArrayString:  []any{},
+	model.PropertyTypeArrayNumber:  []any{},
+	model.PropertyTypeArrayInteger: []any{},
+	model.PropertyTypeArrayFloat:   []any{},
+	model.PropertyTypeArrayBoolean: []any{},
+}
+
+// typeKey is the stored name of a type schema node, as set by CreateTypeSchemaNode
+func typeKey(name string) string {
+	return utils.RemoveSpacesAndUpperCase(name)
+}
+
+// relationshipKey is the stored name of a relationship schema node, as set by CreateRelationshipSchemaNode
+func relationshipKey(name string) string {
+	return utils.RemoveSpacesAndHyphens(strings.ToUpper(name))
+}
+
+func relationshipIdentity(name string, fromType string, toType string) string {
+	return name + "|" + fromType + "|" + toType
+}
+
+func relationshipTarget(fromType string, name string, toType string) string {
+	return fmt.Sprintf("%s -[%s]-> %s", fromType, name, toType)
+}
@@ -0,0 +1,287 @@
+package domainschema
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/mike-jacks/neo/db"
+	"github.com/mike-jacks/neo/model"
+	"github.com/mike-jacks/neo/subscriptions"
+	"github.com/mike-jacks/neo/utils"
+)
+
+// Notifier publishes the response of every applied change, resolver.Resolver passes its
+// subscription manager's Publish
+type Notifier func(eventType subscriptions.EventType, data interface{})
+
+// Plan is the ordered list of changes turning the stored schema of a domain into a document. Every
+// change is classified as
+//
+//   - ADDITIVE: creating a domain, type, relationship or property, or changing a property value
+//   - DATA_MIGRATING: changing the type of a property, existing values need converting
+//   - BREAKING: deleting a type, relationship or property
+//
+// together with the number of existing object nodes and object relationships it affects.
+type Plan struct {
+	Domain string
+	steps  []*step
+}
+
+type step struct {
+	change *model.SchemaChange
+	apply  func(ctx context.Context, state *applyState) error
+}
+
+// applyState carries the ids of type and relationship schema nodes, including those created by
+// earlier steps, keyed by typeKey and relationshipIdentity
+type applyState struct {
+	database        db.Database
+	notify          Notifier
+	typeIDs         map[string]string
+	relationshipIDs map[string]string
+}
+
+// storedSchema is the schema of a domain as currently stored
+type storedSchema struct {
+	domainExists  bool
+	types         map[string]*model.TypeSchemaNode
+	relationships map[string]*model.RelationshipSchemaNode
+	counts        *db.DomainObjectCounts
+}
+
+// NewPlan compares document with the stored schema of its domain
+func NewPlan(ctx context.Context, database db.Database, document *Document) (*Plan, error) {
+	stored, err := loadStoredSchema(ctx, database, document.Domain)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{Domain: document.Domain}
+	if !stored.domainExists {
+		plan.add(newChange(model.SchemaChangeActionCreate, model.SchemaChangeKindDomain, document.Domain, "create domain schema node"), createDomain(document.Domain))
+	}
+
+	for _, t := range document.Types {
+		key := typeKey(t.Name)
+		storedType := stored.types[key]
+		if storedType == nil {
+			plan.add(newChange(model.SchemaChangeActionCreate, model.SchemaChangeKindType, key, "create type schema node "+t.Name), createType(document.Domain, t.Name))
+		}
+		var storedProperties []*model.Property
+		if storedType != nil {
+			storedProperties = storedType.Properties
+		}
+		plan.addPropertyChanges(model.SchemaChangeKindTypeProperty, key, t.Properties, storedProperties, stored.counts.Type(key), document.Prune, updateTypeProperty(key), removeTypeProperty(key))
+	}
+
+	for _, relationship := range document.Relationships {
+		name, from, to := relationshipKey(relationship.Name), typeKey(relationship.From), typeKey(relationship.To)
+		identity := relationshipIdentity(name, from, to)
+		target := relationshipTarget(from, name, to)
+		storedRelationship := stored.relationships[identity]
+		if storedRelationship == nil {
+			plan.add(newChange(model.SchemaChangeActionCreate, model.SchemaChangeKindRelationship, target, "create relationship schema node "+relationship.Name), createRelationship(document.Domain, relationship.Name, identity, from, to))
+		}
+		var storedProperties []*model.Property
+		counts := stored.counts.Relationship("")
+		if storedRelationship != nil {
+			storedProperties = storedRelationship.Properties
+			counts = stored.counts.Relationship(storedRelationship.ID)
+		}
+		plan.addPropertyChanges(model.SchemaChangeKindRelationshipProperty, target, relationship.Properties, storedProperties, counts, document.Prune, updateRelationshipProperty(identity), removeRelationshipProperty(identity))
+	}
+
+	if document.Prune {
+		declaredTypes := map[string]bool{}
+		for _, t := range document.Types {
+			declaredTypes[typeKey(t.Name)] = true
+		}
+		declaredRelationships := map[string]bool{}
+		for _, relationship := range document.Relationships {
+			declaredRelationships[relationshipIdentity(relationshipKey(relationship.Name), typeKey(relationship.From), typeKey(relationship.To))] = true
+		}
+
+		// Relationships go first, a pruned type is never the endpoint of a declared relationship
+		for _, identity := range sortedKeys(stored.relationships) {
+			relationship := stored.relationships[identity]
+			// A document only declares relationships between its own types, so relationships to types
+			// of other domains are never pruned
+			if declaredRelationships[identity] || stored.crossesDomains(relationship) {
+				continue
+			}
+			target := relationshipTarget(typeName(stored, relationship.FromTypeSchemaNodeID), relationship.Name, typeName(stored, relationship.ToTypeSchemaNodeID))
+			plan.add(&model.SchemaChange{
+				Action:                      model.SchemaChangeActionDelete,
+				Kind:                        model.SchemaChangeKindRelationship,
+				Target:                      target,
+				Description:                 "delete relationship schema node and its object relationships",
+				Compatibility:               model.SchemaChangeCompatibilityBreaking,
+				AffectedObjectRelationships: int(stored.counts.Relationship(relationship.ID).Total),
+			}, deleteRelationship(relationship.ID))
+		}
+		for _, key := range sortedKeys(stored.types) {
+			if declaredTypes[key] {
+				continue
+			}
+			// Deleting the object nodes detaches them from every relationship described in the domain
+			affectedRelationships := int64(0)
+			for _, relationship := range stored.relationships {
+				if relationship.FromTypeSchemaNodeID == stored.types[key].ID || relationship.ToTypeSchemaNodeID == stored.types[key].ID {
+					affectedRelationships += stored.counts.Relationship(relationship.ID).Total
+				}
+			}
+			plan.add(&model.SchemaChange{
+				Action:                      model.SchemaChangeActionDelete,
+				Kind:                        model.SchemaChangeKindType,
+				Target:                      key,
+				Description:                 "delete type schema node and its object nodes",
+				Compatibility:               model.SchemaChangeCompatibilityBreaking,
+				AffectedObjectNodes:         int(stored.counts.Type(key).Total),
+				AffectedObjectRelationships: int(affectedRelationships),
+			}, deleteType(stored.types[key].ID))
+		}
+	}
+
+	return plan, nil
+}
+
+// Changes lists the planned changes in the order they are applied
+func (p *Plan) Changes() []*model.SchemaChange {
+	changes := make([]*model.SchemaChange, 0, len(p.steps))
+	for _, step := range p.steps {
+		changes = append(changes, step.change)
+	}
+	return changes
+}
+
+func (p *Plan) add(change *model.SchemaChange, apply func(ctx context.Context, state *applyState) error) {
+	p.steps = append(p.steps, &step{change: change, apply: apply})
+}
+
+// newChange returns an additive change, affecting reclassifies it
+func newChange(action model.SchemaChangeAction, kind model.SchemaChangeKind, target string, description string) *model.SchemaChange {
+	return &model.SchemaChange{Action: action, Kind: kind, Target: target, Description: description, Compatibility: model.SchemaChangeCompatibilityAdditive}
+}
+
+// affecting sets the number of objects having a property on change, as object nodes or object
+// relationships depending on its kind
+func affecting(change *model.SchemaChange, compatibility model.SchemaChangeCompatibility, count int64) *model.SchemaChange {
+	change.Compatibility = compatibility
+	if change.Kind == model.SchemaChangeKindRelationshipProperty {
+		change.AffectedObjectRelationships = int(count)
+	} else {
+		change.AffectedObjectNodes = int(count)
+	}
+	return change
+}
+
+func (p *Plan) addPropertyChanges(kind model.SchemaChangeKind, owner string, properties []*Property, stored []*model.Property, counts *db.ObjectCounts, prune bool, update func(property *Property) func(ctx context.Context, state *applyState) error, remove func(key string) func(ctx context.Context, state *applyState) error) {
+	storedByKey := map[string]*model.Property{}
+	for _, property := range stored {
+		storedByKey[property.Key] = property
+	}
+
+	declared := map[string]bool{}
+	for _, property := range properties {
+		declared[property.Key] = true
+		target := owner + "." + property.Key
+		storedProperty := storedByKey[property.Key]
+		switch {
+		case storedProperty == nil:
+			p.add(newChange(model.SchemaChangeActionCreate, kind, target, fmt.Sprintf("add property %s", describeProperty(property.Type, property.Value))), update(property))
+		case storedProperty.Type != property.Type:
+			change := newChange(model.SchemaChangeActionUpdate, kind, target, fmt.Sprintf("change property from %s to %s", describeProperty(storedProperty.Type, storedProperty.Value), describeProperty(property.Type, property.Value)))
+			p.add(affecting(change, model.SchemaChangeCompatibilityDataMigrating, counts.Properties[property.Key]), update(property))
+		case fmt.Sprint(storedProperty.Value) != fmt.Sprint(property.Value):
+			p.add(newChange(model.SchemaChangeActionUpdate, kind, target, fmt.Sprintf("change property from %s to %s", describeProperty(storedProperty.Type, storedProperty.Value), describeProperty(property.Type, property.Value))), update(property))
+		}
+	}
+
+	if !prune {
+		return
+	}
+	keys := make([]string, 0, len(storedByKey))
+	for key := range storedByKey {
+		if !declared[key] {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		change := newChange(model.SchemaChangeActionDelete, kind, owner+"."+key, "remove property from the schema node, object values are kept")
+		p.add(affecting(change, model.SchemaChangeCompatibilityBreaking, counts.Properties[key]), remove(key))
+	}
+}
+
+func describeProperty(propertyType model.PropertyType, value any) string {
+	if propertyType == model.PropertyTypeString || utils.IsTemporalPropertyType(propertyType) {
+		return fmt.Sprintf("%s %q", propertyType, value)
+	}
+	return fmt.Sprintf("%s %v", propertyType, value)
+}
+
+func loadStoredSchema(ctx context.Context, database db.Database, domain string) (*storedSchema, error) {
+	stored := &storedSchema{
+		types:         map[string]*model.TypeSchemaNode{},
+		relationships: map[string]*model.RelationshipSchemaNode{},
+	}
+
+	domains, err := database.GetDomainSchemaNodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, domainSchemaNode := range domains.DomainSchemaNodes {
+		if domainSchemaNode.Domain == domain {
+			stored.domainExists = true
+		}
+	}
+
+	types, err := database.GetTypeSchemaNodes(ctx, &domain)
+	if err != nil {
+		return nil, err
+	}
+	for _, typeSchemaNode := range types.TypeSchemaNodes {
+		stored.types[typeSchemaNode.Name] = typeSchemaNode
+	}
+
+	relationships, err := database.GetRelationshipSchemaNodes(ctx, &domain)
+	if err != nil {
+		return nil, err
+	}
+	for _, relationship := range relationships.RelationshipSchemaNodes {
+		identity := relationshipIdentity(relationship.Name, typeName(stored, relationship.FromTypeSchemaNodeID), typeName(stored, relationship.ToTypeSchemaNodeID))
+		stored.relationships[identity] = relationship
+	}
+
+	if stored.counts, err = database.GetDomainObjectCounts(ctx, domain); err != nil {
+		return nil, err
+	}
+	return stored, nil
+}
+
+// typeName returns the name of a stored type schema node of the domain, or its id for a type of
+// another domain
+func typeName(stored *storedSchema, id string) string {
+	for name, typeSchemaNode := range stored.types {
+		if typeSchemaNode.ID == id {
+			return name
+		}
+	}
+	return id
+}
+
+// crossesDomains tells whether one of the endpoints of relationship is a type of another domain
+func (s *storedSchema) crossesDomains(relationship *model.RelationshipSchemaNode) bool {
+	return typeName(s, relationship.FromTypeSchemaNodeID) == relationship.FromTypeSchemaNodeID ||
+		typeName(s, relationship.ToTypeSchemaNodeID) == relationship.ToTypeSchemaNodeID
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,81 @@
+package domainschema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/mike-jacks/neo/db"
+	"github.com/mike-jacks/neo/model"
+)
+
+// fakeDatabase serves the stored schema of the Shop domain, whose PLACED relationship is between its
+// own types and whose BILLED_BY relationship ends at a type of the Billing domain
+type fakeDatabase struct {
+	db.Database
+}
+
+func (fakeDatabase) GetDomainSchemaNodes(ctx context.Context) (*model.DomainSchemaNodesResponse, error) {
+	return &model.DomainSchemaNodesResponse{Success: true, DomainSchemaNodes: []*model.DomainSchemaNode{{ID: "shop", Domain: "Shop"}, {ID: "billing", Domain: "Billing"}}}, nil
+}
+
+func (fakeDatabase) GetTypeSchemaNodes(ctx context.Context, domain *string) (*model.TypeSchemaNodesResponse, error) {
+	return &model.TypeSchemaNodesResponse{Success: true, TypeSchemaNodes: []*model.TypeSchemaNode{
+		{ID: "customer", Domain: "Shop", Name: "CUSTOMER"},
+		{ID: "order", Domain: "Shop", Name: "ORDER"},
+	}}, nil
+}
+
+func (fakeDatabase) GetRelationshipSchemaNodes(ctx context.Context, domain *string) (*model.RelationshipSchemaNodesResponse, error) {
+	return &model.RelationshipSchemaNodesResponse{Success: true, RelationshipSchemaNodes: []*model.RelationshipSchemaNode{
+		{ID: "placed", Domain: "Shop", Name: "PLACED", FromTypeSchemaNodeID: "customer", ToTypeSchemaNodeID: "order"},
+		{ID: "billed-by", Domain: "Shop", Name: "BILLED_BY", FromTypeSchemaNodeID: "order", ToTypeSchemaNodeID: "invoice"},
+	}}, nil
+}
+
+func (fakeDatabase) GetDomainObjectCounts(ctx context.Context, domain string) (*db.DomainObjectCounts, error) {
+	return &db.DomainObjectCounts{}, nil
+}
+
+func TestPrunePlanKeepsRelationshipsToOtherDomains(t *testing.T) {
+	document, err := Parse([]byte(`
+domain: Shop
+prune: true
+types:
+  - name: Customer
+  - name: Order
+`))
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	plan, err := NewPlan(context.Background(), fakeDatabase{}, document)
+	if err != nil {
+		t.Fatalf("NewPlan failed: %v", err)
+	}
+	changes := plan.Changes()
+	if len(changes) != 1 || changes[0].Action != model.SchemaChangeActionDelete || changes[0].Target != "CUSTOMER -[PLACED]-> ORDER" {
+		for _, change := range changes {
+			t.Logf("planned %s %s %s", change.Action, change.Kind, change.Target)
+		}
+		t.Fatalf("got %d changes, want only the deletion of PLACED", len(changes))
+	}
+}
+
+func TestSnapshotLeavesOutRelationshipsToOtherDomains(t *testing.T) {
+	document, err := Snapshot(context.Background(), fakeDatabase{}, "Shop")
+	if err != nil {
+		t.Fatalf("Snapshot failed: %v", err)
+	}
+	if len(document.Relationships) != 1 || document.Relationships[0].Name != "PLACED" || document.Relationships[0].From != "CUSTOMER" || document.Relationships[0].To != "ORDER" {
+		t.Fatalf("got relationships %+v, want only CUSTOMER -[PLACED]-> ORDER", document.Relationships)
+	}
+
+	// Applying the snapshot with prune plans no changes
+	plan, err := NewPlan(context.Background(), fakeDatabase{}, document)
+	if err != nil {
+		t.Fatalf("NewPlan failed: %v", err)
+	}
+	if changes := plan.Changes(); len(changes) != 0 {
+		t.Errorf("got %d changes, want none", len(changes))
+	}
+}
@@ -0,0 +1,102 @@
+package drain
+
+import (
+	"context"
+	"sync"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Tracker is a gqlgen handler extension that keeps count of in-flight GraphQL operations, including
+// those carried over websockets which http.Server.Shutdown does not wait for, so they can be drained
+// on shutdown
+type Tracker struct {
+	mu       sync.Mutex
+	closed   bool
+	inFlight sync.WaitGroup
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationContextMutator
+	graphql.OperationInterceptor
+} = &Tracker{}
+
+func NewTracker() *Tracker {
+	return &Tracker{}
+}
+
+func (t *Tracker) ExtensionName() string {
+	return "DrainTracker"
+}
+
+func (t *Tracker) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// MutateOperationContext rejects operations that arrive once draining has started
+func (t *Tracker) MutateOperationContext(ctx context.Context, opCtx *graphql.OperationContext) *gqlerror.Error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.closed {
+		return gqlerror.Errorf("server is shutting down")
+	}
+	return nil
+}
+
+// InterceptOperation counts the operation as in flight until its last response has been produced.
+// Queries and mutations produce a single response, subscriptions produce responses until their
+// response handler returns nil.
+func (t *Tracker) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	t.mu.Lock()
+	if t.closed {
+		t.mu.Unlock()
+		return next(ctx)
+	}
+	t.inFlight.Add(1)
+	t.mu.Unlock()
+
+	subscription := false
+	if opCtx := graphql.GetOperationContext(ctx); opCtx.Operation != nil {
+		subscription = opCtx.Operation.Operation == ast.Subscription
+	}
+
+	var once sync.Once
+	finish := func() { once.Do(t.inFlight.Done) }
+
+	handler := next(ctx)
+	return func(ctx context.Context) *graphql.Response {
+		response := handler(ctx)
+		if response == nil || !subscription {
+			finish()
+		}
+		return response
+	}
+}
+
+// Close stops new operations from being accepted
+func (t *Tracker) Close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.closed = true
+}
+
+// Wait blocks until every in-flight operation has finished or ctx is done
+func (t *Tracker) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		t.inFlight.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,352 @@
+package expression
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+// Environment is what an expression is evaluated against: the properties of an object node by key and the number
+// of its object relationships by name
+type Environment struct {
+	Properties map[string]any
+	Outgoing   map[string]int64
+	Incoming   map[string]int64
+}
+
+// Evaluate returns the value of the expression, an int64, float64, string, bool, list or nil
+func (e *Expression) Evaluate(environment Environment) (any, error) {
+	return evaluate(e.root, environment)
+}
+
+func evaluate(n node, environment Environment) (any, error) {
+	switch n := n.(type) {
+	case *literal:
+		return n.value, nil
+	case *property:
+		return normalize(environment.Properties[n.key]), nil
+	case *unary:
+		operand, err := evaluate(n.operand, environment)
+		if err != nil || operand == nil {
+			return nil, err
+		}
+		if n.operator == "!" {
+			b, err := truth(operand)
+			return !b, err
+		}
+		switch v := operand.(type) {
+		case int64:
+			return -v, nil
+		case float64:
+			return -v, nil
+		}
+		return nil, fmt.Errorf("cannot negate %v", operand)
+	case *binary:
+		return evaluateBinary(n, environment)
+	case *call:
+		return evaluateCall(n, environment)
+	}
+	return nil, fmt.Errorf("unexpected expression node %T", n)
+}
+
+// normalize turns property values into the types the operators work on
+func normalize(value any) any {
+	switch v := value.(type) {
+	case int:
+		return int64(v)
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	}
+	return value
+}
+
+func truth(value any) (bool, error) {
+	switch v := value.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return v, nil
+	}
+	return false, fmt.Errorf("%v is not a boolean", value)
+}
+
+func evaluateBinary(n *binary, environment Environment) (any, error) {
+	left, err := evaluate(n.left, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	// && and || only evaluate their right side when it decides the result
+	switch n.operator {
+	case "&&", "||":
+		l, err := truth(left)
+		if err != nil {
+			return nil, err
+		}
+		if l == (n.operator == "||") {
+			return l, nil
+		}
+		right, err := evaluate(n.right, environment)
+		if err != nil {
+			return nil, err
+		}
+		return truth(right)
+	}
+
+	right, err := evaluate(n.right, environment)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.operator {
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	}
+	if left == nil || right == nil {
+		return nil, nil
+	}
+
+	switch n.operator {
+	case "<", "<=", ">", ">=":
+		comparison, err := compare(left, right)
+		if err != nil {
+			return nil, err
+		}
+		switch n.operator {
+		case "<":
+			return comparison < 0, nil
+		case "<=":
+			return comparison <= 0, nil
+		case ">":
+			return comparison > 0, nil
+		}
+		return comparison >= 0, nil
+	case "+":
+		_, leftIsString := left.(string)
+		_, rightIsString := right.(string)
+		if leftIsString || rightIsString {
+			return toString(left) + toString(right), nil
+		}
+	}
+	return arithmetic(n.operator, left, right)
+}
+
+func arithmetic(operator string, left any, right any) (any, error) {
+	l, lok := left.(int64)
+	r, rok := right.(int64)
+	if lok && rok {
+		switch operator {
+		case "+":
+			return l + r, nil
+		case "-":
+			return l - r, nil
+		case "*":
+			return l * r, nil
+		case "%":
+			if r == 0 {
+				return nil, fmt.Errorf("modulo by zero")
+			}
+			return l % r, nil
+		}
+	}
+
+	x, xok := toFloat(left)
+	y, yok := toFloat(right)
+	if !xok || !yok {
+		return nil, fmt.Errorf("%s needs numbers, got %v and %v", operator, left, right)
+	}
+	switch operator {
+	case "+":
+		return x + y, nil
+	case "-":
+		return x - y, nil
+	case "*":
+		return x * y, nil
+	case "/":
+		if y == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return x / y, nil
+	case "%":
+		if y == 0 {
+			return nil, fmt.Errorf("modulo by zero")
+		}
+		return math.Mod(x, y), nil
+	}
+	return nil, fmt.Errorf("unknown operator %s", operator)
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
+
+func toString(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	}
+	return fmt.Sprint(value)
+}
+
+func equal(left any, right any) bool {
+	if x, ok := toFloat(left); ok {
+		y, ok := toFloat(right)
+		return ok && x == y
+	}
+	if left == nil || right == nil {
+		return left == nil && right == nil
+	}
+	return fmt.Sprint(left) == fmt.Sprint(right) && fmt.Sprintf("%T", left) == fmt.Sprintf("%T", right)
+}
+
+func compare(left any, right any) (int, error) {
+	if x, ok := toFloat(left); ok {
+		if y, ok := toFloat(right); ok {
+			switch {
+			case x < y:
+				return -1, nil
+			case x > y:
+				return 1, nil
+			}
+			return 0, nil
+		}
+	}
+	if x, ok := left.(string); ok {
+		if y, ok := right.(string); ok {
+			return strings.Compare(x, y), nil
+		}
+	}
+	return 0, fmt.Errorf("cannot compare %v and %v", left, right)
+}
+
+func evaluateCall(n *call, environment Environment) (any, error) {
+	switch n.function {
+	case "outgoing":
+		return environment.Outgoing[n.arguments[0].(*literal).value.(string)], nil
+	case "incoming":
+		return environment.Incoming[n.arguments[0].(*literal).value.(string)], nil
+	case "if":
+		condition, err := evaluate(n.arguments[0], environment)
+		if err != nil {
+			return nil, err
+		}
+		b, err := truth(condition)
+		if err != nil {
+			return nil, err
+		}
+		if b {
+			return evaluate(n.arguments[1], environment)
+		}
+		return evaluate(n.arguments[2], environment)
+	}
+
+	arguments := make([]any, 0, len(n.arguments))
+	for _, argument := range n.arguments {
+		value, err := evaluate(argument, environment)
+		if err != nil {
+			return nil, err
+		}
+		arguments = append(arguments, value)
+	}
+
+	switch n.function {
+	case "coalesce":
+		for _, argument := range arguments {
+			if argument != nil {
+				return argument, nil
+			}
+		}
+		return nil, nil
+	case "concat":
+		var b strings.Builder
+		for _, argument := range arguments {
+			b.WriteString(toString(argument))
+		}
+		return b.String(), nil
+	case "min", "max":
+		var result any
+		for _, argument := range arguments {
+			if argument == nil {
+				continue
+			}
+			if result == nil {
+				result = argument
+				continue
+			}
+			comparison, err := compare(argument, result)
+			if err != nil {
+				return nil, err
+			}
+			if (n.function == "min" && comparison < 0) || (n.function == "max" && comparison > 0) {
+				result = argument
+			}
+		}
+		return result, nil
+	}
+
+	value := arguments[0]
+	if value == nil {
+		return nil, nil
+	}
+	switch n.function {
+	case "string":
+		return toString(value), nil
+	case "length":
+		switch v := value.(type) {
+		case string:
+			return int64(utf8.RuneCountInString(v)), nil
+		case []any:
+			return int64(len(v)), nil
+		}
+		return nil, fmt.Errorf("length needs a string or a list, got %v", value)
+	case "upper", "lower", "trim":
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s needs a string, got %v", n.function, value)
+		}
+		switch n.function {
+		case "upper":
+			return strings.ToUpper(s), nil
+		case "lower":
+			return strings.ToLower(s), nil
+		}
+		return strings.TrimSpace(s), nil
+	case "round", "floor", "ceil", "abs":
+		if i, ok := value.(int64); ok {
+			if n.function == "abs" && i < 0 {
+				return -i, nil
+			}
+			return i, nil
+		}
+		f, ok := value.(float64)
+		if !ok {
+			return nil, fmt.Errorf("%s needs a number, got %v", n.function, value)
+		}
+		switch n.function {
+		case "round":
+			return math.Round(f), nil
+		case "floor":
+			return math.Floor(f), nil
+		case "ceil":
+			return math.Ceil(f), nil
+		}
+		return math.Abs(f), nil
+	}
+	return nil, fmt.Errorf("unknown function %s", n.function)
+}
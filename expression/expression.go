@@ -0,0 +1,370 @@
+// Package expression parses and evaluates the expressions computed properties of a type schema node are
+// defined with. The language has no loops, assignments or access outside the object node it is evaluated
+// on, so an expression always terminates and cannot change anything.
+//
+//	literals     1, 2.5, "text", 'text', true, false, null
+//	properties   firstName, the property keys of the object node, case insensitive
+//	operators    + - * / %  == != < <= > >=  && || !  ( )
+//	functions    upper lower trim length concat coalesce if round floor ceil abs min max string
+//	             outgoing("DEPENDS_ON") and incoming("DEPENDS_ON") count the object relationships by name
+//
+// + concatenates when either side is a string. null propagates through operators and most functions,
+// coalesce picks the first value that is not null.
+package expression
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	maxLength = 1000
+	maxDepth  = 50
+)
+
+// Expression is a parsed expression
+type Expression struct {
+	source   string
+	root     node
+	outgoing []string
+	incoming []string
+}
+
+// String returns the source of the expression
+func (e *Expression) String() string {
+	return e.source
+}
+
+// Outgoing returns the names of the outgoing object relationships the expression counts
+func (e *Expression) Outgoing() []string {
+	return e.outgoing
+}
+
+// Incoming returns the names of the incoming object relationships the expression counts
+func (e *Expression) Incoming() []string {
+	return e.incoming
+}
+
+type node interface{}
+
+type literal struct {
+	value any
+}
+
+type property struct {
+	key string
+}
+
+type unary struct {
+	operator string
+	operand  node
+}
+
+type binary struct {
+	operator    string
+	left, right node
+}
+
+type call struct {
+	function  string
+	arguments []node
+}
+
+// functionArity is the number of arguments of each function, -1 for any number but at least one
+var functionArity = map[string]int{
+	"upper": 1, "lower": 1, "trim": 1, "length": 1, "string": 1,
+	"round": 1, "floor": 1, "ceil": 1, "abs": 1,
+	"concat": -1, "coalesce": -1, "min": -1, "max": -1,
+	"if":       3,
+	"outgoing": 1, "incoming": 1,
+}
+
+// Parse parses an expression. Property keys are normalized the way property keys are stored, relationship names
+// the way object relationship names are.
+func Parse(source string) (*Expression, error) {
+	if len(source) > maxLength {
+		return nil, fmt.Errorf("expression is longer than %d characters", maxLength)
+	}
+	tokens, err := tokenize(source)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens, expression: &Expression{source: source}}
+	root, err := p.parseOr(0)
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokenEnd {
+		return nil, fmt.Errorf("unexpected %s at offset %d", p.peek(), p.peek().offset)
+	}
+	p.expression.root = root
+	return p.expression, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEnd tokenKind = iota
+	tokenNumber
+	tokenString
+	tokenIdentifier
+	tokenOperator
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	value  any
+	offset int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEnd {
+		return "end of expression"
+	}
+	return strconv.Quote(t.text)
+}
+
+var operators = []string{"==", "!=", "<=", ">=", "&&", "||", "+", "-", "*", "/", "%", "<", ">", "!", "(", ")", ","}
+
+func tokenize(source string) ([]token, error) {
+	tokens := []token{}
+	runes := []rune(source)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case unicode.IsDigit(r) || (r == '.' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.' || runes[i] == 'e' || runes[i] == 'E' ||
+				((runes[i] == '+' || runes[i] == '-') && (runes[i-1] == 'e' || runes[i-1] == 'E'))) {
+				i++
+			}
+			text := string(runes[start:i])
+			var value any
+			if integer, err := strconv.ParseInt(text, 10, 64); err == nil {
+				value = integer
+			} else if float, err := strconv.ParseFloat(text, 64); err == nil {
+				value = float
+			} else {
+				return nil, fmt.Errorf("invalid number %q at offset %d", text, start)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: text, value: value, offset: start})
+		case r == '"' || r == '\'':
+			start := i
+			var b strings.Builder
+			for i++; ; i++ {
+				if i >= len(runes) {
+					return nil, fmt.Errorf("unterminated string at offset %d", start)
+				}
+				if runes[i] == r {
+					i++
+					break
+				}
+				if runes[i] == '\\' && i+1 < len(runes) {
+					i++
+					switch runes[i] {
+					case 'n':
+						b.WriteRune('\n')
+					case 't':
+						b.WriteRune('\t')
+					default:
+						b.WriteRune(runes[i])
+					}
+					continue
+				}
+				b.WriteRune(runes[i])
+			}
+			tokens = append(tokens, token{kind: tokenString, text: string(runes[start:i]), value: b.String(), offset: start})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdentifier, text: string(runes[start:i]), offset: start})
+		default:
+			matched := false
+			for _, operator := range operators {
+				if strings.HasPrefix(string(runes[i:]), operator) {
+					tokens = append(tokens, token{kind: tokenOperator, text: operator, offset: i})
+					i += len([]rune(operator))
+					matched = true
+					break
+				}
+			}
+			if !matched {
+				return nil, fmt.Errorf("unexpected character %q at offset %d", r, i)
+			}
+		}
+	}
+	return append(tokens, token{kind: tokenEnd, offset: len(runes)}), nil
+}
+
+type parser struct {
+	tokens     []token
+	position   int
+	expression *Expression
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.position]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.position]
+	if t.kind != tokenEnd {
+		p.position++
+	}
+	return t
+}
+
+// accept consumes the next token when it is one of the operators
+func (p *parser) accept(operators ...string) (string, bool) {
+	t := p.peek()
+	if t.kind != tokenOperator {
+		return "", false
+	}
+	for _, operator := range operators {
+		if t.text == operator {
+			p.position++
+			return operator, true
+		}
+	}
+	return "", false
+}
+
+func (p *parser) expect(operator string) error {
+	if _, ok := p.accept(operator); !ok {
+		return fmt.Errorf("expected %q at offset %d, found %s", operator, p.peek().offset, p.peek())
+	}
+	return nil
+}
+
+// binaryLevels are the binary operators from the lowest precedence to the highest
+var binaryLevels = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *parser) parseOr(depth int) (node, error) {
+	return p.parseBinary(0, depth)
+}
+
+func (p *parser) parseBinary(level int, depth int) (node, error) {
+	if level == len(binaryLevels) {
+		return p.parseUnary(depth)
+	}
+	left, err := p.parseBinary(level+1, depth)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		operator, ok := p.accept(binaryLevels[level]...)
+		if !ok {
+			return left, nil
+		}
+		right, err := p.parseBinary(level+1, depth)
+		if err != nil {
+			return nil, err
+		}
+		left = &binary{operator: operator, left: left, right: right}
+	}
+}
+
+func (p *parser) parseUnary(depth int) (node, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("expression is nested deeper than %d levels", maxDepth)
+	}
+	if operator, ok := p.accept("!", "-"); ok {
+		operand, err := p.parseUnary(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return &unary{operator: operator, operand: operand}, nil
+	}
+	return p.parsePrimary(depth)
+}
+
+func (p *parser) parsePrimary(depth int) (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenNumber, tokenString:
+		return &literal{value: t.value}, nil
+	case tokenIdentifier:
+		switch strings.ToLower(t.text) {
+		case "true":
+			return &literal{value: true}, nil
+		case "false":
+			return &literal{value: false}, nil
+		case "null":
+			return &literal{value: nil}, nil
+		}
+		if _, ok := p.accept("("); ok {
+			return p.parseCall(t, depth)
+		}
+		return &property{key: strings.ToLower(t.text)}, nil
+	case tokenOperator:
+		if t.text == "(" {
+			inner, err := p.parseOr(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			return inner, p.expect(")")
+		}
+	}
+	return nil, fmt.Errorf("unexpected %s at offset %d", t, t.offset)
+}
+
+func (p *parser) parseCall(name token, depth int) (node, error) {
+	function := strings.ToLower(name.text)
+	arity, ok := functionArity[function]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %s at offset %d", name.text, name.offset)
+	}
+
+	arguments := []node{}
+	if _, ok := p.accept(")"); !ok {
+		for {
+			argument, err := p.parseOr(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			arguments = append(arguments, argument)
+			if _, ok := p.accept(","); !ok {
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+	}
+	if (arity >= 0 && len(arguments) != arity) || (arity < 0 && len(arguments) == 0) {
+		return nil, fmt.Errorf("wrong number of arguments to %s at offset %d", function, name.offset)
+	}
+
+	if function == "outgoing" || function == "incoming" {
+		var name string
+		relationship, ok := arguments[0].(*literal)
+		if ok {
+			name, ok = relationship.value.(string)
+		}
+		if !ok {
+			return nil, fmt.Errorf("%s takes the name of an object relationship as a string", function)
+		}
+		name = strings.ReplaceAll(strings.TrimSpace(strings.ToUpper(name)), " ", "_")
+		arguments[0] = &literal{value: name}
+		if function == "outgoing" {
+			p.expression.outgoing = append(p.expression.outgoing, name)
+		} else {
+			p.expression.incoming = append(p.expression.incoming, name)
+		}
+	}
+	return &call{function: function, arguments: arguments}, nil
+}
@@ -0,0 +1,220 @@
+package expression
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var environment = Environment{
+	Properties: map[string]any{
+		"name":     "Web 1",
+		"replicas": 3,
+		"ratio":    json.Number("0.5"),
+		"enabled":  true,
+		"tags":     []any{"a", "b"},
+	},
+	Outgoing: map[string]int64{"DEPENDS_ON": 2},
+	Incoming: map[string]int64{"RUNS_ON": 1},
+}
+
+func evaluateSource(t *testing.T, source string) (any, error) {
+	t.Helper()
+	expression, err := Parse(source)
+	if err != nil {
+		t.Fatalf("Parse(%q) failed: %v", source, err)
+	}
+	return expression.Evaluate(environment)
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		source string
+		want   any
+	}{
+		// precedence and associativity
+		{"1 + 2 * 3", int64(7)},
+		{"(1 + 2) * 3", int64(9)},
+		{"10 - 4 - 3", int64(3)},
+		{"2 * 3 % 4", int64(2)},
+		{"7 / 2", 3.5},
+		{"-2 * 3", int64(-6)},
+		{"- -2", int64(2)},
+		{"1 + 2 == 3", true},
+		{"1 < 2 == true", true},
+		{"1 + 1 >= 2 && 3 > 4", false},
+		{"true || false && false", true},
+		{"(true || false) && false", false},
+		{"!true || true", true},
+		{"!(1 > 2)", true},
+		{"1 + 2 + 'a'", "3a"},
+		{"'a' + 1 + 2", "a12"},
+
+		// literals, properties and functions
+		{`"double" + 'single'`, "doublesingle"},
+		{`'it\'s'`, "it's"},
+		{".5 + 1e1", 10.5},
+		{"NULL == null", true},
+		{"Name", "Web 1"},
+		{"replicas * 2", int64(6)},
+		{"ratio * 2", 1.0},
+		{"enabled && replicas > 2", true},
+		{"length(name) + length(tags)", int64(7)},
+		{"upper(trim(' web '))", "WEB"},
+		{"LOWER(name)", "web 1"},
+		{"concat(name, '-', replicas)", "Web 1-3"},
+		{"if(replicas > 2, 'large', 'small')", "large"},
+		{"round(2.5) + floor(1.7) + ceil(1.2) + abs(-1.5)", 7.5},
+		{"abs(-4)", int64(4)},
+		{"min(3, 1.5, 2)", 1.5},
+		{"max('a', 'c', 'b')", "c"},
+		{"string(1.5)", "1.5"},
+		{"outgoing('depends on') + incoming('RUNS_ON')", int64(3)},
+		{"outgoing('unknown')", int64(0)},
+		{"1 == 1.0", true},
+		{"'1' == 1", false},
+
+		// missing properties are null, which propagates
+		{"missing", nil},
+		{"missing + 1", nil},
+		{"-missing", nil},
+		{"!missing", nil},
+		{"missing > 1", nil},
+		{"missing == null", true},
+		{"missing != 1", true},
+		{"missing && true", false},
+		{"missing || true", true},
+		{"upper(missing)", nil},
+		{"coalesce(missing, 'default')", "default"},
+		{"coalesce(missing, null)", nil},
+		{"concat('a', missing, 'b')", "ab"},
+		{"min(missing, 2)", int64(2)},
+		{"missing / 0", nil},
+
+		// && and || short-circuit
+		{"false && 1 / 0 > 1", false},
+		{"true || upper(1)", true},
+		{"if(true, 1, 1 / 0)", int64(1)},
+	}
+	for _, test := range tests {
+		got, err := evaluateSource(t, test.source)
+		if err != nil {
+			t.Errorf("%s: got error %v, want %v", test.source, err, test.want)
+			continue
+		}
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s = %#v, want %#v", test.source, got, test.want)
+		}
+	}
+}
+
+func TestEvaluateErrors(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		// type errors
+		{"'a' - 1", "- needs numbers"},
+		{"tags * 2", "* needs numbers"},
+		{"1 < 'a'", "cannot compare 1 and a"},
+		{"!1", "1 is not a boolean"},
+		{"-'a'", "cannot negate a"},
+		{"1 && true", "1 is not a boolean"},
+		{"true && 'yes'", "yes is not a boolean"},
+		{"upper(1)", "upper needs a string"},
+		{"length(1)", "length needs a string or a list"},
+		{"round('a')", "round needs a number"},
+		{"if(1, 2, 3)", "1 is not a boolean"},
+		{"min(1, 'a')", "cannot compare"},
+
+		// division by zero
+		{"1 / 0", "division by zero"},
+		{"1.5 / 0.0", "division by zero"},
+		{"5 % 0", "modulo by zero"},
+		{"5.5 % 0", "modulo by zero"},
+		{"replicas / (replicas - 3)", "division by zero"},
+		{"1 + 1 / 0", "division by zero"},
+	}
+	for _, test := range tests {
+		got, err := evaluateSource(t, test.source)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("%s: got %v and error %v, want error %q", test.source, got, err, test.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		source string
+		want   string
+	}{
+		{"", "unexpected end of expression at offset 0"},
+		{"   ", "unexpected end of expression at offset 3"},
+		{"1 +", "unexpected end of expression at offset 3"},
+		{"1 +* 2", `unexpected "*" at offset 3`},
+		{"1 2", `unexpected "2" at offset 2`},
+		{"(1 + 2", `expected ")" at offset 6, found end of expression`},
+		{"(1 + 2))", `unexpected ")" at offset 7`},
+		{")", `unexpected ")" at offset 0`},
+		{"'abc", "unterminated string at offset 0"},
+		{`"abc\"`, "unterminated string at offset 0"},
+		{"1.2.3", `invalid number "1.2.3" at offset 0`},
+		{"1e", `invalid number "1e" at offset 0`},
+		{"a # b", "unexpected character '#' at offset 2"},
+		{"name.first", "unexpected character '.' at offset 4"},
+		{"a = 1", "unexpected character '=' at offset 2"},
+		{"unknown(1)", "unknown function unknown at offset 0"},
+		{"upper()", "wrong number of arguments to upper"},
+		{"upper(1, 2)", "wrong number of arguments to upper"},
+		{"coalesce()", "wrong number of arguments to coalesce"},
+		{"if(true, 1)", "wrong number of arguments to if"},
+		{"upper(1,)", `unexpected ")" at offset 8`},
+		{"upper(1", `expected ")" at offset 7`},
+		{"outgoing(name)", "outgoing takes the name of an object relationship as a string"},
+		{"incoming(1)", "incoming takes the name of an object relationship as a string"},
+		{strings.Repeat("(", 60) + "1" + strings.Repeat(")", 60), "nested deeper than 50 levels"},
+		{strings.Repeat("!", 60) + "true", "nested deeper than 50 levels"},
+		{strings.Repeat("upper(", 60) + "'a'" + strings.Repeat(")", 60), "nested deeper than 50 levels"},
+		{strings.Repeat("1+", 500) + "1", "longer than 1000 characters"},
+	}
+	for _, test := range tests {
+		expression, err := Parse(test.source)
+		if err == nil || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("Parse(%q) = %v and error %v, want error %q", test.source, expression, err, test.want)
+		}
+	}
+}
+
+func TestParseCollectsRelationshipNames(t *testing.T) {
+	expression, err := Parse("outgoing(' depends on ') + outgoing('USES') - incoming('runs on')")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	if got, want := expression.Outgoing(), []string{"DEPENDS_ON", "USES"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got outgoing %v, want %v", got, want)
+	}
+	if got, want := expression.Incoming(), []string{"RUNS_ON"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got incoming %v, want %v", got, want)
+	}
+}
+
+// FuzzParse checks malformed input makes Parse and Evaluate return errors rather than panic
+func FuzzParse(f *testing.F) {
+	for _, seed := range []string{
+		"1 + 2 * 3", "(1 + 2", "'abc", "1e+", "-.5e-3", "upper(name, ", "outgoing('a') / incoming(x)",
+		"if(missing, 1 / 0, -'a')", "min()", "!!!!-1", "\"\\", "coalesce(tags, 1) + length(tags) % 0",
+	} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, source string) {
+		expression, err := Parse(source)
+		if err != nil {
+			return
+		}
+		if expression.String() != source {
+			t.Errorf("String() = %q, want %q", expression.String(), source)
+		}
+		expression.Evaluate(environment)
+	})
+}
@@ -48,6 +48,40 @@ type DirectiveRoot struct {
 }
 
 type ComplexityRoot struct {
+	CardinalityReportResponse struct {
+		Message    func(childComplexity int) int
+		Success    func(childComplexity int) int
+		Violations func(childComplexity int) int
+	}
+
+	CardinalityViolation struct {
+		Count                    func(childComplexity int) int
+		Message                  func(childComplexity int) int
+		ObjectNodeID             func(childComplexity int) int
+		Relationship             func(childComplexity int) int
+		RelationshipSchemaNodeID func(childComplexity int) int
+		Side                     func(childComplexity int) int
+	}
+
+	ComputedProperty struct {
+		Expression func(childComplexity int) int
+		Key        func(childComplexity int) int
+	}
+
+	DomainDependenciesResponse struct {
+		Dependencies func(childComplexity int) int
+		Message      func(childComplexity int) int
+		Success      func(childComplexity int) int
+	}
+
+	DomainDependency struct {
+		Direction                func(childComplexity int) int
+		Domain                   func(childComplexity int) int
+		ObjectRelationshipCount  func(childComplexity int) int
+		Relationship             func(childComplexity int) int
+		RelationshipSchemaNodeID func(childComplexity int) int
+	}
+
 	DomainSchemaNode struct {
 		Domain     func(childComplexity int) int
 		ID         func(childComplexity int) int
@@ -69,29 +103,52 @@ type ComplexityRoot struct {
 		Success           func(childComplexity int) int
 	}
 
+	Index struct {
+		EntityType       func(childComplexity int) int
+		LabelsOrTypes    func(childComplexity int) int
+		Name             func(childComplexity int) int
+		OwningConstraint func(childComplexity int) int
+		Properties       func(childComplexity int) int
+		State            func(childComplexity int) int
+		Type             func(childComplexity int) int
+	}
+
+	IndexesResponse struct {
+		Indexes func(childComplexity int) int
+		Message func(childComplexity int) int
+		Success func(childComplexity int) int
+	}
+
 	Mutation struct {
 		AddLabelsOnObjectNode                      func(childComplexity int, id string, labels []string) int
+		ApplyDomainSchema                          func(childComplexity int, document string, dryRun *bool) int
 		CreateDomainSchemaNode                     func(childComplexity int, domain string) int
 		CreateObjectNode                           func(childComplexity int, domain string, name string, typeArg string, labels []string, properties []*model.PropertyInput) int
 		CreateObjectRelationship                   func(childComplexity int, name string, properties []*model.PropertyInput, fromObjectNodeID string, toObjectNodeID string) int
 		CreateRelationshipSchemaNode               func(childComplexity int, name string, domain string, fromTypeSchemaNodeID string, toTypeSchemaNodeID string) int
 		CreateTypeSchemaNode                       func(childComplexity int, domain string, name string) int
-		DeleteDomainSchemaNode                     func(childComplexity int, id string) int
+		DeleteDomainSchemaNode                     func(childComplexity int, id string, crossDomain *model.CrossDomainRule) int
 		DeleteObjectNode                           func(childComplexity int, id string) int
 		DeleteObjectRelationship                   func(childComplexity int, id string) int
 		DeleteRelationshipSchemaNode               func(childComplexity int, id string) int
 		DeleteTypeSchemaNode                       func(childComplexity int, id string) int
+		RebuildConstraints                         func(childComplexity int) int
 		RemoveLabelsFromObjectNode                 func(childComplexity int, id string, labels []string) int
 		RemovePropertiesFromObjectNode             func(childComplexity int, id string, properties []string) int
 		RemovePropertiesFromObjectRelationship     func(childComplexity int, id string, properties []string) int
-		RemovePropertiesFromRelationshipSchemaNode func(childComplexity int, id string, properties []string) int
-		RemovePropertiesFromTypeSchemaNode         func(childComplexity int, id string, properties []string) int
+		RemovePropertiesFromRelationshipSchemaNode func(childComplexity int, id string, properties []string, propagate *bool) int
+		RemovePropertiesFromTypeSchemaNode         func(childComplexity int, id string, properties []string, propagate *bool) int
 		RenameDomainSchemaNode                     func(childComplexity int, id string, newName string) int
 		RenameObjectNode                           func(childComplexity int, id string, newName string) int
-		RenamePropertyOnRelationshipSchemaNode     func(childComplexity int, id string, oldPropertyName string, newPropertyName string) int
-		RenamePropertyOnTypeSchemaNode             func(childComplexity int, id string, oldPropertyName string, newPropertyName string) int
+		RenamePropertyOnRelationshipSchemaNode     func(childComplexity int, id string, oldPropertyName string, newPropertyName string, propagate *bool) int
+		RenamePropertyOnTypeSchemaNode             func(childComplexity int, id string, oldPropertyName string, newPropertyName string, propagate *bool) int
 		RenameRelationshipSchemaNode               func(childComplexity int, id string, newName string) int
 		RenameTypeSchemaNode                       func(childComplexity int, id string, newName string) int
+		SetCardinalityOnRelationshipSchemaNode     func(childComplexity int, id string, cardinality *model.RelationshipCardinalityInput) int
+		SetComputedPropertyOnTypeSchemaNode        func(childComplexity int, id string, property string, expression *string) int
+		SetJSONSchemaOnTypeSchemaNode              func(childComplexity int, id string, property string, schema *string) int
+		SetParentOnTypeSchemaNode                  func(childComplexity int, id string, parentTypeSchemaNodeID *string) int
+		SetPropertyConstraintOnTypeSchemaNode      func(childComplexity int, id string, property string, constraint *model.PropertyConstraintInput) int
 		UpdatePropertiesOnObjectNode               func(childComplexity int, id string, properties []*model.PropertyInput) int
 		UpdatePropertiesOnObjectRelationship       func(childComplexity int, id string, properties []*model.PropertyInput) int
 		UpdatePropertiesOnRelationshipSchemaNode   func(childComplexity int, id string, properties []*model.PropertyInput) int
@@ -167,28 +224,60 @@ type ComplexityRoot struct {
 	}
 
 	Property struct {
-		Key   func(childComplexity int) int
-		Type  func(childComplexity int) int
-		Value func(childComplexity int) int
+		Key      func(childComplexity int) int
+		ReadOnly func(childComplexity int) int
+		Type     func(childComplexity int) int
+		Value    func(childComplexity int) int
+	}
+
+	PropertyConstraint struct {
+		AllowedValues func(childComplexity int) int
+		DefaultValue  func(childComplexity int) int
+		Key           func(childComplexity int) int
+		Max           func(childComplexity int) int
+		MaxLength     func(childComplexity int) int
+		Min           func(childComplexity int) int
+		MinLength     func(childComplexity int) int
+		Pattern       func(childComplexity int) int
+		Unique        func(childComplexity int) int
+	}
+
+	PropertyJsonSchema struct {
+		Key    func(childComplexity int) int
+		Schema func(childComplexity int) int
 	}
 
 	Query struct {
+		ExportSchemaDiagram                    func(childComplexity int, domain string, format model.SchemaDiagramFormat, includeCounts *bool) int
+		GetDomainExternalDependencies          func(childComplexity int, domain string) int
 		GetDomainSchemaNode                    func(childComplexity int, id string) int
 		GetDomainSchemaNodes                   func(childComplexity int) int
 		GetObjectNode                          func(childComplexity int, id string) int
 		GetObjectNodeIncomingRelationships     func(childComplexity int, toObjectNodeID string) int
 		GetObjectNodeOutgoingRelationships     func(childComplexity int, fromObjectNodeID string) int
 		GetObjectNodeRelationship              func(childComplexity int, id string) int
-		GetObjectNodes                         func(childComplexity int, domain *string, typeArg *string) int
+		GetObjectNodes                         func(childComplexity int, domain *string, typeArg *string, where []*model.PropertyFilter, orderBy []*model.PropertySort) int
 		GetRelationshipSchemaNode              func(childComplexity int, id string) int
 		GetRelationshipSchemaNodes             func(childComplexity int, domain *string) int
 		GetTypeSchemaNode                      func(childComplexity int, id string) int
 		GetTypeSchemaNodeIncomingRelationships func(childComplexity int, id string) int
 		GetTypeSchemaNodeOutgoingRelationships func(childComplexity int, id string) int
 		GetTypeSchemaNodes                     func(childComplexity int, domain *string) int
+		Indexes                                func(childComplexity int) int
+		ObjectNodesInBoundingBox               func(childComplexity int, domain string, typeArg string, property string, lowerLeft model.PointInput, upperRight model.PointInput) int
+		ObjectNodesWithinDistance              func(childComplexity int, domain string, typeArg string, property string, center model.PointInput, meters float64) int
+		RelationshipCardinalityReport          func(childComplexity int, domain string) int
+		SchemaDiff                             func(childComplexity int, domain string, against model.SchemaDiffAgainst) int
+	}
+
+	RelationshipCardinality struct {
+		Cardinality  func(childComplexity int) int
+		FromRequired func(childComplexity int) int
+		ToRequired   func(childComplexity int) int
 	}
 
 	RelationshipSchemaNode struct {
+		Cardinality          func(childComplexity int) int
 		Domain               func(childComplexity int) int
 		FromTypeSchemaNodeID func(childComplexity int) int
 		ID                   func(childComplexity int) int
@@ -218,6 +307,36 @@ type ComplexityRoot struct {
 		Success func(childComplexity int) int
 	}
 
+	SchemaChange struct {
+		Action                      func(childComplexity int) int
+		AffectedObjectNodes         func(childComplexity int) int
+		AffectedObjectRelationships func(childComplexity int) int
+		Compatibility               func(childComplexity int) int
+		Description                 func(childComplexity int) int
+		Kind                        func(childComplexity int) int
+		Target                      func(childComplexity int) int
+	}
+
+	SchemaDiagramResponse struct {
+		Diagram func(childComplexity int) int
+		Format  func(childComplexity int) int
+		Message func(childComplexity int) int
+		Success func(childComplexity int) int
+	}
+
+	SchemaDiffResponse struct {
+		Changes func(childComplexity int) int
+		Message func(childComplexity int) int
+		Success func(childComplexity int) int
+	}
+
+	SchemaPlanResponse struct {
+		Changes func(childComplexity int) int
+		DryRun  func(childComplexity int) int
+		Message func(childComplexity int) int
+		Success func(childComplexity int) int
+	}
+
 	Subscription struct {
 		DomainSchemaNodeCreated       func(childComplexity int) int
 		DomainSchemaNodeDeleted       func(childComplexity int) int
@@ -237,13 +356,17 @@ type ComplexityRoot struct {
 	}
 
 	TypeSchemaNode struct {
-		Domain       func(childComplexity int) int
-		ID           func(childComplexity int) int
-		Labels       func(childComplexity int) int
-		Name         func(childComplexity int) int
-		OriginalName func(childComplexity int) int
-		Properties   func(childComplexity int) int
-		Type         func(childComplexity int) int
+		ComputedProperties     func(childComplexity int) int
+		Constraints            func(childComplexity int) int
+		Domain                 func(childComplexity int) int
+		ID                     func(childComplexity int) int
+		JSONSchemas            func(childComplexity int) int
+		Labels                 func(childComplexity int) int
+		Name                   func(childComplexity int) int
+		OriginalName           func(childComplexity int) int
+		ParentTypeSchemaNodeID func(childComplexity int) int
+		Properties             func(childComplexity int) int
+		Type                   func(childComplexity int) int
 	}
 
 	TypeSchemaNodeResponse struct {
@@ -273,34 +396,48 @@ type MutationResolver interface {
 	DeleteObjectRelationship(ctx context.Context, id string) (*model.ObjectRelationshipResponse, error)
 	CreateDomainSchemaNode(ctx context.Context, domain string) (*model.DomainSchemaNodeResponse, error)
 	RenameDomainSchemaNode(ctx context.Context, id string, newName string) (*model.DomainSchemaNodeResponse, error)
-	DeleteDomainSchemaNode(ctx context.Context, id string) (*model.DomainSchemaNodeResponse, error)
+	DeleteDomainSchemaNode(ctx context.Context, id string, crossDomain *model.CrossDomainRule) (*model.DomainSchemaNodeResponse, error)
 	CreateTypeSchemaNode(ctx context.Context, domain string, name string) (*model.TypeSchemaNodeResponse, error)
 	RenameTypeSchemaNode(ctx context.Context, id string, newName string) (*model.TypeSchemaNodeResponse, error)
 	UpdatePropertiesOnTypeSchemaNode(ctx context.Context, id string, properties []*model.PropertyInput) (*model.TypeSchemaNodeResponse, error)
-	RenamePropertyOnTypeSchemaNode(ctx context.Context, id string, oldPropertyName string, newPropertyName string) (*model.TypeSchemaNodeResponse, error)
-	RemovePropertiesFromTypeSchemaNode(ctx context.Context, id string, properties []string) (*model.TypeSchemaNodeResponse, error)
+	RenamePropertyOnTypeSchemaNode(ctx context.Context, id string, oldPropertyName string, newPropertyName string, propagate *bool) (*model.TypeSchemaNodeResponse, error)
+	RemovePropertiesFromTypeSchemaNode(ctx context.Context, id string, properties []string, propagate *bool) (*model.TypeSchemaNodeResponse, error)
+	SetJSONSchemaOnTypeSchemaNode(ctx context.Context, id string, property string, schema *string) (*model.TypeSchemaNodeResponse, error)
+	SetPropertyConstraintOnTypeSchemaNode(ctx context.Context, id string, property string, constraint *model.PropertyConstraintInput) (*model.TypeSchemaNodeResponse, error)
+	SetComputedPropertyOnTypeSchemaNode(ctx context.Context, id string, property string, expression *string) (*model.TypeSchemaNodeResponse, error)
+	SetParentOnTypeSchemaNode(ctx context.Context, id string, parentTypeSchemaNodeID *string) (*model.TypeSchemaNodeResponse, error)
 	DeleteTypeSchemaNode(ctx context.Context, id string) (*model.TypeSchemaNodeResponse, error)
 	CreateRelationshipSchemaNode(ctx context.Context, name string, domain string, fromTypeSchemaNodeID string, toTypeSchemaNodeID string) (*model.RelationshipSchemaNodeResponse, error)
 	RenameRelationshipSchemaNode(ctx context.Context, id string, newName string) (*model.RelationshipSchemaNodeResponse, error)
 	UpdatePropertiesOnRelationshipSchemaNode(ctx context.Context, id string, properties []*model.PropertyInput) (*model.RelationshipSchemaNodeResponse, error)
-	RenamePropertyOnRelationshipSchemaNode(ctx context.Context, id string, oldPropertyName string, newPropertyName string) (*model.RelationshipSchemaNodeResponse, error)
-	RemovePropertiesFromRelationshipSchemaNode(ctx context.Context, id string, properties []string) (*model.RelationshipSchemaNodeResponse, error)
+	RenamePropertyOnRelationshipSchemaNode(ctx context.Context, id string, oldPropertyName string, newPropertyName string, propagate *bool) (*model.RelationshipSchemaNodeResponse, error)
+	RemovePropertiesFromRelationshipSchemaNode(ctx context.Context, id string, properties []string, propagate *bool) (*model.RelationshipSchemaNodeResponse, error)
+	SetCardinalityOnRelationshipSchemaNode(ctx context.Context, id string, cardinality *model.RelationshipCardinalityInput) (*model.RelationshipSchemaNodeResponse, error)
 	DeleteRelationshipSchemaNode(ctx context.Context, id string) (*model.RelationshipSchemaNodeResponse, error)
+	ApplyDomainSchema(ctx context.Context, document string, dryRun *bool) (*model.SchemaPlanResponse, error)
+	RebuildConstraints(ctx context.Context) (*model.IndexesResponse, error)
 }
 type QueryResolver interface {
 	GetObjectNode(ctx context.Context, id string) (*model.ObjectNodeResponse, error)
-	GetObjectNodes(ctx context.Context, domain *string, typeArg *string) (*model.ObjectNodesResponse, error)
+	GetObjectNodes(ctx context.Context, domain *string, typeArg *string, where []*model.PropertyFilter, orderBy []*model.PropertySort) (*model.ObjectNodesResponse, error)
+	ObjectNodesWithinDistance(ctx context.Context, domain string, typeArg string, property string, center model.PointInput, meters float64) (*model.ObjectNodesResponse, error)
+	ObjectNodesInBoundingBox(ctx context.Context, domain string, typeArg string, property string, lowerLeft model.PointInput, upperRight model.PointInput) (*model.ObjectNodesResponse, error)
 	GetObjectNodeRelationship(ctx context.Context, id string) (*model.ObjectRelationshipResponse, error)
 	GetObjectNodeOutgoingRelationships(ctx context.Context, fromObjectNodeID string) (*model.ObjectRelationshipsResponse, error)
 	GetObjectNodeIncomingRelationships(ctx context.Context, toObjectNodeID string) (*model.ObjectRelationshipsResponse, error)
 	GetDomainSchemaNode(ctx context.Context, id string) (*model.DomainSchemaNodeResponse, error)
 	GetDomainSchemaNodes(ctx context.Context) (*model.DomainSchemaNodesResponse, error)
+	GetDomainExternalDependencies(ctx context.Context, domain string) (*model.DomainDependenciesResponse, error)
 	GetTypeSchemaNode(ctx context.Context, id string) (*model.TypeSchemaNodeResponse, error)
 	GetTypeSchemaNodes(ctx context.Context, domain *string) (*model.TypeSchemaNodesResponse, error)
 	GetTypeSchemaNodeOutgoingRelationships(ctx context.Context, id string) (*model.RelationshipSchemaNodesResponse, error)
 	GetTypeSchemaNodeIncomingRelationships(ctx context.Context, id string) (*model.RelationshipSchemaNodesResponse, error)
 	GetRelationshipSchemaNode(ctx context.Context, id string) (*model.RelationshipSchemaNodeResponse, error)
 	GetRelationshipSchemaNodes(ctx context.Context, domain *string) (*model.RelationshipSchemaNodesResponse, error)
+	RelationshipCardinalityReport(ctx context.Context, domain string) (*model.CardinalityReportResponse, error)
+	SchemaDiff(ctx context.Context, domain string, against model.SchemaDiffAgainst) (*model.SchemaDiffResponse, error)
+	ExportSchemaDiagram(ctx context.Context, domain string, format model.SchemaDiagramFormat, includeCounts *bool) (*model.SchemaDiagramResponse, error)
+	Indexes(ctx context.Context) (*model.IndexesResponse, error)
 }
 type SubscriptionResolver interface {
 	ObjectNodeCreated(ctx context.Context) (<-chan *model.ObjectNodeResponse, error)
@@ -339,6 +476,139 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 	_ = ec
 	switch typeName + "." + field {
 
+	case "CardinalityReportResponse.message":
+		if e.complexity.CardinalityReportResponse.Message == nil {
+			break
+		}
+
+		return e.complexity.CardinalityReportResponse.Message(childComplexity), true
+
+	case "CardinalityReportResponse.success":
+		if e.complexity.CardinalityReportResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.CardinalityReportResponse.Success(childComplexity), true
+
+	case "CardinalityReportResponse.violations":
+		if e.complexity.CardinalityReportResponse.Violations == nil {
+			break
+		}
+
+		return e.complexity.CardinalityReportResponse.Violations(childComplexity), true
+
+	case "CardinalityViolation.count":
+		if e.complexity.CardinalityViolation.Count == nil {
+			break
+		}
+
+		return e.complexity.CardinalityViolation.Count(childComplexity), true
+
+	case "CardinalityViolation.message":
+		if e.complexity.CardinalityViolation.Message == nil {
+			break
+		}
+
+		return e.complexity.CardinalityViolation.Message(childComplexity), true
+
+	case "CardinalityViolation.objectNodeId":
+		if e.complexity.CardinalityViolation.ObjectNodeID == nil {
+			break
+		}
+
+		return e.complexity.CardinalityViolation.ObjectNodeID(childComplexity), true
+
+	case "CardinalityViolation.relationship":
+		if e.complexity.CardinalityViolation.Relationship == nil {
+			break
+		}
+
+		return e.complexity.CardinalityViolation.Relationship(childComplexity), true
+
+	case "CardinalityViolation.relationshipSchemaNodeId":
+		if e.complexity.CardinalityViolation.RelationshipSchemaNodeID == nil {
+			break
+		}
+
+		return e.complexity.CardinalityViolation.RelationshipSchemaNodeID(childComplexity), true
+
+	case "CardinalityViolation.side":
+		if e.complexity.CardinalityViolation.Side == nil {
+			break
+		}
+
+		return e.complexity.CardinalityViolation.Side(childComplexity), true
+
+	case "ComputedProperty.expression":
+		if e.complexity.ComputedProperty.Expression == nil {
+			break
+		}
+
+		return e.complexity.ComputedProperty.Expression(childComplexity), true
+
+	case "ComputedProperty.key":
+		if e.complexity.ComputedProperty.Key == nil {
+			break
+		}
+
+		return e.complexity.ComputedProperty.Key(childComplexity), true
+
+	case "DomainDependenciesResponse.dependencies":
+		if e.complexity.DomainDependenciesResponse.Dependencies == nil {
+			break
+		}
+
+		return e.complexity.DomainDependenciesResponse.Dependencies(childComplexity), true
+
+	case "DomainDependenciesResponse.message":
+		if e.complexity.DomainDependenciesResponse.Message == nil {
+			break
+		}
+
+		return e.complexity.DomainDependenciesResponse.Message(childComplexity), true
+
+	case "DomainDependenciesResponse.success":
+		if e.complexity.DomainDependenciesResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.DomainDependenciesResponse.Success(childComplexity), true
+
+	case "DomainDependency.direction":
+		if e.complexity.DomainDependency.Direction == nil {
+			break
+		}
+
+		return e.complexity.DomainDependency.Direction(childComplexity), true
+
+	case "DomainDependency.domain":
+		if e.complexity.DomainDependency.Domain == nil {
+			break
+		}
+
+		return e.complexity.DomainDependency.Domain(childComplexity), true
+
+	case "DomainDependency.objectRelationshipCount":
+		if e.complexity.DomainDependency.ObjectRelationshipCount == nil {
+			break
+		}
+
+		return e.complexity.DomainDependency.ObjectRelationshipCount(childComplexity), true
+
+	case "DomainDependency.relationship":
+		if e.complexity.DomainDependency.Relationship == nil {
+			break
+		}
+
+		return e.complexity.DomainDependency.Relationship(childComplexity), true
+
+	case "DomainDependency.relationshipSchemaNodeId":
+		if e.complexity.DomainDependency.RelationshipSchemaNodeID == nil {
+			break
+		}
+
+		return e.complexity.DomainDependency.RelationshipSchemaNodeID(childComplexity), true
+
 	case "DomainSchemaNode.domain":
 		if e.complexity.DomainSchemaNode.Domain == nil {
 			break
@@ -423,6 +693,76 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.DomainSchemaNodesResponse.Success(childComplexity), true
 
+	case "Index.entityType":
+		if e.complexity.Index.EntityType == nil {
+			break
+		}
+
+		return e.complexity.Index.EntityType(childComplexity), true
+
+	case "Index.labelsOrTypes":
+		if e.complexity.Index.LabelsOrTypes == nil {
+			break
+		}
+
+		return e.complexity.Index.LabelsOrTypes(childComplexity), true
+
+	case "Index.name":
+		if e.complexity.Index.Name == nil {
+			break
+		}
+
+		return e.complexity.Index.Name(childComplexity), true
+
+	case "Index.owningConstraint":
+		if e.complexity.Index.OwningConstraint == nil {
+			break
+		}
+
+		return e.complexity.Index.OwningConstraint(childComplexity), true
+
+	case "Index.properties":
+		if e.complexity.Index.Properties == nil {
+			break
+		}
+
+		return e.complexity.Index.Properties(childComplexity), true
+
+	case "Index.state":
+		if e.complexity.Index.State == nil {
+			break
+		}
+
+		return e.complexity.Index.State(childComplexity), true
+
+	case "Index.type":
+		if e.complexity.Index.Type == nil {
+			break
+		}
+
+		return e.complexity.Index.Type(childComplexity), true
+
+	case "IndexesResponse.indexes":
+		if e.complexity.IndexesResponse.Indexes == nil {
+			break
+		}
+
+		return e.complexity.IndexesResponse.Indexes(childComplexity), true
+
+	case "IndexesResponse.message":
+		if e.complexity.IndexesResponse.Message == nil {
+			break
+		}
+
+		return e.complexity.IndexesResponse.Message(childComplexity), true
+
+	case "IndexesResponse.success":
+		if e.complexity.IndexesResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.IndexesResponse.Success(childComplexity), true
+
 	case "Mutation.addLabelsOnObjectNode":
 		if e.complexity.Mutation.AddLabelsOnObjectNode == nil {
 			break
@@ -435,6 +775,18 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.AddLabelsOnObjectNode(childComplexity, args["id"].(string), args["labels"].([]string)), true
 
+	case "Mutation.applyDomainSchema":
+		if e.complexity.Mutation.ApplyDomainSchema == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_applyDomainSchema_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ApplyDomainSchema(childComplexity, args["document"].(string), args["dryRun"].(*bool)), true
+
 	case "Mutation.createDomainSchemaNode":
 		if e.complexity.Mutation.CreateDomainSchemaNode == nil {
 			break
@@ -505,7 +857,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.DeleteDomainSchemaNode(childComplexity, args["id"].(string)), true
+		return e.complexity.Mutation.DeleteDomainSchemaNode(childComplexity, args["id"].(string), args["crossDomain"].(*model.CrossDomainRule)), true
 
 	case "Mutation.deleteObjectNode":
 		if e.complexity.Mutation.DeleteObjectNode == nil {
@@ -555,6 +907,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.DeleteTypeSchemaNode(childComplexity, args["id"].(string)), true
 
+	case "Mutation.rebuildConstraints":
+		if e.complexity.Mutation.RebuildConstraints == nil {
+			break
+		}
+
+		return e.complexity.Mutation.RebuildConstraints(childComplexity), true
+
 	case "Mutation.removeLabelsFromObjectNode":
 		if e.complexity.Mutation.RemoveLabelsFromObjectNode == nil {
 			break
@@ -601,7 +960,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.RemovePropertiesFromRelationshipSchemaNode(childComplexity, args["id"].(string), args["properties"].([]string)), true
+		return e.complexity.Mutation.RemovePropertiesFromRelationshipSchemaNode(childComplexity, args["id"].(string), args["properties"].([]string), args["propagate"].(*bool)), true
 
 	case "Mutation.removePropertiesFromTypeSchemaNode":
 		if e.complexity.Mutation.RemovePropertiesFromTypeSchemaNode == nil {
@@ -613,7 +972,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.RemovePropertiesFromTypeSchemaNode(childComplexity, args["id"].(string), args["properties"].([]string)), true
+		return e.complexity.Mutation.RemovePropertiesFromTypeSchemaNode(childComplexity, args["id"].(string), args["properties"].([]string), args["propagate"].(*bool)), true
 
 	case "Mutation.renameDomainSchemaNode":
 		if e.complexity.Mutation.RenameDomainSchemaNode == nil {
@@ -649,7 +1008,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.RenamePropertyOnRelationshipSchemaNode(childComplexity, args["id"].(string), args["oldPropertyName"].(string), args["newPropertyName"].(string)), true
+		return e.complexity.Mutation.RenamePropertyOnRelationshipSchemaNode(childComplexity, args["id"].(string), args["oldPropertyName"].(string), args["newPropertyName"].(string), args["propagate"].(*bool)), true
 
 	case "Mutation.renamePropertyOnTypeSchemaNode":
 		if e.complexity.Mutation.RenamePropertyOnTypeSchemaNode == nil {
@@ -661,7 +1020,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Mutation.RenamePropertyOnTypeSchemaNode(childComplexity, args["id"].(string), args["oldPropertyName"].(string), args["newPropertyName"].(string)), true
+		return e.complexity.Mutation.RenamePropertyOnTypeSchemaNode(childComplexity, args["id"].(string), args["oldPropertyName"].(string), args["newPropertyName"].(string), args["propagate"].(*bool)), true
 
 	case "Mutation.renameRelationshipSchemaNode":
 		if e.complexity.Mutation.RenameRelationshipSchemaNode == nil {
@@ -687,6 +1046,66 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Mutation.RenameTypeSchemaNode(childComplexity, args["id"].(string), args["newName"].(string)), true
 
+	case "Mutation.setCardinalityOnRelationshipSchemaNode":
+		if e.complexity.Mutation.SetCardinalityOnRelationshipSchemaNode == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setCardinalityOnRelationshipSchemaNode_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetCardinalityOnRelationshipSchemaNode(childComplexity, args["id"].(string), args["cardinality"].(*model.RelationshipCardinalityInput)), true
+
+	case "Mutation.setComputedPropertyOnTypeSchemaNode":
+		if e.complexity.Mutation.SetComputedPropertyOnTypeSchemaNode == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setComputedPropertyOnTypeSchemaNode_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetComputedPropertyOnTypeSchemaNode(childComplexity, args["id"].(string), args["property"].(string), args["expression"].(*string)), true
+
+	case "Mutation.setJsonSchemaOnTypeSchemaNode":
+		if e.complexity.Mutation.SetJSONSchemaOnTypeSchemaNode == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setJsonSchemaOnTypeSchemaNode_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetJSONSchemaOnTypeSchemaNode(childComplexity, args["id"].(string), args["property"].(string), args["schema"].(*string)), true
+
+	case "Mutation.setParentOnTypeSchemaNode":
+		if e.complexity.Mutation.SetParentOnTypeSchemaNode == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setParentOnTypeSchemaNode_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetParentOnTypeSchemaNode(childComplexity, args["id"].(string), args["parentTypeSchemaNodeId"].(*string)), true
+
+	case "Mutation.setPropertyConstraintOnTypeSchemaNode":
+		if e.complexity.Mutation.SetPropertyConstraintOnTypeSchemaNode == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setPropertyConstraintOnTypeSchemaNode_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetPropertyConstraintOnTypeSchemaNode(childComplexity, args["id"].(string), args["property"].(string), args["constraint"].(*model.PropertyConstraintInput)), true
+
 	case "Mutation.updatePropertiesOnObjectNode":
 		if e.complexity.Mutation.UpdatePropertiesOnObjectNode == nil {
 			break
@@ -1008,6 +1427,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Property.Key(childComplexity), true
 
+	case "Property.readOnly":
+		if e.complexity.Property.ReadOnly == nil {
+			break
+		}
+
+		return e.complexity.Property.ReadOnly(childComplexity), true
+
 	case "Property.type":
 		if e.complexity.Property.Type == nil {
 			break
@@ -1022,63 +1448,164 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Property.Value(childComplexity), true
 
-	case "Query.getDomainSchemaNode":
-		if e.complexity.Query.GetDomainSchemaNode == nil {
+	case "PropertyConstraint.allowedValues":
+		if e.complexity.PropertyConstraint.AllowedValues == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getDomainSchemaNode_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.PropertyConstraint.AllowedValues(childComplexity), true
+
+	case "PropertyConstraint.defaultValue":
+		if e.complexity.PropertyConstraint.DefaultValue == nil {
+			break
 		}
 
-		return e.complexity.Query.GetDomainSchemaNode(childComplexity, args["id"].(string)), true
+		return e.complexity.PropertyConstraint.DefaultValue(childComplexity), true
 
-	case "Query.getDomainSchemaNodes":
-		if e.complexity.Query.GetDomainSchemaNodes == nil {
+	case "PropertyConstraint.key":
+		if e.complexity.PropertyConstraint.Key == nil {
 			break
 		}
 
-		return e.complexity.Query.GetDomainSchemaNodes(childComplexity), true
+		return e.complexity.PropertyConstraint.Key(childComplexity), true
 
-	case "Query.getObjectNode":
-		if e.complexity.Query.GetObjectNode == nil {
+	case "PropertyConstraint.max":
+		if e.complexity.PropertyConstraint.Max == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getObjectNode_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.PropertyConstraint.Max(childComplexity), true
+
+	case "PropertyConstraint.maxLength":
+		if e.complexity.PropertyConstraint.MaxLength == nil {
+			break
 		}
 
-		return e.complexity.Query.GetObjectNode(childComplexity, args["id"].(string)), true
+		return e.complexity.PropertyConstraint.MaxLength(childComplexity), true
 
-	case "Query.getObjectNodeIncomingRelationships":
-		if e.complexity.Query.GetObjectNodeIncomingRelationships == nil {
+	case "PropertyConstraint.min":
+		if e.complexity.PropertyConstraint.Min == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getObjectNodeIncomingRelationships_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.PropertyConstraint.Min(childComplexity), true
+
+	case "PropertyConstraint.minLength":
+		if e.complexity.PropertyConstraint.MinLength == nil {
+			break
 		}
 
-		return e.complexity.Query.GetObjectNodeIncomingRelationships(childComplexity, args["toObjectNodeId"].(string)), true
+		return e.complexity.PropertyConstraint.MinLength(childComplexity), true
 
-	case "Query.getObjectNodeOutgoingRelationships":
-		if e.complexity.Query.GetObjectNodeOutgoingRelationships == nil {
+	case "PropertyConstraint.pattern":
+		if e.complexity.PropertyConstraint.Pattern == nil {
 			break
 		}
 
-		args, err := ec.field_Query_getObjectNodeOutgoingRelationships_args(context.TODO(), rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.PropertyConstraint.Pattern(childComplexity), true
+
+	case "PropertyConstraint.unique":
+		if e.complexity.PropertyConstraint.Unique == nil {
+			break
 		}
 
-		return e.complexity.Query.GetObjectNodeOutgoingRelationships(childComplexity, args["fromObjectNodeId"].(string)), true
+		return e.complexity.PropertyConstraint.Unique(childComplexity), true
 
-	case "Query.getObjectNodeRelationship":
-		if e.complexity.Query.GetObjectNodeRelationship == nil {
+	case "PropertyJsonSchema.key":
+		if e.complexity.PropertyJsonSchema.Key == nil {
+			break
+		}
+
+		return e.complexity.PropertyJsonSchema.Key(childComplexity), true
+
+	case "PropertyJsonSchema.schema":
+		if e.complexity.PropertyJsonSchema.Schema == nil {
+			break
+		}
+
+		return e.complexity.PropertyJsonSchema.Schema(childComplexity), true
+
+	case "Query.exportSchemaDiagram":
+		if e.complexity.Query.ExportSchemaDiagram == nil {
+			break
+		}
+
+		args, err := ec.field_Query_exportSchemaDiagram_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ExportSchemaDiagram(childComplexity, args["domain"].(string), args["format"].(model.SchemaDiagramFormat), args["includeCounts"].(*bool)), true
+
+	case "Query.getDomainExternalDependencies":
+		if e.complexity.Query.GetDomainExternalDependencies == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getDomainExternalDependencies_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetDomainExternalDependencies(childComplexity, args["domain"].(string)), true
+
+	case "Query.getDomainSchemaNode":
+		if e.complexity.Query.GetDomainSchemaNode == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getDomainSchemaNode_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetDomainSchemaNode(childComplexity, args["id"].(string)), true
+
+	case "Query.getDomainSchemaNodes":
+		if e.complexity.Query.GetDomainSchemaNodes == nil {
+			break
+		}
+
+		return e.complexity.Query.GetDomainSchemaNodes(childComplexity), true
+
+	case "Query.getObjectNode":
+		if e.complexity.Query.GetObjectNode == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getObjectNode_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetObjectNode(childComplexity, args["id"].(string)), true
+
+	case "Query.getObjectNodeIncomingRelationships":
+		if e.complexity.Query.GetObjectNodeIncomingRelationships == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getObjectNodeIncomingRelationships_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetObjectNodeIncomingRelationships(childComplexity, args["toObjectNodeId"].(string)), true
+
+	case "Query.getObjectNodeOutgoingRelationships":
+		if e.complexity.Query.GetObjectNodeOutgoingRelationships == nil {
+			break
+		}
+
+		args, err := ec.field_Query_getObjectNodeOutgoingRelationships_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.GetObjectNodeOutgoingRelationships(childComplexity, args["fromObjectNodeId"].(string)), true
+
+	case "Query.getObjectNodeRelationship":
+		if e.complexity.Query.GetObjectNodeRelationship == nil {
 			break
 		}
 
@@ -1099,7 +1626,7 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 			return 0, false
 		}
 
-		return e.complexity.Query.GetObjectNodes(childComplexity, args["domain"].(*string), args["type"].(*string)), true
+		return e.complexity.Query.GetObjectNodes(childComplexity, args["domain"].(*string), args["type"].(*string), args["where"].([]*model.PropertyFilter), args["orderBy"].([]*model.PropertySort)), true
 
 	case "Query.getRelationshipSchemaNode":
 		if e.complexity.Query.GetRelationshipSchemaNode == nil {
@@ -1173,6 +1700,89 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Query.GetTypeSchemaNodes(childComplexity, args["domain"].(*string)), true
 
+	case "Query.indexes":
+		if e.complexity.Query.Indexes == nil {
+			break
+		}
+
+		return e.complexity.Query.Indexes(childComplexity), true
+
+	case "Query.objectNodesInBoundingBox":
+		if e.complexity.Query.ObjectNodesInBoundingBox == nil {
+			break
+		}
+
+		args, err := ec.field_Query_objectNodesInBoundingBox_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ObjectNodesInBoundingBox(childComplexity, args["domain"].(string), args["type"].(string), args["property"].(string), args["lowerLeft"].(model.PointInput), args["upperRight"].(model.PointInput)), true
+
+	case "Query.objectNodesWithinDistance":
+		if e.complexity.Query.ObjectNodesWithinDistance == nil {
+			break
+		}
+
+		args, err := ec.field_Query_objectNodesWithinDistance_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ObjectNodesWithinDistance(childComplexity, args["domain"].(string), args["type"].(string), args["property"].(string), args["center"].(model.PointInput), args["meters"].(float64)), true
+
+	case "Query.relationshipCardinalityReport":
+		if e.complexity.Query.RelationshipCardinalityReport == nil {
+			break
+		}
+
+		args, err := ec.field_Query_relationshipCardinalityReport_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.RelationshipCardinalityReport(childComplexity, args["domain"].(string)), true
+
+	case "Query.schemaDiff":
+		if e.complexity.Query.SchemaDiff == nil {
+			break
+		}
+
+		args, err := ec.field_Query_schemaDiff_args(context.TODO(), rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SchemaDiff(childComplexity, args["domain"].(string), args["against"].(model.SchemaDiffAgainst)), true
+
+	case "RelationshipCardinality.cardinality":
+		if e.complexity.RelationshipCardinality.Cardinality == nil {
+			break
+		}
+
+		return e.complexity.RelationshipCardinality.Cardinality(childComplexity), true
+
+	case "RelationshipCardinality.fromRequired":
+		if e.complexity.RelationshipCardinality.FromRequired == nil {
+			break
+		}
+
+		return e.complexity.RelationshipCardinality.FromRequired(childComplexity), true
+
+	case "RelationshipCardinality.toRequired":
+		if e.complexity.RelationshipCardinality.ToRequired == nil {
+			break
+		}
+
+		return e.complexity.RelationshipCardinality.ToRequired(childComplexity), true
+
+	case "RelationshipSchemaNode.cardinality":
+		if e.complexity.RelationshipSchemaNode.Cardinality == nil {
+			break
+		}
+
+		return e.complexity.RelationshipSchemaNode.Cardinality(childComplexity), true
+
 	case "RelationshipSchemaNode.domain":
 		if e.complexity.RelationshipSchemaNode.Domain == nil {
 			break
@@ -1299,6 +1909,132 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Response.Success(childComplexity), true
 
+	case "SchemaChange.action":
+		if e.complexity.SchemaChange.Action == nil {
+			break
+		}
+
+		return e.complexity.SchemaChange.Action(childComplexity), true
+
+	case "SchemaChange.affectedObjectNodes":
+		if e.complexity.SchemaChange.AffectedObjectNodes == nil {
+			break
+		}
+
+		return e.complexity.SchemaChange.AffectedObjectNodes(childComplexity), true
+
+	case "SchemaChange.affectedObjectRelationships":
+		if e.complexity.SchemaChange.AffectedObjectRelationships == nil {
+			break
+		}
+
+		return e.complexity.SchemaChange.AffectedObjectRelationships(childComplexity), true
+
+	case "SchemaChange.compatibility":
+		if e.complexity.SchemaChange.Compatibility == nil {
+			break
+		}
+
+		return e.complexity.SchemaChange.Compatibility(childComplexity), true
+
+	case "SchemaChange.description":
+		if e.complexity.SchemaChange.Description == nil {
+			break
+		}
+
+		return e.complexity.SchemaChange.Description(childComplexity), true
+
+	case "SchemaChange.kind":
+		if e.complexity.SchemaChange.Kind == nil {
+			break
+		}
+
+		return e.complexity.SchemaChange.Kind(childComplexity), true
+
+	case "SchemaChange.target":
+		if e.complexity.SchemaChange.Target == nil {
+			break
+		}
+
+		return e.complexity.SchemaChange.Target(childComplexity), true
+
+	case "SchemaDiagramResponse.diagram":
+		if e.complexity.SchemaDiagramResponse.Diagram == nil {
+			break
+		}
+
+		return e.complexity.SchemaDiagramResponse.Diagram(childComplexity), true
+
+	case "SchemaDiagramResponse.format":
+		if e.complexity.SchemaDiagramResponse.Format == nil {
+			break
+		}
+
+		return e.complexity.SchemaDiagramResponse.Format(childComplexity), true
+
+	case "SchemaDiagramResponse.message":
+		if e.complexity.SchemaDiagramResponse.Message == nil {
+			break
+		}
+
+		return e.complexity.SchemaDiagramResponse.Message(childComplexity), true
+
+	case "SchemaDiagramResponse.success":
+		if e.complexity.SchemaDiagramResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.SchemaDiagramResponse.Success(childComplexity), true
+
+	case "SchemaDiffResponse.changes":
+		if e.complexity.SchemaDiffResponse.Changes == nil {
+			break
+		}
+
+		return e.complexity.SchemaDiffResponse.Changes(childComplexity), true
+
+	case "SchemaDiffResponse.message":
+		if e.complexity.SchemaDiffResponse.Message == nil {
+			break
+		}
+
+		return e.complexity.SchemaDiffResponse.Message(childComplexity), true
+
+	case "SchemaDiffResponse.success":
+		if e.complexity.SchemaDiffResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.SchemaDiffResponse.Success(childComplexity), true
+
+	case "SchemaPlanResponse.changes":
+		if e.complexity.SchemaPlanResponse.Changes == nil {
+			break
+		}
+
+		return e.complexity.SchemaPlanResponse.Changes(childComplexity), true
+
+	case "SchemaPlanResponse.dryRun":
+		if e.complexity.SchemaPlanResponse.DryRun == nil {
+			break
+		}
+
+		return e.complexity.SchemaPlanResponse.DryRun(childComplexity), true
+
+	case "SchemaPlanResponse.message":
+		if e.complexity.SchemaPlanResponse.Message == nil {
+			break
+		}
+
+		return e.complexity.SchemaPlanResponse.Message(childComplexity), true
+
+	case "SchemaPlanResponse.success":
+		if e.complexity.SchemaPlanResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.SchemaPlanResponse.Success(childComplexity), true
+
 	case "Subscription.domainSchemaNodeCreated":
 		if e.complexity.Subscription.DomainSchemaNodeCreated == nil {
 			break
@@ -1404,6 +2140,20 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.Subscription.TypeSchemaNodeUpdated(childComplexity), true
 
+	case "TypeSchemaNode.computedProperties":
+		if e.complexity.TypeSchemaNode.ComputedProperties == nil {
+			break
+		}
+
+		return e.complexity.TypeSchemaNode.ComputedProperties(childComplexity), true
+
+	case "TypeSchemaNode.constraints":
+		if e.complexity.TypeSchemaNode.Constraints == nil {
+			break
+		}
+
+		return e.complexity.TypeSchemaNode.Constraints(childComplexity), true
+
 	case "TypeSchemaNode.domain":
 		if e.complexity.TypeSchemaNode.Domain == nil {
 			break
@@ -1418,6 +2168,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TypeSchemaNode.ID(childComplexity), true
 
+	case "TypeSchemaNode.jsonSchemas":
+		if e.complexity.TypeSchemaNode.JSONSchemas == nil {
+			break
+		}
+
+		return e.complexity.TypeSchemaNode.JSONSchemas(childComplexity), true
+
 	case "TypeSchemaNode.labels":
 		if e.complexity.TypeSchemaNode.Labels == nil {
 			break
@@ -1439,6 +2196,13 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 
 		return e.complexity.TypeSchemaNode.OriginalName(childComplexity), true
 
+	case "TypeSchemaNode.parentTypeSchemaNodeId":
+		if e.complexity.TypeSchemaNode.ParentTypeSchemaNodeID == nil {
+			break
+		}
+
+		return e.complexity.TypeSchemaNode.ParentTypeSchemaNodeID(childComplexity), true
+
 	case "TypeSchemaNode.properties":
 		if e.complexity.TypeSchemaNode.Properties == nil {
 			break
@@ -1500,17 +2264,23 @@ func (e *executableSchema) Complexity(typeName, field string, childComplexity in
 }
 
 func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
-	rc := graphql.GetOperationContext(ctx)
-	ec := executionContext{rc, e, 0, 0, make(chan graphql.DeferredResult)}
+	opCtx := graphql.GetOperationContext(ctx)
+	ec := executionContext{opCtx, e, 0, 0, make(chan graphql.DeferredResult)}
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
 		ec.unmarshalInputDeleteObjectNodeInput,
 		ec.unmarshalInputObjectNodeInput,
+		ec.unmarshalInputPointInput,
+		ec.unmarshalInputPropertyConstraintInput,
+		ec.unmarshalInputPropertyFilter,
 		ec.unmarshalInputPropertyInput,
+		ec.unmarshalInputPropertySort,
+		ec.unmarshalInputRelationshipCardinalityInput,
+		ec.unmarshalInputSchemaDiffAgainst,
 		ec.unmarshalInputUpdateObjectNodeInput,
 	)
 	first := true
 
-	switch rc.Operation.Operation {
+	switch opCtx.Operation.Operation {
 	case ast.Query:
 		return func(ctx context.Context) *graphql.Response {
 			var response graphql.Response
@@ -1518,7 +2288,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			if first {
 				first = false
 				ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-				data = ec._Query(ctx, rc.Operation.SelectionSet)
+				data = ec._Query(ctx, opCtx.Operation.SelectionSet)
 			} else {
 				if atomic.LoadInt32(&ec.pendingDeferred) > 0 {
 					result := <-ec.deferredResults
@@ -1548,7 +2318,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			}
 			first = false
 			ctx = graphql.WithUnmarshalerMap(ctx, inputUnmarshalMap)
-			data := ec._Mutation(ctx, rc.Operation.SelectionSet)
+			data := ec._Mutation(ctx, opCtx.Operation.SelectionSet)
 			var buf bytes.Buffer
 			data.MarshalGQL(&buf)
 
@@ -1557,7 +2327,7 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 			}
 		}
 	case ast.Subscription:
-		next := ec._Subscription(ctx, rc.Operation.SelectionSet)
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
 
 		var buf bytes.Buffer
 		return func(ctx context.Context) *graphql.Response {
@@ -1621,6 +2391,43 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 }
 
 var sources = []*ast.Source{
+	{Name: "../schema/domainDependencies.graphql", Input: `"""
+What deleting a domain does to the relationship schema nodes and object relationships connecting it to other
+domains. Renaming a domain keeps them, they refer to type schema nodes and object nodes by id.
+"""
+enum CrossDomainRule {
+  "Refuse to delete a domain with external dependencies"
+  RESTRICT
+  """
+  Delete the relationship schema nodes and object relationships connecting the domain to other domains with it,
+  and clear the RELATIONSHIP properties of object nodes of other domains referencing its object nodes
+  """
+  CASCADE
+}
+
+enum DependencyDirection {
+  "The domain points at the other domain"
+  OUTGOING
+  "The other domain points at the domain"
+  INCOMING
+}
+
+"Object relationships of one name connecting a domain to another domain, and the relationship schema node describing them"
+type DomainDependency {
+  direction: DependencyDirection!
+  domain: String!
+  relationship: String!
+  "Null for object relationships no cross-domain relationship schema node describes"
+  relationshipSchemaNodeId: String
+  objectRelationshipCount: Int!
+}
+
+type DomainDependenciesResponse {
+  success: Boolean!
+  message: String
+  dependencies: [DomainDependency!]
+}
+`, BuiltIn: false},
 	{Name: "../schema/domainSchemaNode.graphql", Input: `type DomainSchemaNode {
   id: String!
   domain: String!
@@ -1629,6 +2436,16 @@ var sources = []*ast.Source{
   labels: [String!]
   properties: [Property!]
 }`, BuiltIn: false},
+	{Name: "../schema/index.graphql", Input: `type Index {
+  name: String!
+  type: String!
+  entityType: String!
+  labelsOrTypes: [String!]
+  properties: [String!]
+  state: String!
+  owningConstraint: String
+}
+`, BuiltIn: false},
 	{Name: "../schema/mutations.graphql", Input: `type Mutation {
   # Object Mutations
   createObjectNode(domain: String!, name: String!, type: String!, labels: [String!], properties: [PropertyInput!]): ObjectNodeResponse!
@@ -1656,15 +2473,24 @@ var sources = []*ast.Source{
 
   createDomainSchemaNode(domain: String!): DomainSchemaNodeResponse!
   renameDomainSchemaNode(id: String!, newName: String!): DomainSchemaNodeResponse!
-  deleteDomainSchemaNode(id: String!): DomainSchemaNodeResponse!
+  deleteDomainSchemaNode(id: String!, crossDomain: CrossDomainRule = RESTRICT): DomainSchemaNodeResponse!
 
   createTypeSchemaNode(domain: String!, name: String!): TypeSchemaNodeResponse!
   renameTypeSchemaNode(id: String!, newName: String!): TypeSchemaNodeResponse!
   updatePropertiesOnTypeSchemaNode(id: String!, properties: [PropertyInput!]!): TypeSchemaNodeResponse!
-  renamePropertyOnTypeSchemaNode(id: String!, oldPropertyName: String!, newPropertyName: String!): TypeSchemaNodeResponse!
-  removePropertiesFromTypeSchemaNode(id: String!, properties: [String!]!): TypeSchemaNodeResponse!
+  renamePropertyOnTypeSchemaNode(id: String!, oldPropertyName: String!, newPropertyName: String!, propagate: Boolean = true): TypeSchemaNodeResponse!
+  removePropertiesFromTypeSchemaNode(id: String!, properties: [String!]!, propagate: Boolean = true): TypeSchemaNodeResponse!
+  "Sets the JSON Schema object node values of a JSON property must match, a null schema removes it"
+  setJsonSchemaOnTypeSchemaNode(id: String!, property: String!, schema: String): TypeSchemaNodeResponse!
+  "Sets the constraints object node values of a property must satisfy, a null constraint removes them"
+  setPropertyConstraintOnTypeSchemaNode(id: String!, property: String!, constraint: PropertyConstraintInput): TypeSchemaNodeResponse!
+  "Sets the expression of a computed property of the object nodes of the type, a null expression removes it"
+  setComputedPropertyOnTypeSchemaNode(id: String!, property: String!, expression: String): TypeSchemaNodeResponse!
+  "Sets the type schema node of the same domain the type schema node extends, a null parent removes it"
+  setParentOnTypeSchemaNode(id: String!, parentTypeSchemaNodeId: String): TypeSchemaNodeResponse!
   deleteTypeSchemaNode(id: String!): TypeSchemaNodeResponse!
 
+  "The relationship schema node belongs to domain, the domain of its from or to type schema node, the other one may be in another domain"
   createRelationshipSchemaNode(
     name: String!
     domain: String!
@@ -1673,10 +2499,17 @@ var sources = []*ast.Source{
   ): RelationshipSchemaNodeResponse!
   renameRelationshipSchemaNode(id: String!, newName: String!): RelationshipSchemaNodeResponse!
   updatePropertiesOnRelationshipSchemaNode(id: String!, properties: [PropertyInput!]!): RelationshipSchemaNodeResponse!
-  renamePropertyOnRelationshipSchemaNode(id: String!, oldPropertyName: String!, newPropertyName: String!): RelationshipSchemaNodeResponse!
-  removePropertiesFromRelationshipSchemaNode(id: String!, properties: [String!]!): RelationshipSchemaNodeResponse!
+  renamePropertyOnRelationshipSchemaNode(id: String!, oldPropertyName: String!, newPropertyName: String!, propagate: Boolean = true): RelationshipSchemaNodeResponse!
+  removePropertiesFromRelationshipSchemaNode(id: String!, properties: [String!]!, propagate: Boolean = true): RelationshipSchemaNodeResponse!
+  "Sets the cardinality object relationships of the relationship schema must keep, a null cardinality removes it"
+  setCardinalityOnRelationshipSchemaNode(id: String!, cardinality: RelationshipCardinalityInput): RelationshipSchemaNodeResponse!
   deleteRelationshipSchemaNode(id: String!): RelationshipSchemaNodeResponse!
 
+  applyDomainSchema(document: String!, dryRun: Boolean = false): SchemaPlanResponse!
+
+  # Admin Mutations
+  rebuildConstraints: IndexesResponse!
+
 }
 `, BuiltIn: false},
 	{Name: "../schema/objectNode.graphql", Input: `type ObjectNode {
@@ -1729,30 +2562,96 @@ input DeleteObjectNodeInput {
 
 enum PropertyType {
   STRING
+  "Input only, resolves to INTEGER or FLOAT from the value"
   NUMBER
+  "A 64-bit integer"
+  INTEGER
+  "A 64-bit float, whole numbers included"
+  FLOAT
   BOOLEAN
   ARRAY_STRING
+  "Input only, resolves to ARRAY_INTEGER when every element is an integer and ARRAY_FLOAT otherwise"
   ARRAY_NUMBER
+  ARRAY_INTEGER
+  ARRAY_FLOAT
   ARRAY_BOOLEAN
   RELATIONSHIP
+  "ISO 8601 date, YYYY-MM-DD"
+  DATE
+  "ISO 8601 date and time with a UTC offset, YYYY-MM-DDThh:mm:ss±hh:mm"
+  DATETIME
+  "ISO 8601 date and time without a time zone, YYYY-MM-DDThh:mm:ss"
+  LOCAL_DATETIME
+  "ISO 8601 duration, P[nY][nM][nW][nD][T[nH][nM][nS]]"
+  DURATION
+  "A WGS-84 point, {latitude, longitude}, or a Cartesian point, {x, y}"
+  POINT
+  "Any JSON value, stored serialized and validated against the JSON Schema of the property on its type schema node, if any"
+  JSON
 }
 
 type Property {
   key: String!
   value: Any!
   type: PropertyType!
+  "Computed properties are read-only, they cannot be written on object nodes"
+  readOnly: Boolean!
 }
 
 input PropertyInput {
   key: String!
+  "null removes the property"
+  value: Any
+  type: PropertyType!
+}
+
+"Either latitude and longitude, a WGS-84 point, or x and y, a Cartesian point"
+input PointInput {
+  latitude: Float
+  longitude: Float
+  x: Float
+  y: Float
+}
+`, BuiltIn: false},
+	{Name: "../schema/propertyFilter.graphql", Input: `enum FilterOperator {
+  EQ
+  NEQ
+  LT
+  LTE
+  GT
+  GTE
+}
+
+enum SortDirection {
+  ASC
+  DESC
+}
+
+"""
+A comparison of a property with a value, the value is read as type so temporal values compare natively.
+On JSON properties path, such as $.address.city or $.tags[0], selects the value inside the property to compare.
+"""
+input PropertyFilter {
+  key: String!
+  operator: FilterOperator!
   value: Any!
   type: PropertyType!
+  path: String
+}
+
+input PropertySort {
+  key: String!
+  direction: SortDirection = ASC
 }
 `, BuiltIn: false},
 	{Name: "../schema/queries.graphql", Input: `type Query {
   # Object Queries
   getObjectNode(id: String!): ObjectNodeResponse!
-  getObjectNodes(domain: String, type: String): ObjectNodesResponse!
+  getObjectNodes(domain: String, type: String, where: [PropertyFilter!], orderBy: [PropertySort!]): ObjectNodesResponse!
+  "Object nodes whose POINT property is within meters of center, nearest first. Cartesian distances are in coordinate units."
+  objectNodesWithinDistance(domain: String!, type: String!, property: String!, center: PointInput!, meters: Float!): ObjectNodesResponse!
+  "Object nodes whose POINT property lies in the box between the lowerLeft and upperRight corners"
+  objectNodesInBoundingBox(domain: String!, type: String!, property: String!, lowerLeft: PointInput!, upperRight: PointInput!): ObjectNodesResponse!
 
   getObjectNodeRelationship(id: String!): ObjectRelationshipResponse!
   getObjectNodeOutgoingRelationships(fromObjectNodeId: String!): ObjectRelationshipsResponse!
@@ -1760,6 +2659,8 @@ input PropertyInput {
 
   getDomainSchemaNode(id: String!): DomainSchemaNodeResponse!
   getDomainSchemaNodes: DomainSchemaNodesResponse!
+  "The relationship schema nodes and object relationships connecting domain to other domains"
+  getDomainExternalDependencies(domain: String!): DomainDependenciesResponse!
 
   getTypeSchemaNode(id: String!): TypeSchemaNodeResponse!
   getTypeSchemaNodes(domain: String): TypeSchemaNodesResponse!
@@ -1768,10 +2669,68 @@ input PropertyInput {
 
   getRelationshipSchemaNode(id: String!): RelationshipSchemaNodeResponse!
   getRelationshipSchemaNodes(domain: String): RelationshipSchemaNodesResponse!
+  "The object nodes of domain breaking the cardinality of a relationship schema"
+  relationshipCardinalityReport(domain: String!): CardinalityReportResponse!
+
+  schemaDiff(domain: String!, against: SchemaDiffAgainst!): SchemaDiffResponse!
+  exportSchemaDiagram(domain: String!, format: SchemaDiagramFormat!, includeCounts: Boolean = false): SchemaDiagramResponse!
+
+  # Admin Queries
+  indexes: IndexesResponse!
 
 }
 
 union ObjectNodeOrRelationshipNode = ObjectNode | ObjectRelationship
+`, BuiltIn: false},
+	{Name: "../schema/relationshipCardinality.graphql", Input: `"""
+How many object relationships of a relationship schema an object node may have. The left side is the from
+type, the right side the to type: MANY_TO_ONE lets every from object node have one relationship to a to
+object node, while a to object node has any number of them.
+"""
+enum Cardinality {
+  ONE_TO_ONE
+  ONE_TO_MANY
+  MANY_TO_ONE
+  MANY_TO_MANY
+}
+
+"""
+The cardinality of the object relationships of a relationship schema. Required sides need at least one
+relationship per object node, which deleting the last one cannot break, but object nodes created without
+one are only reported by relationshipCardinalityReport.
+"""
+type RelationshipCardinality {
+  cardinality: Cardinality!
+  fromRequired: Boolean!
+  toRequired: Boolean!
+}
+
+input RelationshipCardinalityInput {
+  cardinality: Cardinality!
+  fromRequired: Boolean = false
+  toRequired: Boolean = false
+}
+
+enum RelationshipSide {
+  FROM
+  TO
+}
+
+"An object node with too few or too many object relationships of a relationship schema"
+type CardinalityViolation {
+  relationshipSchemaNodeId: String!
+  relationship: String!
+  objectNodeId: String!
+  side: RelationshipSide!
+  count: Int!
+  message: String!
+}
+
+type CardinalityReportResponse {
+  success: Boolean!
+  message: String
+  violations: [CardinalityViolation!]
+}
 `, BuiltIn: false},
 	{Name: "../schema/relationshipSchemaNode.graphql", Input: `type RelationshipSchemaNode {
   id: String!
@@ -1781,6 +2740,7 @@ union ObjectNodeOrRelationshipNode = ObjectNode | ObjectRelationship
   type: String!
   fromTypeSchemaNodeId: String!
   toTypeSchemaNodeId: String!
+  cardinality: RelationshipCardinality
   properties: [Property!]
   labels: [String!]
 }
@@ -1870,12 +2830,80 @@ type ObjectRelationshipObjectNodesResponse {
   message: String
   objectRelationshipObjectNodes: [ObjectRelationshipObjectNode!]
 }
-`, BuiltIn: false},
-	{Name: "../schema/schema.graphql", Input: `schema {
-  query: Query
+
+type IndexesResponse {
+  success: Boolean!
+  message: String
+  indexes: [Index!]
+}
+
+type SchemaPlanResponse {
+  success: Boolean!
+  message: String
+  dryRun: Boolean!
+  changes: [SchemaChange!]
+}
+
+type SchemaDiffResponse {
+  success: Boolean!
+  message: String
+  changes: [SchemaChange!]
+}
+
+type SchemaDiagramResponse {
+  success: Boolean!
+  message: String
+  format: SchemaDiagramFormat!
+  diagram: String
+}
+`, BuiltIn: false},
+	{Name: "../schema/schema.graphql", Input: `schema {
+  query: Query
   mutation: Mutation
   subscription: Subscription
 }
+`, BuiltIn: false},
+	{Name: "../schema/schemaChange.graphql", Input: `enum SchemaChangeAction {
+  CREATE
+  UPDATE
+  DELETE
+}
+
+enum SchemaChangeKind {
+  DOMAIN
+  TYPE
+  TYPE_PROPERTY
+  RELATIONSHIP
+  RELATIONSHIP_PROPERTY
+}
+
+enum SchemaChangeCompatibility {
+  ADDITIVE
+  BREAKING
+  DATA_MIGRATING
+}
+
+type SchemaChange {
+  action: SchemaChangeAction!
+  kind: SchemaChangeKind!
+  target: String!
+  description: String!
+  compatibility: SchemaChangeCompatibility!
+  affectedObjectNodes: Int!
+  affectedObjectRelationships: Int!
+}
+
+input SchemaDiffAgainst {
+  document: String
+  domain: String
+}
+`, BuiltIn: false},
+	{Name: "../schema/schemaDiagram.graphql", Input: `enum SchemaDiagramFormat {
+  MERMAID_ER
+  MERMAID_CLASS
+  DOT
+  PLANTUML
+}
 `, BuiltIn: false},
 	{Name: "../schema/subscriptions.graphql", Input: `type Subscription {
   objectNodeCreated: ObjectNodeResponse!
@@ -1907,6 +2935,58 @@ type ObjectRelationshipObjectNodesResponse {
   originalName: String!
   labels: [String!]
   properties: [Property!]
+  jsonSchemas: [PropertyJsonSchema!]
+  constraints: [PropertyConstraint!]
+  computedProperties: [ComputedProperty!]
+  "The type schema node of the same domain this one extends, inheriting its properties and relationship schemas"
+  parentTypeSchemaNodeId: String
+}
+
+"""
+A read-only property object nodes of the type get on read, the value of expression evaluated against their
+properties and object relationship counts, such as firstName + " " + lastName or outgoing("DEPENDS_ON")
+"""
+type ComputedProperty {
+  key: String!
+  expression: String!
+}
+
+"The JSON Schema values of a JSON property are validated against"
+type PropertyJsonSchema {
+  key: String!
+  schema: String!
+}
+
+"""
+The constraints object node values of a property are checked against when written. Bounds and lengths are
+inclusive, lengths count the characters of a STRING and the elements of an array, and allowed values, bounds
+and patterns apply to every element of an array.
+"""
+type PropertyConstraint {
+  key: String!
+  allowedValues: [Any!]
+  "Bounds of INTEGER and FLOAT values"
+  min: Float
+  max: Float
+  minLength: Int
+  maxLength: Int
+  "A regular expression STRING values must match in full"
+  pattern: String
+  "No two object nodes of the type in the domain have the same value, backed by a Neo4j constraint"
+  unique: Boolean!
+  "The value of the property on object nodes created without it"
+  defaultValue: Any
+}
+
+input PropertyConstraintInput {
+  allowedValues: [Any!]
+  min: Float
+  max: Float
+  minLength: Int
+  maxLength: Int
+  pattern: String
+  unique: Boolean = false
+  defaultValue: Any
 }
 `, BuiltIn: false},
 }
@@ -1957,6 +3037,47 @@ func (ec *executionContext) field_Mutation_addLabelsOnObjectNode_argsLabels(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_applyDomainSchema_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Mutation_applyDomainSchema_argsDocument(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["document"] = arg0
+	arg1, err := ec.field_Mutation_applyDomainSchema_argsDryRun(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["dryRun"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Mutation_applyDomainSchema_argsDocument(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("document"))
+	if tmp, ok := rawArgs["document"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_applyDomainSchema_argsDryRun(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*bool, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("dryRun"))
+	if tmp, ok := rawArgs["dryRun"]; ok {
+		return ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+	}
+
+	var zeroVal *bool
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_createDomainSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2278,6 +3399,11 @@ func (ec *executionContext) field_Mutation_deleteDomainSchemaNode_args(ctx conte
 		return nil, err
 	}
 	args["id"] = arg0
+	arg1, err := ec.field_Mutation_deleteDomainSchemaNode_argsCrossDomain(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["crossDomain"] = arg1
 	return args, nil
 }
 func (ec *executionContext) field_Mutation_deleteDomainSchemaNode_argsID(
@@ -2293,6 +3419,19 @@ func (ec *executionContext) field_Mutation_deleteDomainSchemaNode_argsID(
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_deleteDomainSchemaNode_argsCrossDomain(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*model.CrossDomainRule, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("crossDomain"))
+	if tmp, ok := rawArgs["crossDomain"]; ok {
+		return ec.unmarshalOCrossDomainRule2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐCrossDomainRule(ctx, tmp)
+	}
+
+	var zeroVal *model.CrossDomainRule
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_deleteObjectNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2521,6 +3660,11 @@ func (ec *executionContext) field_Mutation_removePropertiesFromRelationshipSchem
 		return nil, err
 	}
 	args["properties"] = arg1
+	arg2, err := ec.field_Mutation_removePropertiesFromRelationshipSchemaNode_argsPropagate(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["propagate"] = arg2
 	return args, nil
 }
 func (ec *executionContext) field_Mutation_removePropertiesFromRelationshipSchemaNode_argsID(
@@ -2549,6 +3693,19 @@ func (ec *executionContext) field_Mutation_removePropertiesFromRelationshipSchem
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_removePropertiesFromRelationshipSchemaNode_argsPropagate(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*bool, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("propagate"))
+	if tmp, ok := rawArgs["propagate"]; ok {
+		return ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+	}
+
+	var zeroVal *bool
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_removePropertiesFromTypeSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2562,6 +3719,11 @@ func (ec *executionContext) field_Mutation_removePropertiesFromTypeSchemaNode_ar
 		return nil, err
 	}
 	args["properties"] = arg1
+	arg2, err := ec.field_Mutation_removePropertiesFromTypeSchemaNode_argsPropagate(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["propagate"] = arg2
 	return args, nil
 }
 func (ec *executionContext) field_Mutation_removePropertiesFromTypeSchemaNode_argsID(
@@ -2590,6 +3752,19 @@ func (ec *executionContext) field_Mutation_removePropertiesFromTypeSchemaNode_ar
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_removePropertiesFromTypeSchemaNode_argsPropagate(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*bool, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("propagate"))
+	if tmp, ok := rawArgs["propagate"]; ok {
+		return ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+	}
+
+	var zeroVal *bool
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_renameDomainSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2690,6 +3865,11 @@ func (ec *executionContext) field_Mutation_renamePropertyOnRelationshipSchemaNod
 		return nil, err
 	}
 	args["newPropertyName"] = arg2
+	arg3, err := ec.field_Mutation_renamePropertyOnRelationshipSchemaNode_argsPropagate(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["propagate"] = arg3
 	return args, nil
 }
 func (ec *executionContext) field_Mutation_renamePropertyOnRelationshipSchemaNode_argsID(
@@ -2731,6 +3911,19 @@ func (ec *executionContext) field_Mutation_renamePropertyOnRelationshipSchemaNod
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_renamePropertyOnRelationshipSchemaNode_argsPropagate(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*bool, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("propagate"))
+	if tmp, ok := rawArgs["propagate"]; ok {
+		return ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+	}
+
+	var zeroVal *bool
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_renamePropertyOnTypeSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2749,6 +3942,11 @@ func (ec *executionContext) field_Mutation_renamePropertyOnTypeSchemaNode_args(c
 		return nil, err
 	}
 	args["newPropertyName"] = arg2
+	arg3, err := ec.field_Mutation_renamePropertyOnTypeSchemaNode_argsPropagate(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["propagate"] = arg3
 	return args, nil
 }
 func (ec *executionContext) field_Mutation_renamePropertyOnTypeSchemaNode_argsID(
@@ -2790,6 +3988,19 @@ func (ec *executionContext) field_Mutation_renamePropertyOnTypeSchemaNode_argsNe
 	return zeroVal, nil
 }
 
+func (ec *executionContext) field_Mutation_renamePropertyOnTypeSchemaNode_argsPropagate(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*bool, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("propagate"))
+	if tmp, ok := rawArgs["propagate"]; ok {
+		return ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+	}
+
+	var zeroVal *bool
+	return zeroVal, nil
+}
+
 func (ec *executionContext) field_Mutation_renameRelationshipSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
@@ -2872,22 +4083,22 @@ func (ec *executionContext) field_Mutation_renameTypeSchemaNode_argsNewName(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_updatePropertiesOnObjectNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_setCardinalityOnRelationshipSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Mutation_updatePropertiesOnObjectNode_argsID(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_setCardinalityOnRelationshipSchemaNode_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["id"] = arg0
-	arg1, err := ec.field_Mutation_updatePropertiesOnObjectNode_argsProperties(ctx, rawArgs)
+	arg1, err := ec.field_Mutation_setCardinalityOnRelationshipSchemaNode_argsCardinality(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["properties"] = arg1
+	args["cardinality"] = arg1
 	return args, nil
 }
-func (ec *executionContext) field_Mutation_updatePropertiesOnObjectNode_argsID(
+func (ec *executionContext) field_Mutation_setCardinalityOnRelationshipSchemaNode_argsID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (string, error) {
@@ -2900,35 +4111,40 @@ func (ec *executionContext) field_Mutation_updatePropertiesOnObjectNode_argsID(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_updatePropertiesOnObjectNode_argsProperties(
+func (ec *executionContext) field_Mutation_setCardinalityOnRelationshipSchemaNode_argsCardinality(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) ([]*model.PropertyInput, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("properties"))
-	if tmp, ok := rawArgs["properties"]; ok {
-		return ec.unmarshalNPropertyInput2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInputᚄ(ctx, tmp)
+) (*model.RelationshipCardinalityInput, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("cardinality"))
+	if tmp, ok := rawArgs["cardinality"]; ok {
+		return ec.unmarshalORelationshipCardinalityInput2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipCardinalityInput(ctx, tmp)
 	}
 
-	var zeroVal []*model.PropertyInput
+	var zeroVal *model.RelationshipCardinalityInput
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_updatePropertiesOnObjectRelationship_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_setComputedPropertyOnTypeSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Mutation_updatePropertiesOnObjectRelationship_argsID(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_setComputedPropertyOnTypeSchemaNode_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["id"] = arg0
-	arg1, err := ec.field_Mutation_updatePropertiesOnObjectRelationship_argsProperties(ctx, rawArgs)
+	arg1, err := ec.field_Mutation_setComputedPropertyOnTypeSchemaNode_argsProperty(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["properties"] = arg1
+	args["property"] = arg1
+	arg2, err := ec.field_Mutation_setComputedPropertyOnTypeSchemaNode_argsExpression(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["expression"] = arg2
 	return args, nil
 }
-func (ec *executionContext) field_Mutation_updatePropertiesOnObjectRelationship_argsID(
+func (ec *executionContext) field_Mutation_setComputedPropertyOnTypeSchemaNode_argsID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (string, error) {
@@ -2941,35 +4157,53 @@ func (ec *executionContext) field_Mutation_updatePropertiesOnObjectRelationship_
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_updatePropertiesOnObjectRelationship_argsProperties(
+func (ec *executionContext) field_Mutation_setComputedPropertyOnTypeSchemaNode_argsProperty(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) ([]*model.PropertyInput, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("properties"))
-	if tmp, ok := rawArgs["properties"]; ok {
-		return ec.unmarshalNPropertyInput2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInputᚄ(ctx, tmp)
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("property"))
+	if tmp, ok := rawArgs["property"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
-	var zeroVal []*model.PropertyInput
+	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_updatePropertiesOnRelationshipSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_setComputedPropertyOnTypeSchemaNode_argsExpression(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("expression"))
+	if tmp, ok := rawArgs["expression"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_setJsonSchemaOnTypeSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Mutation_updatePropertiesOnRelationshipSchemaNode_argsID(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_setJsonSchemaOnTypeSchemaNode_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["id"] = arg0
-	arg1, err := ec.field_Mutation_updatePropertiesOnRelationshipSchemaNode_argsProperties(ctx, rawArgs)
+	arg1, err := ec.field_Mutation_setJsonSchemaOnTypeSchemaNode_argsProperty(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["properties"] = arg1
+	args["property"] = arg1
+	arg2, err := ec.field_Mutation_setJsonSchemaOnTypeSchemaNode_argsSchema(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["schema"] = arg2
 	return args, nil
 }
-func (ec *executionContext) field_Mutation_updatePropertiesOnRelationshipSchemaNode_argsID(
+func (ec *executionContext) field_Mutation_setJsonSchemaOnTypeSchemaNode_argsID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (string, error) {
@@ -2982,35 +4216,48 @@ func (ec *executionContext) field_Mutation_updatePropertiesOnRelationshipSchemaN
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_updatePropertiesOnRelationshipSchemaNode_argsProperties(
+func (ec *executionContext) field_Mutation_setJsonSchemaOnTypeSchemaNode_argsProperty(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) ([]*model.PropertyInput, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("properties"))
-	if tmp, ok := rawArgs["properties"]; ok {
-		return ec.unmarshalNPropertyInput2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInputᚄ(ctx, tmp)
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("property"))
+	if tmp, ok := rawArgs["property"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
-	var zeroVal []*model.PropertyInput
+	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_updatePropertiesOnTypeSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_setJsonSchemaOnTypeSchemaNode_argsSchema(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("schema"))
+	if tmp, ok := rawArgs["schema"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_setParentOnTypeSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Mutation_updatePropertiesOnTypeSchemaNode_argsID(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_setParentOnTypeSchemaNode_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["id"] = arg0
-	arg1, err := ec.field_Mutation_updatePropertiesOnTypeSchemaNode_argsProperties(ctx, rawArgs)
+	arg1, err := ec.field_Mutation_setParentOnTypeSchemaNode_argsParentTypeSchemaNodeID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["properties"] = arg1
+	args["parentTypeSchemaNodeId"] = arg1
 	return args, nil
 }
-func (ec *executionContext) field_Mutation_updatePropertiesOnTypeSchemaNode_argsID(
+func (ec *executionContext) field_Mutation_setParentOnTypeSchemaNode_argsID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (string, error) {
@@ -3023,35 +4270,45 @@ func (ec *executionContext) field_Mutation_updatePropertiesOnTypeSchemaNode_args
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Mutation_updatePropertiesOnTypeSchemaNode_argsProperties(
+func (ec *executionContext) field_Mutation_setParentOnTypeSchemaNode_argsParentTypeSchemaNodeID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) ([]*model.PropertyInput, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("properties"))
-	if tmp, ok := rawArgs["properties"]; ok {
-		return ec.unmarshalNPropertyInput2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInputᚄ(ctx, tmp)
+) (*string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("parentTypeSchemaNodeId"))
+	if tmp, ok := rawArgs["parentTypeSchemaNodeId"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
 	}
 
-	var zeroVal []*model.PropertyInput
+	var zeroVal *string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_setPropertyConstraintOnTypeSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Query___type_argsName(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_setPropertyConstraintOnTypeSchemaNode_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["name"] = arg0
+	args["id"] = arg0
+	arg1, err := ec.field_Mutation_setPropertyConstraintOnTypeSchemaNode_argsProperty(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["property"] = arg1
+	arg2, err := ec.field_Mutation_setPropertyConstraintOnTypeSchemaNode_argsConstraint(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["constraint"] = arg2
 	return args, nil
 }
-func (ec *executionContext) field_Query___type_argsName(
+func (ec *executionContext) field_Mutation_setPropertyConstraintOnTypeSchemaNode_argsID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (string, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-	if tmp, ok := rawArgs["name"]; ok {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
 		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
@@ -3059,22 +4316,12 @@ func (ec *executionContext) field_Query___type_argsName(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_getDomainSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_getDomainSchemaNode_argsID(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["id"] = arg0
-	return args, nil
-}
-func (ec *executionContext) field_Query_getDomainSchemaNode_argsID(
+func (ec *executionContext) field_Mutation_setPropertyConstraintOnTypeSchemaNode_argsProperty(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (string, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-	if tmp, ok := rawArgs["id"]; ok {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("property"))
+	if tmp, ok := rawArgs["property"]; ok {
 		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
@@ -3082,45 +4329,40 @@ func (ec *executionContext) field_Query_getDomainSchemaNode_argsID(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_getObjectNodeIncomingRelationships_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
-	var err error
-	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_getObjectNodeIncomingRelationships_argsToObjectNodeID(ctx, rawArgs)
-	if err != nil {
-		return nil, err
-	}
-	args["toObjectNodeId"] = arg0
-	return args, nil
-}
-func (ec *executionContext) field_Query_getObjectNodeIncomingRelationships_argsToObjectNodeID(
+func (ec *executionContext) field_Mutation_setPropertyConstraintOnTypeSchemaNode_argsConstraint(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) (string, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("toObjectNodeId"))
-	if tmp, ok := rawArgs["toObjectNodeId"]; ok {
-		return ec.unmarshalNString2string(ctx, tmp)
+) (*model.PropertyConstraintInput, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("constraint"))
+	if tmp, ok := rawArgs["constraint"]; ok {
+		return ec.unmarshalOPropertyConstraintInput2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyConstraintInput(ctx, tmp)
 	}
 
-	var zeroVal string
+	var zeroVal *model.PropertyConstraintInput
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_getObjectNodeOutgoingRelationships_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_updatePropertiesOnObjectNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_getObjectNodeOutgoingRelationships_argsFromObjectNodeID(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_updatePropertiesOnObjectNode_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["fromObjectNodeId"] = arg0
+	args["id"] = arg0
+	arg1, err := ec.field_Mutation_updatePropertiesOnObjectNode_argsProperties(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["properties"] = arg1
 	return args, nil
 }
-func (ec *executionContext) field_Query_getObjectNodeOutgoingRelationships_argsFromObjectNodeID(
+func (ec *executionContext) field_Mutation_updatePropertiesOnObjectNode_argsID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (string, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("fromObjectNodeId"))
-	if tmp, ok := rawArgs["fromObjectNodeId"]; ok {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
 		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
@@ -3128,17 +4370,35 @@ func (ec *executionContext) field_Query_getObjectNodeOutgoingRelationships_argsF
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_getObjectNodeRelationship_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_updatePropertiesOnObjectNode_argsProperties(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) ([]*model.PropertyInput, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("properties"))
+	if tmp, ok := rawArgs["properties"]; ok {
+		return ec.unmarshalNPropertyInput2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInputᚄ(ctx, tmp)
+	}
+
+	var zeroVal []*model.PropertyInput
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_updatePropertiesOnObjectRelationship_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_getObjectNodeRelationship_argsID(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_updatePropertiesOnObjectRelationship_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["id"] = arg0
+	arg1, err := ec.field_Mutation_updatePropertiesOnObjectRelationship_argsProperties(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["properties"] = arg1
 	return args, nil
 }
-func (ec *executionContext) field_Query_getObjectNodeRelationship_argsID(
+func (ec *executionContext) field_Mutation_updatePropertiesOnObjectRelationship_argsID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (string, error) {
@@ -3151,17 +4411,35 @@ func (ec *executionContext) field_Query_getObjectNodeRelationship_argsID(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_getObjectNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_updatePropertiesOnObjectRelationship_argsProperties(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) ([]*model.PropertyInput, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("properties"))
+	if tmp, ok := rawArgs["properties"]; ok {
+		return ec.unmarshalNPropertyInput2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInputᚄ(ctx, tmp)
+	}
+
+	var zeroVal []*model.PropertyInput
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_updatePropertiesOnRelationshipSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_getObjectNode_argsID(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_updatePropertiesOnRelationshipSchemaNode_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["id"] = arg0
+	arg1, err := ec.field_Mutation_updatePropertiesOnRelationshipSchemaNode_argsProperties(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["properties"] = arg1
 	return args, nil
 }
-func (ec *executionContext) field_Query_getObjectNode_argsID(
+func (ec *executionContext) field_Mutation_updatePropertiesOnRelationshipSchemaNode_argsID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (string, error) {
@@ -3174,63 +4452,76 @@ func (ec *executionContext) field_Query_getObjectNode_argsID(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_getObjectNodes_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Mutation_updatePropertiesOnRelationshipSchemaNode_argsProperties(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) ([]*model.PropertyInput, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("properties"))
+	if tmp, ok := rawArgs["properties"]; ok {
+		return ec.unmarshalNPropertyInput2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInputᚄ(ctx, tmp)
+	}
+
+	var zeroVal []*model.PropertyInput
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Mutation_updatePropertiesOnTypeSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_getObjectNodes_argsDomain(ctx, rawArgs)
+	arg0, err := ec.field_Mutation_updatePropertiesOnTypeSchemaNode_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["domain"] = arg0
-	arg1, err := ec.field_Query_getObjectNodes_argsType(ctx, rawArgs)
+	args["id"] = arg0
+	arg1, err := ec.field_Mutation_updatePropertiesOnTypeSchemaNode_argsProperties(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["type"] = arg1
+	args["properties"] = arg1
 	return args, nil
 }
-func (ec *executionContext) field_Query_getObjectNodes_argsDomain(
+func (ec *executionContext) field_Mutation_updatePropertiesOnTypeSchemaNode_argsID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) (*string, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
-	if tmp, ok := rawArgs["domain"]; ok {
-		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
-	var zeroVal *string
+	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_getObjectNodes_argsType(
+func (ec *executionContext) field_Mutation_updatePropertiesOnTypeSchemaNode_argsProperties(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) (*string, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
-	if tmp, ok := rawArgs["type"]; ok {
-		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+) ([]*model.PropertyInput, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("properties"))
+	if tmp, ok := rawArgs["properties"]; ok {
+		return ec.unmarshalNPropertyInput2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInputᚄ(ctx, tmp)
 	}
 
-	var zeroVal *string
+	var zeroVal []*model.PropertyInput
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_getRelationshipSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query___type_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_getRelationshipSchemaNode_argsID(ctx, rawArgs)
+	arg0, err := ec.field_Query___type_argsName(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["id"] = arg0
+	args["name"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field_Query_getRelationshipSchemaNode_argsID(
+func (ec *executionContext) field_Query___type_argsName(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (string, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-	if tmp, ok := rawArgs["id"]; ok {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+	if tmp, ok := rawArgs["name"]; ok {
 		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
@@ -3238,45 +4529,81 @@ func (ec *executionContext) field_Query_getRelationshipSchemaNode_argsID(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_getRelationshipSchemaNodes_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_exportSchemaDiagram_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_getRelationshipSchemaNodes_argsDomain(ctx, rawArgs)
+	arg0, err := ec.field_Query_exportSchemaDiagram_argsDomain(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["domain"] = arg0
+	arg1, err := ec.field_Query_exportSchemaDiagram_argsFormat(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["format"] = arg1
+	arg2, err := ec.field_Query_exportSchemaDiagram_argsIncludeCounts(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["includeCounts"] = arg2
 	return args, nil
 }
-func (ec *executionContext) field_Query_getRelationshipSchemaNodes_argsDomain(
+func (ec *executionContext) field_Query_exportSchemaDiagram_argsDomain(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) (*string, error) {
+) (string, error) {
 	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
 	if tmp, ok := rawArgs["domain"]; ok {
-		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
-	var zeroVal *string
+	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_getTypeSchemaNodeIncomingRelationships_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_exportSchemaDiagram_argsFormat(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.SchemaDiagramFormat, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("format"))
+	if tmp, ok := rawArgs["format"]; ok {
+		return ec.unmarshalNSchemaDiagramFormat2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaDiagramFormat(ctx, tmp)
+	}
+
+	var zeroVal model.SchemaDiagramFormat
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_exportSchemaDiagram_argsIncludeCounts(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*bool, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeCounts"))
+	if tmp, ok := rawArgs["includeCounts"]; ok {
+		return ec.unmarshalOBoolean2ᚖbool(ctx, tmp)
+	}
+
+	var zeroVal *bool
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_getDomainExternalDependencies_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_getTypeSchemaNodeIncomingRelationships_argsID(ctx, rawArgs)
+	arg0, err := ec.field_Query_getDomainExternalDependencies_argsDomain(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["id"] = arg0
+	args["domain"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field_Query_getTypeSchemaNodeIncomingRelationships_argsID(
+func (ec *executionContext) field_Query_getDomainExternalDependencies_argsDomain(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (string, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-	if tmp, ok := rawArgs["id"]; ok {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
+	if tmp, ok := rawArgs["domain"]; ok {
 		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
@@ -3284,17 +4611,17 @@ func (ec *executionContext) field_Query_getTypeSchemaNodeIncomingRelationships_a
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_getTypeSchemaNodeOutgoingRelationships_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_getDomainSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_getTypeSchemaNodeOutgoingRelationships_argsID(ctx, rawArgs)
+	arg0, err := ec.field_Query_getDomainSchemaNode_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
 	args["id"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field_Query_getTypeSchemaNodeOutgoingRelationships_argsID(
+func (ec *executionContext) field_Query_getDomainSchemaNode_argsID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (string, error) {
@@ -3307,22 +4634,22 @@ func (ec *executionContext) field_Query_getTypeSchemaNodeOutgoingRelationships_a
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_getTypeSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_getObjectNodeIncomingRelationships_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_getTypeSchemaNode_argsID(ctx, rawArgs)
+	arg0, err := ec.field_Query_getObjectNodeIncomingRelationships_argsToObjectNodeID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["id"] = arg0
+	args["toObjectNodeId"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field_Query_getTypeSchemaNode_argsID(
+func (ec *executionContext) field_Query_getObjectNodeIncomingRelationships_argsToObjectNodeID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
 ) (string, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-	if tmp, ok := rawArgs["id"]; ok {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("toObjectNodeId"))
+	if tmp, ok := rawArgs["toObjectNodeId"]; ok {
 		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
@@ -3330,491 +4657,600 @@ func (ec *executionContext) field_Query_getTypeSchemaNode_argsID(
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field_Query_getTypeSchemaNodes_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_getObjectNodeOutgoingRelationships_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field_Query_getTypeSchemaNodes_argsDomain(ctx, rawArgs)
+	arg0, err := ec.field_Query_getObjectNodeOutgoingRelationships_argsFromObjectNodeID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["domain"] = arg0
+	args["fromObjectNodeId"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field_Query_getTypeSchemaNodes_argsDomain(
+func (ec *executionContext) field_Query_getObjectNodeOutgoingRelationships_argsFromObjectNodeID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) (*string, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
-	if tmp, ok := rawArgs["domain"]; ok {
-		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("fromObjectNodeId"))
+	if tmp, ok := rawArgs["fromObjectNodeId"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
-	var zeroVal *string
+	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_getObjectNodeRelationship_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field___Type_enumValues_argsIncludeDeprecated(ctx, rawArgs)
+	arg0, err := ec.field_Query_getObjectNodeRelationship_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["includeDeprecated"] = arg0
+	args["id"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field___Type_enumValues_argsIncludeDeprecated(
+func (ec *executionContext) field_Query_getObjectNodeRelationship_argsID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) (bool, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		return ec.unmarshalOBoolean2bool(ctx, tmp)
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
-	var zeroVal bool
+	var zeroVal string
 	return zeroVal, nil
 }
 
-func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+func (ec *executionContext) field_Query_getObjectNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
 	var err error
 	args := map[string]interface{}{}
-	arg0, err := ec.field___Type_fields_argsIncludeDeprecated(ctx, rawArgs)
+	arg0, err := ec.field_Query_getObjectNode_argsID(ctx, rawArgs)
 	if err != nil {
 		return nil, err
 	}
-	args["includeDeprecated"] = arg0
+	args["id"] = arg0
 	return args, nil
 }
-func (ec *executionContext) field___Type_fields_argsIncludeDeprecated(
+func (ec *executionContext) field_Query_getObjectNode_argsID(
 	ctx context.Context,
 	rawArgs map[string]interface{},
-) (bool, error) {
-	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
-	if tmp, ok := rawArgs["includeDeprecated"]; ok {
-		return ec.unmarshalOBoolean2bool(ctx, tmp)
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
 
-	var zeroVal bool
+	var zeroVal string
 	return zeroVal, nil
 }
 
-// endregion ***************************** args.gotpl *****************************
-
-// region    ************************** directives.gotpl **************************
-
-// endregion ************************** directives.gotpl **************************
-
-// region    **************************** field.gotpl *****************************
-
-func (ec *executionContext) _DomainSchemaNode_id(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DomainSchemaNode_id(ctx, field)
+func (ec *executionContext) field_Query_getObjectNodes_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_getObjectNodes_argsDomain(ctx, rawArgs)
 	if err != nil {
-		return graphql.Null
+		return nil, err
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
-	})
+	args["domain"] = arg0
+	arg1, err := ec.field_Query_getObjectNodes_argsType(ctx, rawArgs)
 	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
+		return nil, err
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_DomainSchemaNode_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DomainSchemaNode",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _DomainSchemaNode_domain(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DomainSchemaNode_domain(ctx, field)
+	args["type"] = arg1
+	arg2, err := ec.field_Query_getObjectNodes_argsWhere(ctx, rawArgs)
 	if err != nil {
-		return graphql.Null
+		return nil, err
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Domain, nil
-	})
+	args["where"] = arg2
+	arg3, err := ec.field_Query_getObjectNodes_argsOrderBy(ctx, rawArgs)
 	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+		return nil, err
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
+	args["orderBy"] = arg3
+	return args, nil
+}
+func (ec *executionContext) field_Query_getObjectNodes_argsDomain(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
+	if tmp, ok := rawArgs["domain"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+
+	var zeroVal *string
+	return zeroVal, nil
 }
 
-func (ec *executionContext) fieldContext_DomainSchemaNode_domain(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DomainSchemaNode",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Query_getObjectNodes_argsType(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+	if tmp, ok := rawArgs["type"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
 	}
-	return fc, nil
+
+	var zeroVal *string
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _DomainSchemaNode_name(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DomainSchemaNode_name(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
+func (ec *executionContext) field_Query_getObjectNodes_argsWhere(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) ([]*model.PropertyFilter, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("where"))
+	if tmp, ok := rawArgs["where"]; ok {
+		return ec.unmarshalOPropertyFilter2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyFilterᚄ(ctx, tmp)
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+
+	var zeroVal []*model.PropertyFilter
+	return zeroVal, nil
 }
 
-func (ec *executionContext) fieldContext_DomainSchemaNode_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DomainSchemaNode",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Query_getObjectNodes_argsOrderBy(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) ([]*model.PropertySort, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("orderBy"))
+	if tmp, ok := rawArgs["orderBy"]; ok {
+		return ec.unmarshalOPropertySort2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertySortᚄ(ctx, tmp)
 	}
-	return fc, nil
+
+	var zeroVal []*model.PropertySort
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _DomainSchemaNode_type(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DomainSchemaNode_type(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
-	})
+func (ec *executionContext) field_Query_getRelationshipSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_getRelationshipSchemaNode_argsID(ctx, rawArgs)
 	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
+		return nil, err
 	}
-	res := resTmp.(string)
-	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	args["id"] = arg0
+	return args, nil
 }
-
-func (ec *executionContext) fieldContext_DomainSchemaNode_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DomainSchemaNode",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Query_getRelationshipSchemaNode_argsID(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
-	return fc, nil
+
+	var zeroVal string
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _DomainSchemaNode_labels(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DomainSchemaNode_labels(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Labels, nil
-	})
+func (ec *executionContext) field_Query_getRelationshipSchemaNodes_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_getRelationshipSchemaNodes_argsDomain(ctx, rawArgs)
 	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+		return nil, err
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["domain"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_getRelationshipSchemaNodes_argsDomain(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
+	if tmp, ok := rawArgs["domain"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
 	}
-	res := resTmp.([]string)
-	fc.Result = res
-	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+
+	var zeroVal *string
+	return zeroVal, nil
 }
 
-func (ec *executionContext) fieldContext_DomainSchemaNode_labels(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DomainSchemaNode",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Query_getTypeSchemaNodeIncomingRelationships_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_getTypeSchemaNodeIncomingRelationships_argsID(ctx, rawArgs)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["id"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_getTypeSchemaNodeIncomingRelationships_argsID(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _DomainSchemaNode_properties(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DomainSchemaNode_properties(ctx, field)
+func (ec *executionContext) field_Query_getTypeSchemaNodeOutgoingRelationships_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_getTypeSchemaNodeOutgoingRelationships_argsID(ctx, rawArgs)
 	if err != nil {
-		return graphql.Null
+		return nil, err
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Properties, nil
-	})
+	args["id"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_getTypeSchemaNodeOutgoingRelationships_argsID(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_getTypeSchemaNode_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_getTypeSchemaNode_argsID(ctx, rawArgs)
 	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+		return nil, err
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["id"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_getTypeSchemaNode_argsID(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+	if tmp, ok := rawArgs["id"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
-	res := resTmp.([]*model.Property)
-	fc.Result = res
-	return ec.marshalOProperty2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyᚄ(ctx, field.Selections, res)
+
+	var zeroVal string
+	return zeroVal, nil
 }
 
-func (ec *executionContext) fieldContext_DomainSchemaNode_properties(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DomainSchemaNode",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "key":
-				return ec.fieldContext_Property_key(ctx, field)
-			case "value":
-				return ec.fieldContext_Property_value(ctx, field)
-			case "type":
-				return ec.fieldContext_Property_type(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
-		},
+func (ec *executionContext) field_Query_getTypeSchemaNodes_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_getTypeSchemaNodes_argsDomain(ctx, rawArgs)
+	if err != nil {
+		return nil, err
 	}
-	return fc, nil
+	args["domain"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_getTypeSchemaNodes_argsDomain(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (*string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
+	if tmp, ok := rawArgs["domain"]; ok {
+		return ec.unmarshalOString2ᚖstring(ctx, tmp)
+	}
+
+	var zeroVal *string
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _DomainSchemaNodeResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNodeResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DomainSchemaNodeResponse_success(ctx, field)
+func (ec *executionContext) field_Query_objectNodesInBoundingBox_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_objectNodesInBoundingBox_argsDomain(ctx, rawArgs)
 	if err != nil {
-		return graphql.Null
+		return nil, err
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Success, nil
-	})
+	args["domain"] = arg0
+	arg1, err := ec.field_Query_objectNodesInBoundingBox_argsType(ctx, rawArgs)
 	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+		return nil, err
 	}
-	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return graphql.Null
+	args["type"] = arg1
+	arg2, err := ec.field_Query_objectNodesInBoundingBox_argsProperty(ctx, rawArgs)
+	if err != nil {
+		return nil, err
 	}
-	res := resTmp.(bool)
-	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	args["property"] = arg2
+	arg3, err := ec.field_Query_objectNodesInBoundingBox_argsLowerLeft(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["lowerLeft"] = arg3
+	arg4, err := ec.field_Query_objectNodesInBoundingBox_argsUpperRight(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["upperRight"] = arg4
+	return args, nil
 }
-
-func (ec *executionContext) fieldContext_DomainSchemaNodeResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DomainSchemaNodeResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
+func (ec *executionContext) field_Query_objectNodesInBoundingBox_argsDomain(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
+	if tmp, ok := rawArgs["domain"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
-	return fc, nil
+
+	var zeroVal string
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _DomainSchemaNodeResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNodeResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DomainSchemaNodeResponse_message(ctx, field)
-	if err != nil {
-		return graphql.Null
+func (ec *executionContext) field_Query_objectNodesInBoundingBox_argsType(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+	if tmp, ok := rawArgs["type"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
-	})
-	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_objectNodesInBoundingBox_argsProperty(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("property"))
+	if tmp, ok := rawArgs["property"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
-	if resTmp == nil {
-		return graphql.Null
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_objectNodesInBoundingBox_argsLowerLeft(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.PointInput, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("lowerLeft"))
+	if tmp, ok := rawArgs["lowerLeft"]; ok {
+		return ec.unmarshalNPointInput2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPointInput(ctx, tmp)
 	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+
+	var zeroVal model.PointInput
+	return zeroVal, nil
 }
 
-func (ec *executionContext) fieldContext_DomainSchemaNodeResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DomainSchemaNodeResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+func (ec *executionContext) field_Query_objectNodesInBoundingBox_argsUpperRight(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.PointInput, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("upperRight"))
+	if tmp, ok := rawArgs["upperRight"]; ok {
+		return ec.unmarshalNPointInput2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPointInput(ctx, tmp)
 	}
-	return fc, nil
+
+	var zeroVal model.PointInput
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _DomainSchemaNodeResponse_domainSchemaNode(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNodeResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DomainSchemaNodeResponse_domainSchemaNode(ctx, field)
+func (ec *executionContext) field_Query_objectNodesWithinDistance_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_objectNodesWithinDistance_argsDomain(ctx, rawArgs)
 	if err != nil {
-		return graphql.Null
+		return nil, err
 	}
-	ctx = graphql.WithFieldContext(ctx, fc)
-	defer func() {
-		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
-		}
-	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DomainSchemaNode, nil
-	})
+	args["domain"] = arg0
+	arg1, err := ec.field_Query_objectNodesWithinDistance_argsType(ctx, rawArgs)
 	if err != nil {
-		ec.Error(ctx, err)
-		return graphql.Null
+		return nil, err
 	}
-	if resTmp == nil {
-		return graphql.Null
+	args["type"] = arg1
+	arg2, err := ec.field_Query_objectNodesWithinDistance_argsProperty(ctx, rawArgs)
+	if err != nil {
+		return nil, err
 	}
-	res := resTmp.(*model.DomainSchemaNode)
-	fc.Result = res
-	return ec.marshalODomainSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNode(ctx, field.Selections, res)
+	args["property"] = arg2
+	arg3, err := ec.field_Query_objectNodesWithinDistance_argsCenter(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["center"] = arg3
+	arg4, err := ec.field_Query_objectNodesWithinDistance_argsMeters(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["meters"] = arg4
+	return args, nil
 }
+func (ec *executionContext) field_Query_objectNodesWithinDistance_argsDomain(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
+	if tmp, ok := rawArgs["domain"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
 
-func (ec *executionContext) fieldContext_DomainSchemaNodeResponse_domainSchemaNode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "DomainSchemaNodeResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_DomainSchemaNode_id(ctx, field)
-			case "domain":
-				return ec.fieldContext_DomainSchemaNode_domain(ctx, field)
-			case "name":
-				return ec.fieldContext_DomainSchemaNode_name(ctx, field)
-			case "type":
-				return ec.fieldContext_DomainSchemaNode_type(ctx, field)
-			case "labels":
-				return ec.fieldContext_DomainSchemaNode_labels(ctx, field)
-			case "properties":
-				return ec.fieldContext_DomainSchemaNode_properties(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNode", field.Name)
-		},
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_objectNodesWithinDistance_argsType(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+	if tmp, ok := rawArgs["type"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
 	}
-	return fc, nil
+
+	var zeroVal string
+	return zeroVal, nil
 }
 
-func (ec *executionContext) _DomainSchemaNodesResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DomainSchemaNodesResponse_success(ctx, field)
+func (ec *executionContext) field_Query_objectNodesWithinDistance_argsProperty(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("property"))
+	if tmp, ok := rawArgs["property"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_objectNodesWithinDistance_argsCenter(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.PointInput, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("center"))
+	if tmp, ok := rawArgs["center"]; ok {
+		return ec.unmarshalNPointInput2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPointInput(ctx, tmp)
+	}
+
+	var zeroVal model.PointInput
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_objectNodesWithinDistance_argsMeters(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (float64, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("meters"))
+	if tmp, ok := rawArgs["meters"]; ok {
+		return ec.unmarshalNFloat2float64(ctx, tmp)
+	}
+
+	var zeroVal float64
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_relationshipCardinalityReport_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_relationshipCardinalityReport_argsDomain(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["domain"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field_Query_relationshipCardinalityReport_argsDomain(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
+	if tmp, ok := rawArgs["domain"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_schemaDiff_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field_Query_schemaDiff_argsDomain(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["domain"] = arg0
+	arg1, err := ec.field_Query_schemaDiff_argsAgainst(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["against"] = arg1
+	return args, nil
+}
+func (ec *executionContext) field_Query_schemaDiff_argsDomain(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (string, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
+	if tmp, ok := rawArgs["domain"]; ok {
+		return ec.unmarshalNString2string(ctx, tmp)
+	}
+
+	var zeroVal string
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field_Query_schemaDiff_argsAgainst(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (model.SchemaDiffAgainst, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("against"))
+	if tmp, ok := rawArgs["against"]; ok {
+		return ec.unmarshalNSchemaDiffAgainst2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaDiffAgainst(ctx, tmp)
+	}
+
+	var zeroVal model.SchemaDiffAgainst
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field___Type_enumValues_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field___Type_enumValues_argsIncludeDeprecated(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field___Type_enumValues_argsIncludeDeprecated(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (bool, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		return ec.unmarshalOBoolean2bool(ctx, tmp)
+	}
+
+	var zeroVal bool
+	return zeroVal, nil
+}
+
+func (ec *executionContext) field___Type_fields_args(ctx context.Context, rawArgs map[string]interface{}) (map[string]interface{}, error) {
+	var err error
+	args := map[string]interface{}{}
+	arg0, err := ec.field___Type_fields_argsIncludeDeprecated(ctx, rawArgs)
+	if err != nil {
+		return nil, err
+	}
+	args["includeDeprecated"] = arg0
+	return args, nil
+}
+func (ec *executionContext) field___Type_fields_argsIncludeDeprecated(
+	ctx context.Context,
+	rawArgs map[string]interface{},
+) (bool, error) {
+	ctx = graphql.WithPathContext(ctx, graphql.NewPathWithField("includeDeprecated"))
+	if tmp, ok := rawArgs["includeDeprecated"]; ok {
+		return ec.unmarshalOBoolean2bool(ctx, tmp)
+	}
+
+	var zeroVal bool
+	return zeroVal, nil
+}
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _CardinalityReportResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.CardinalityReportResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CardinalityReportResponse_success(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3844,9 +5280,9 @@ func (ec *executionContext) _DomainSchemaNodesResponse_success(ctx context.Conte
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DomainSchemaNodesResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CardinalityReportResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DomainSchemaNodesResponse",
+		Object:     "CardinalityReportResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3857,8 +5293,8 @@ func (ec *executionContext) fieldContext_DomainSchemaNodesResponse_success(_ con
 	return fc, nil
 }
 
-func (ec *executionContext) _DomainSchemaNodesResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DomainSchemaNodesResponse_message(ctx, field)
+func (ec *executionContext) _CardinalityReportResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.CardinalityReportResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CardinalityReportResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3885,9 +5321,9 @@ func (ec *executionContext) _DomainSchemaNodesResponse_message(ctx context.Conte
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DomainSchemaNodesResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CardinalityReportResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DomainSchemaNodesResponse",
+		Object:     "CardinalityReportResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3898,8 +5334,8 @@ func (ec *executionContext) fieldContext_DomainSchemaNodesResponse_message(_ con
 	return fc, nil
 }
 
-func (ec *executionContext) _DomainSchemaNodesResponse_domainSchemaNodes(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_DomainSchemaNodesResponse_domainSchemaNodes(ctx, field)
+func (ec *executionContext) _CardinalityReportResponse_violations(ctx context.Context, field graphql.CollectedField, obj *model.CardinalityReportResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CardinalityReportResponse_violations(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3912,7 +5348,7 @@ func (ec *executionContext) _DomainSchemaNodesResponse_domainSchemaNodes(ctx con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DomainSchemaNodes, nil
+		return obj.Violations, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3921,40 +5357,40 @@ func (ec *executionContext) _DomainSchemaNodesResponse_domainSchemaNodes(ctx con
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.DomainSchemaNode)
+	res := resTmp.([]*model.CardinalityViolation)
 	fc.Result = res
-	return ec.marshalODomainSchemaNode2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeᚄ(ctx, field.Selections, res)
+	return ec.marshalOCardinalityViolation2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐCardinalityViolationᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_DomainSchemaNodesResponse_domainSchemaNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CardinalityReportResponse_violations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DomainSchemaNodesResponse",
+		Object:     "CardinalityReportResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_DomainSchemaNode_id(ctx, field)
-			case "domain":
-				return ec.fieldContext_DomainSchemaNode_domain(ctx, field)
-			case "name":
-				return ec.fieldContext_DomainSchemaNode_name(ctx, field)
-			case "type":
-				return ec.fieldContext_DomainSchemaNode_type(ctx, field)
-			case "labels":
-				return ec.fieldContext_DomainSchemaNode_labels(ctx, field)
-			case "properties":
-				return ec.fieldContext_DomainSchemaNode_properties(ctx, field)
+			case "relationshipSchemaNodeId":
+				return ec.fieldContext_CardinalityViolation_relationshipSchemaNodeId(ctx, field)
+			case "relationship":
+				return ec.fieldContext_CardinalityViolation_relationship(ctx, field)
+			case "objectNodeId":
+				return ec.fieldContext_CardinalityViolation_objectNodeId(ctx, field)
+			case "side":
+				return ec.fieldContext_CardinalityViolation_side(ctx, field)
+			case "count":
+				return ec.fieldContext_CardinalityViolation_count(ctx, field)
+			case "message":
+				return ec.fieldContext_CardinalityViolation_message(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNode", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CardinalityViolation", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createObjectNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createObjectNode(ctx, field)
+func (ec *executionContext) _CardinalityViolation_relationshipSchemaNodeId(ctx context.Context, field graphql.CollectedField, obj *model.CardinalityViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CardinalityViolation_relationshipSchemaNodeId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -3967,7 +5403,7 @@ func (ec *executionContext) _Mutation_createObjectNode(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateObjectNode(rctx, fc.Args["domain"].(string), fc.Args["name"].(string), fc.Args["type"].(string), fc.Args["labels"].([]string), fc.Args["properties"].([]*model.PropertyInput))
+		return obj.RelationshipSchemaNodeID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -3979,45 +5415,26 @@ func (ec *executionContext) _Mutation_createObjectNode(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectNodeResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createObjectNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CardinalityViolation_relationshipSchemaNodeId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CardinalityViolation",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
-			case "objectNode":
-				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createObjectNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_renameObjectNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_renameObjectNode(ctx, field)
+func (ec *executionContext) _CardinalityViolation_relationship(ctx context.Context, field graphql.CollectedField, obj *model.CardinalityViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CardinalityViolation_relationship(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4030,7 +5447,7 @@ func (ec *executionContext) _Mutation_renameObjectNode(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RenameObjectNode(rctx, fc.Args["id"].(string), fc.Args["newName"].(string))
+		return obj.Relationship, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4042,49 +5459,30 @@ func (ec *executionContext) _Mutation_renameObjectNode(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectNodeResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_renameObjectNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CardinalityViolation_relationship(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CardinalityViolation",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
-			case "objectNode":
-				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_renameObjectNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _Mutation_deleteObjectNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_deleteObjectNode(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
+	return fc, nil
+}
+
+func (ec *executionContext) _CardinalityViolation_objectNodeId(ctx context.Context, field graphql.CollectedField, obj *model.CardinalityViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CardinalityViolation_objectNodeId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
@@ -4093,7 +5491,7 @@ func (ec *executionContext) _Mutation_deleteObjectNode(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteObjectNode(rctx, fc.Args["id"].(string))
+		return obj.ObjectNodeID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4105,45 +5503,26 @@ func (ec *executionContext) _Mutation_deleteObjectNode(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectNodeResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteObjectNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CardinalityViolation_objectNodeId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CardinalityViolation",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
-			case "objectNode":
-				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteObjectNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_addLabelsOnObjectNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_addLabelsOnObjectNode(ctx, field)
+func (ec *executionContext) _CardinalityViolation_side(ctx context.Context, field graphql.CollectedField, obj *model.CardinalityViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CardinalityViolation_side(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4156,7 +5535,7 @@ func (ec *executionContext) _Mutation_addLabelsOnObjectNode(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().AddLabelsOnObjectNode(rctx, fc.Args["id"].(string), fc.Args["labels"].([]string))
+		return obj.Side, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4168,45 +5547,26 @@ func (ec *executionContext) _Mutation_addLabelsOnObjectNode(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectNodeResponse)
+	res := resTmp.(model.RelationshipSide)
 	fc.Result = res
-	return ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNRelationshipSide2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSide(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_addLabelsOnObjectNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CardinalityViolation_side(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CardinalityViolation",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
-			case "objectNode":
-				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
+			return nil, errors.New("field of type RelationshipSide does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_addLabelsOnObjectNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_removeLabelsFromObjectNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_removeLabelsFromObjectNode(ctx, field)
+func (ec *executionContext) _CardinalityViolation_count(ctx context.Context, field graphql.CollectedField, obj *model.CardinalityViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CardinalityViolation_count(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4219,7 +5579,7 @@ func (ec *executionContext) _Mutation_removeLabelsFromObjectNode(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RemoveLabelsFromObjectNode(rctx, fc.Args["id"].(string), fc.Args["labels"].([]string))
+		return obj.Count, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4231,45 +5591,26 @@ func (ec *executionContext) _Mutation_removeLabelsFromObjectNode(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectNodeResponse)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_removeLabelsFromObjectNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CardinalityViolation_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CardinalityViolation",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
-			case "objectNode":
-				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_removeLabelsFromObjectNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updatePropertiesOnObjectNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_updatePropertiesOnObjectNode(ctx, field)
+func (ec *executionContext) _CardinalityViolation_message(ctx context.Context, field graphql.CollectedField, obj *model.CardinalityViolation) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_CardinalityViolation_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4282,7 +5623,7 @@ func (ec *executionContext) _Mutation_updatePropertiesOnObjectNode(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdatePropertiesOnObjectNode(rctx, fc.Args["id"].(string), fc.Args["properties"].([]*model.PropertyInput))
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4294,45 +5635,26 @@ func (ec *executionContext) _Mutation_updatePropertiesOnObjectNode(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectNodeResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updatePropertiesOnObjectNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CardinalityViolation_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "CardinalityViolation",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
-			case "objectNode":
-				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updatePropertiesOnObjectNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_removePropertiesFromObjectNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_removePropertiesFromObjectNode(ctx, field)
+func (ec *executionContext) _ComputedProperty_key(ctx context.Context, field graphql.CollectedField, obj *model.ComputedProperty) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ComputedProperty_key(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4345,7 +5667,7 @@ func (ec *executionContext) _Mutation_removePropertiesFromObjectNode(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RemovePropertiesFromObjectNode(rctx, fc.Args["id"].(string), fc.Args["properties"].([]string))
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4357,45 +5679,26 @@ func (ec *executionContext) _Mutation_removePropertiesFromObjectNode(ctx context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectNodeResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_removePropertiesFromObjectNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ComputedProperty_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ComputedProperty",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
-			case "objectNode":
-				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_removePropertiesFromObjectNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createObjectRelationship(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createObjectRelationship(ctx, field)
+func (ec *executionContext) _ComputedProperty_expression(ctx context.Context, field graphql.CollectedField, obj *model.ComputedProperty) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ComputedProperty_expression(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4408,7 +5711,7 @@ func (ec *executionContext) _Mutation_createObjectRelationship(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateObjectRelationship(rctx, fc.Args["name"].(string), fc.Args["properties"].([]*model.PropertyInput), fc.Args["fromObjectNodeId"].(string), fc.Args["toObjectNodeId"].(string))
+		return obj.Expression, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4420,45 +5723,26 @@ func (ec *executionContext) _Mutation_createObjectRelationship(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectRelationshipResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createObjectRelationship(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ComputedProperty_expression(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "ComputedProperty",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
-			case "objectRelationship":
-				return ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createObjectRelationship_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updatePropertiesOnObjectRelationship(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_updatePropertiesOnObjectRelationship(ctx, field)
+func (ec *executionContext) _DomainDependenciesResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.DomainDependenciesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainDependenciesResponse_success(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4471,7 +5755,7 @@ func (ec *executionContext) _Mutation_updatePropertiesOnObjectRelationship(ctx c
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdatePropertiesOnObjectRelationship(rctx, fc.Args["id"].(string), fc.Args["properties"].([]*model.PropertyInput))
+		return obj.Success, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4483,45 +5767,26 @@ func (ec *executionContext) _Mutation_updatePropertiesOnObjectRelationship(ctx c
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectRelationshipResponse)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updatePropertiesOnObjectRelationship(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainDependenciesResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainDependenciesResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
-			case "objectRelationship":
-				return ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipResponse", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updatePropertiesOnObjectRelationship_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_removePropertiesFromObjectRelationship(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_removePropertiesFromObjectRelationship(ctx, field)
+func (ec *executionContext) _DomainDependenciesResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.DomainDependenciesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainDependenciesResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4534,57 +5799,35 @@ func (ec *executionContext) _Mutation_removePropertiesFromObjectRelationship(ctx
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RemovePropertiesFromObjectRelationship(rctx, fc.Args["id"].(string), fc.Args["properties"].([]string))
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectRelationshipResponse)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_removePropertiesFromObjectRelationship(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainDependenciesResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainDependenciesResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
-			case "objectRelationship":
-				return ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_removePropertiesFromObjectRelationship_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteObjectRelationship(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_deleteObjectRelationship(ctx, field)
+func (ec *executionContext) _DomainDependenciesResponse_dependencies(ctx context.Context, field graphql.CollectedField, obj *model.DomainDependenciesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainDependenciesResponse_dependencies(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4597,57 +5840,47 @@ func (ec *executionContext) _Mutation_deleteObjectRelationship(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteObjectRelationship(rctx, fc.Args["id"].(string))
+		return obj.Dependencies, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectRelationshipResponse)
+	res := resTmp.([]*model.DomainDependency)
 	fc.Result = res
-	return ec.marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx, field.Selections, res)
+	return ec.marshalODomainDependency2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainDependencyᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteObjectRelationship(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainDependenciesResponse_dependencies(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainDependenciesResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
-			case "objectRelationship":
-				return ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipResponse", field.Name)
+			case "direction":
+				return ec.fieldContext_DomainDependency_direction(ctx, field)
+			case "domain":
+				return ec.fieldContext_DomainDependency_domain(ctx, field)
+			case "relationship":
+				return ec.fieldContext_DomainDependency_relationship(ctx, field)
+			case "relationshipSchemaNodeId":
+				return ec.fieldContext_DomainDependency_relationshipSchemaNodeId(ctx, field)
+			case "objectRelationshipCount":
+				return ec.fieldContext_DomainDependency_objectRelationshipCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DomainDependency", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteObjectRelationship_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createDomainSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createDomainSchemaNode(ctx, field)
+func (ec *executionContext) _DomainDependency_direction(ctx context.Context, field graphql.CollectedField, obj *model.DomainDependency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainDependency_direction(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4660,7 +5893,7 @@ func (ec *executionContext) _Mutation_createDomainSchemaNode(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateDomainSchemaNode(rctx, fc.Args["domain"].(string))
+		return obj.Direction, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4672,45 +5905,26 @@ func (ec *executionContext) _Mutation_createDomainSchemaNode(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.DomainSchemaNodeResponse)
+	res := resTmp.(model.DependencyDirection)
 	fc.Result = res
-	return ec.marshalNDomainSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNDependencyDirection2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDependencyDirection(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createDomainSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainDependency_direction(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainDependency",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_DomainSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_DomainSchemaNodeResponse_message(ctx, field)
-			case "domainSchemaNode":
-				return ec.fieldContext_DomainSchemaNodeResponse_domainSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type DependencyDirection does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createDomainSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_renameDomainSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_renameDomainSchemaNode(ctx, field)
+func (ec *executionContext) _DomainDependency_domain(ctx context.Context, field graphql.CollectedField, obj *model.DomainDependency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainDependency_domain(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4723,7 +5937,7 @@ func (ec *executionContext) _Mutation_renameDomainSchemaNode(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RenameDomainSchemaNode(rctx, fc.Args["id"].(string), fc.Args["newName"].(string))
+		return obj.Domain, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4735,45 +5949,26 @@ func (ec *executionContext) _Mutation_renameDomainSchemaNode(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.DomainSchemaNodeResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNDomainSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_renameDomainSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainDependency_domain(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainDependency",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_DomainSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_DomainSchemaNodeResponse_message(ctx, field)
-			case "domainSchemaNode":
-				return ec.fieldContext_DomainSchemaNodeResponse_domainSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_renameDomainSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteDomainSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_deleteDomainSchemaNode(ctx, field)
+func (ec *executionContext) _DomainDependency_relationship(ctx context.Context, field graphql.CollectedField, obj *model.DomainDependency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainDependency_relationship(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4786,7 +5981,7 @@ func (ec *executionContext) _Mutation_deleteDomainSchemaNode(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteDomainSchemaNode(rctx, fc.Args["id"].(string))
+		return obj.Relationship, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4798,45 +5993,26 @@ func (ec *executionContext) _Mutation_deleteDomainSchemaNode(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.DomainSchemaNodeResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNDomainSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteDomainSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainDependency_relationship(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainDependency",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_DomainSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_DomainSchemaNodeResponse_message(ctx, field)
-			case "domainSchemaNode":
-				return ec.fieldContext_DomainSchemaNodeResponse_domainSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteDomainSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createTypeSchemaNode(ctx, field)
+func (ec *executionContext) _DomainDependency_relationshipSchemaNodeId(ctx context.Context, field graphql.CollectedField, obj *model.DomainDependency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainDependency_relationshipSchemaNodeId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4849,57 +6025,35 @@ func (ec *executionContext) _Mutation_createTypeSchemaNode(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateTypeSchemaNode(rctx, fc.Args["domain"].(string), fc.Args["name"].(string))
+		return obj.RelationshipSchemaNodeID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.TypeSchemaNodeResponse)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainDependency_relationshipSchemaNodeId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainDependency",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
-			case "typeSchemaNode":
-				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_renameTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_renameTypeSchemaNode(ctx, field)
+func (ec *executionContext) _DomainDependency_objectRelationshipCount(ctx context.Context, field graphql.CollectedField, obj *model.DomainDependency) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainDependency_objectRelationshipCount(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4912,7 +6066,7 @@ func (ec *executionContext) _Mutation_renameTypeSchemaNode(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RenameTypeSchemaNode(rctx, fc.Args["id"].(string), fc.Args["newName"].(string))
+		return obj.ObjectRelationshipCount, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4924,45 +6078,26 @@ func (ec *executionContext) _Mutation_renameTypeSchemaNode(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.TypeSchemaNodeResponse)
+	res := resTmp.(int)
 	fc.Result = res
-	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNInt2int(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_renameTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainDependency_objectRelationshipCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainDependency",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
-			case "typeSchemaNode":
-				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_renameTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updatePropertiesOnTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_updatePropertiesOnTypeSchemaNode(ctx, field)
+func (ec *executionContext) _DomainSchemaNode_id(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainSchemaNode_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -4975,7 +6110,7 @@ func (ec *executionContext) _Mutation_updatePropertiesOnTypeSchemaNode(ctx conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdatePropertiesOnTypeSchemaNode(rctx, fc.Args["id"].(string), fc.Args["properties"].([]*model.PropertyInput))
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -4987,45 +6122,26 @@ func (ec *executionContext) _Mutation_updatePropertiesOnTypeSchemaNode(ctx conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.TypeSchemaNodeResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updatePropertiesOnTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainSchemaNode_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainSchemaNode",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
-			case "typeSchemaNode":
-				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updatePropertiesOnTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_renamePropertyOnTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_renamePropertyOnTypeSchemaNode(ctx, field)
+func (ec *executionContext) _DomainSchemaNode_domain(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainSchemaNode_domain(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5038,7 +6154,7 @@ func (ec *executionContext) _Mutation_renamePropertyOnTypeSchemaNode(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RenamePropertyOnTypeSchemaNode(rctx, fc.Args["id"].(string), fc.Args["oldPropertyName"].(string), fc.Args["newPropertyName"].(string))
+		return obj.Domain, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5050,45 +6166,26 @@ func (ec *executionContext) _Mutation_renamePropertyOnTypeSchemaNode(ctx context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.TypeSchemaNodeResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_renamePropertyOnTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainSchemaNode_domain(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainSchemaNode",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
-			case "typeSchemaNode":
-				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_renamePropertyOnTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_removePropertiesFromTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_removePropertiesFromTypeSchemaNode(ctx, field)
+func (ec *executionContext) _DomainSchemaNode_name(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainSchemaNode_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5101,7 +6198,7 @@ func (ec *executionContext) _Mutation_removePropertiesFromTypeSchemaNode(ctx con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RemovePropertiesFromTypeSchemaNode(rctx, fc.Args["id"].(string), fc.Args["properties"].([]string))
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5113,45 +6210,26 @@ func (ec *executionContext) _Mutation_removePropertiesFromTypeSchemaNode(ctx con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.TypeSchemaNodeResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_removePropertiesFromTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainSchemaNode_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainSchemaNode",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
-			case "typeSchemaNode":
-				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_removePropertiesFromTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_deleteTypeSchemaNode(ctx, field)
+func (ec *executionContext) _DomainSchemaNode_type(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainSchemaNode_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5164,7 +6242,7 @@ func (ec *executionContext) _Mutation_deleteTypeSchemaNode(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteTypeSchemaNode(rctx, fc.Args["id"].(string))
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5176,45 +6254,26 @@ func (ec *executionContext) _Mutation_deleteTypeSchemaNode(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.TypeSchemaNodeResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainSchemaNode_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainSchemaNode",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
-			case "typeSchemaNode":
-				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_createRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_createRelationshipSchemaNode(ctx, field)
+func (ec *executionContext) _DomainSchemaNode_labels(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainSchemaNode_labels(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5227,57 +6286,35 @@ func (ec *executionContext) _Mutation_createRelationshipSchemaNode(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().CreateRelationshipSchemaNode(rctx, fc.Args["name"].(string), fc.Args["domain"].(string), fc.Args["fromTypeSchemaNodeId"].(string), fc.Args["toTypeSchemaNodeId"].(string))
+		return obj.Labels, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RelationshipSchemaNodeResponse)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_createRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainSchemaNode_labels(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainSchemaNode",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
-			case "relationshipSchemaNode":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_createRelationshipSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_renameRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_renameRelationshipSchemaNode(ctx, field)
+func (ec *executionContext) _DomainSchemaNode_properties(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainSchemaNode_properties(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5290,57 +6327,45 @@ func (ec *executionContext) _Mutation_renameRelationshipSchemaNode(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RenameRelationshipSchemaNode(rctx, fc.Args["id"].(string), fc.Args["newName"].(string))
+		return obj.Properties, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RelationshipSchemaNodeResponse)
+	res := resTmp.([]*model.Property)
 	fc.Result = res
-	return ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalOProperty2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_renameRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainSchemaNode_properties(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainSchemaNode",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "success":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
-			case "relationshipSchemaNode":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
+			case "key":
+				return ec.fieldContext_Property_key(ctx, field)
+			case "value":
+				return ec.fieldContext_Property_value(ctx, field)
+			case "type":
+				return ec.fieldContext_Property_type(ctx, field)
+			case "readOnly":
+				return ec.fieldContext_Property_readOnly(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_renameRelationshipSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_updatePropertiesOnRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_updatePropertiesOnRelationshipSchemaNode(ctx, field)
+func (ec *executionContext) _DomainSchemaNodeResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNodeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainSchemaNodeResponse_success(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5353,7 +6378,7 @@ func (ec *executionContext) _Mutation_updatePropertiesOnRelationshipSchemaNode(c
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().UpdatePropertiesOnRelationshipSchemaNode(rctx, fc.Args["id"].(string), fc.Args["properties"].([]*model.PropertyInput))
+		return obj.Success, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5365,45 +6390,26 @@ func (ec *executionContext) _Mutation_updatePropertiesOnRelationshipSchemaNode(c
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RelationshipSchemaNodeResponse)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_updatePropertiesOnRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainSchemaNodeResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainSchemaNodeResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
-			case "relationshipSchemaNode":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_updatePropertiesOnRelationshipSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_renamePropertyOnRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_renamePropertyOnRelationshipSchemaNode(ctx, field)
+func (ec *executionContext) _DomainSchemaNodeResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNodeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainSchemaNodeResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5416,57 +6422,35 @@ func (ec *executionContext) _Mutation_renamePropertyOnRelationshipSchemaNode(ctx
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RenamePropertyOnRelationshipSchemaNode(rctx, fc.Args["id"].(string), fc.Args["oldPropertyName"].(string), fc.Args["newPropertyName"].(string))
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RelationshipSchemaNodeResponse)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_renamePropertyOnRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainSchemaNodeResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainSchemaNodeResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
-			case "relationshipSchemaNode":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_renamePropertyOnRelationshipSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_removePropertiesFromRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_removePropertiesFromRelationshipSchemaNode(ctx, field)
+func (ec *executionContext) _DomainSchemaNodeResponse_domainSchemaNode(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNodeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainSchemaNodeResponse_domainSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5479,57 +6463,49 @@ func (ec *executionContext) _Mutation_removePropertiesFromRelationshipSchemaNode
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().RemovePropertiesFromRelationshipSchemaNode(rctx, fc.Args["id"].(string), fc.Args["properties"].([]string))
+		return obj.DomainSchemaNode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RelationshipSchemaNodeResponse)
+	res := resTmp.(*model.DomainSchemaNode)
 	fc.Result = res
-	return ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalODomainSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_removePropertiesFromRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainSchemaNodeResponse_domainSchemaNode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainSchemaNodeResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "success":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
-			case "relationshipSchemaNode":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
+			case "id":
+				return ec.fieldContext_DomainSchemaNode_id(ctx, field)
+			case "domain":
+				return ec.fieldContext_DomainSchemaNode_domain(ctx, field)
+			case "name":
+				return ec.fieldContext_DomainSchemaNode_name(ctx, field)
+			case "type":
+				return ec.fieldContext_DomainSchemaNode_type(ctx, field)
+			case "labels":
+				return ec.fieldContext_DomainSchemaNode_labels(ctx, field)
+			case "properties":
+				return ec.fieldContext_DomainSchemaNode_properties(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNode", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_removePropertiesFromRelationshipSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Mutation_deleteRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Mutation_deleteRelationshipSchemaNode(ctx, field)
+func (ec *executionContext) _DomainSchemaNodesResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainSchemaNodesResponse_success(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5542,7 +6518,7 @@ func (ec *executionContext) _Mutation_deleteRelationshipSchemaNode(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Mutation().DeleteRelationshipSchemaNode(rctx, fc.Args["id"].(string))
+		return obj.Success, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5554,45 +6530,26 @@ func (ec *executionContext) _Mutation_deleteRelationshipSchemaNode(ctx context.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RelationshipSchemaNodeResponse)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Mutation_deleteRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainSchemaNodesResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Mutation",
+		Object:     "DomainSchemaNodesResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
-			case "relationshipSchemaNode":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Mutation_deleteRelationshipSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectNode_id(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectNode_id(ctx, field)
+func (ec *executionContext) _DomainSchemaNodesResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainSchemaNodesResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5605,26 +6562,23 @@ func (ec *executionContext) _ObjectNode_id(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectNode_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainSchemaNodesResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectNode",
+		Object:     "DomainSchemaNodesResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5635,8 +6589,8 @@ func (ec *executionContext) fieldContext_ObjectNode_id(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectNode_domain(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectNode_domain(ctx, field)
+func (ec *executionContext) _DomainSchemaNodesResponse_domainSchemaNodes(ctx context.Context, field graphql.CollectedField, obj *model.DomainSchemaNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_DomainSchemaNodesResponse_domainSchemaNodes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5649,38 +6603,49 @@ func (ec *executionContext) _ObjectNode_domain(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Domain, nil
+		return obj.DomainSchemaNodes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.DomainSchemaNode)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalODomainSchemaNode2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectNode_domain(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DomainSchemaNodesResponse_domainSchemaNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectNode",
+		Object:     "DomainSchemaNodesResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_DomainSchemaNode_id(ctx, field)
+			case "domain":
+				return ec.fieldContext_DomainSchemaNode_domain(ctx, field)
+			case "name":
+				return ec.fieldContext_DomainSchemaNode_name(ctx, field)
+			case "type":
+				return ec.fieldContext_DomainSchemaNode_type(ctx, field)
+			case "labels":
+				return ec.fieldContext_DomainSchemaNode_labels(ctx, field)
+			case "properties":
+				return ec.fieldContext_DomainSchemaNode_properties(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNode", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectNode_name(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectNode_name(ctx, field)
+func (ec *executionContext) _Index_name(ctx context.Context, field graphql.CollectedField, obj *model.Index) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Index_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5710,9 +6675,9 @@ func (ec *executionContext) _ObjectNode_name(ctx context.Context, field graphql.
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectNode_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Index_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectNode",
+		Object:     "Index",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5723,8 +6688,8 @@ func (ec *executionContext) fieldContext_ObjectNode_name(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectNode_type(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectNode_type(ctx, field)
+func (ec *executionContext) _Index_type(ctx context.Context, field graphql.CollectedField, obj *model.Index) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Index_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5754,9 +6719,9 @@ func (ec *executionContext) _ObjectNode_type(ctx context.Context, field graphql.
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectNode_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Index_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectNode",
+		Object:     "Index",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5767,8 +6732,8 @@ func (ec *executionContext) fieldContext_ObjectNode_type(_ context.Context, fiel
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectNode_originalName(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectNode_originalName(ctx, field)
+func (ec *executionContext) _Index_entityType(ctx context.Context, field graphql.CollectedField, obj *model.Index) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Index_entityType(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5781,7 +6746,7 @@ func (ec *executionContext) _ObjectNode_originalName(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OriginalName, nil
+		return obj.EntityType, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5798,9 +6763,9 @@ func (ec *executionContext) _ObjectNode_originalName(ctx context.Context, field
 	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectNode_originalName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Index_entityType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectNode",
+		Object:     "Index",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5811,8 +6776,8 @@ func (ec *executionContext) fieldContext_ObjectNode_originalName(_ context.Conte
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectNode_labels(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectNode_labels(ctx, field)
+func (ec *executionContext) _Index_labelsOrTypes(ctx context.Context, field graphql.CollectedField, obj *model.Index) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Index_labelsOrTypes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5825,7 +6790,7 @@ func (ec *executionContext) _ObjectNode_labels(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Labels, nil
+		return obj.LabelsOrTypes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5839,9 +6804,9 @@ func (ec *executionContext) _ObjectNode_labels(ctx context.Context, field graphq
 	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectNode_labels(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Index_labelsOrTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectNode",
+		Object:     "Index",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5852,8 +6817,8 @@ func (ec *executionContext) fieldContext_ObjectNode_labels(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectNode_properties(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectNode_properties(ctx, field)
+func (ec *executionContext) _Index_properties(ctx context.Context, field graphql.CollectedField, obj *model.Index) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Index_properties(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5875,34 +6840,26 @@ func (ec *executionContext) _ObjectNode_properties(ctx context.Context, field gr
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Property)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalOProperty2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectNode_properties(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Index_properties(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectNode",
+		Object:     "Index",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "key":
-				return ec.fieldContext_Property_key(ctx, field)
-			case "value":
-				return ec.fieldContext_Property_value(ctx, field)
-			case "type":
-				return ec.fieldContext_Property_type(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectNodeResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodeResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectNodeResponse_success(ctx, field)
+func (ec *executionContext) _Index_state(ctx context.Context, field graphql.CollectedField, obj *model.Index) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Index_state(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5915,7 +6872,7 @@ func (ec *executionContext) _ObjectNodeResponse_success(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Success, nil
+		return obj.State, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5927,26 +6884,26 @@ func (ec *executionContext) _ObjectNodeResponse_success(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectNodeResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Index_state(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectNodeResponse",
+		Object:     "Index",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectNodeResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodeResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectNodeResponse_message(ctx, field)
+func (ec *executionContext) _Index_owningConstraint(ctx context.Context, field graphql.CollectedField, obj *model.Index) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Index_owningConstraint(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -5959,7 +6916,7 @@ func (ec *executionContext) _ObjectNodeResponse_message(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.OwningConstraint, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -5973,9 +6930,9 @@ func (ec *executionContext) _ObjectNodeResponse_message(ctx context.Context, fie
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectNodeResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Index_owningConstraint(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectNodeResponse",
+		Object:     "Index",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -5986,8 +6943,8 @@ func (ec *executionContext) fieldContext_ObjectNodeResponse_message(_ context.Co
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectNodeResponse_objectNode(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodeResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
+func (ec *executionContext) _IndexesResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.IndexesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IndexesResponse_success(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6000,51 +6957,38 @@ func (ec *executionContext) _ObjectNodeResponse_objectNode(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ObjectNode, nil
+		return obj.Success, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectNode)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOObjectNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNode(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectNodeResponse_objectNode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IndexesResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectNodeResponse",
+		Object:     "IndexesResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_ObjectNode_id(ctx, field)
-			case "domain":
-				return ec.fieldContext_ObjectNode_domain(ctx, field)
-			case "name":
-				return ec.fieldContext_ObjectNode_name(ctx, field)
-			case "type":
-				return ec.fieldContext_ObjectNode_type(ctx, field)
-			case "originalName":
-				return ec.fieldContext_ObjectNode_originalName(ctx, field)
-			case "labels":
-				return ec.fieldContext_ObjectNode_labels(ctx, field)
-			case "properties":
-				return ec.fieldContext_ObjectNode_properties(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectNode", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectNodesOrRelationshipNodesResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodesOrRelationshipNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectNodesOrRelationshipNodesResponse_success(ctx, field)
+func (ec *executionContext) _IndexesResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.IndexesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IndexesResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6057,38 +7001,35 @@ func (ec *executionContext) _ObjectNodesOrRelationshipNodesResponse_success(ctx
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Success, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectNodesOrRelationshipNodesResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IndexesResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectNodesOrRelationshipNodesResponse",
+		Object:     "IndexesResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectNodesOrRelationshipNodesResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodesOrRelationshipNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectNodesOrRelationshipNodesResponse_message(ctx, field)
+func (ec *executionContext) _IndexesResponse_indexes(ctx context.Context, field graphql.CollectedField, obj *model.IndexesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_IndexesResponse_indexes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6101,7 +7042,7 @@ func (ec *executionContext) _ObjectNodesOrRelationshipNodesResponse_message(ctx
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.Indexes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6110,26 +7051,42 @@ func (ec *executionContext) _ObjectNodesOrRelationshipNodesResponse_message(ctx
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.Index)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOIndex2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐIndexᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectNodesOrRelationshipNodesResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_IndexesResponse_indexes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectNodesOrRelationshipNodesResponse",
+		Object:     "IndexesResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_Index_name(ctx, field)
+			case "type":
+				return ec.fieldContext_Index_type(ctx, field)
+			case "entityType":
+				return ec.fieldContext_Index_entityType(ctx, field)
+			case "labelsOrTypes":
+				return ec.fieldContext_Index_labelsOrTypes(ctx, field)
+			case "properties":
+				return ec.fieldContext_Index_properties(ctx, field)
+			case "state":
+				return ec.fieldContext_Index_state(ctx, field)
+			case "owningConstraint":
+				return ec.fieldContext_Index_owningConstraint(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Index", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectNodesOrRelationshipNodesResponse_objectNodesOrRelationshipNodes(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodesOrRelationshipNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectNodesOrRelationshipNodesResponse_objectNodesOrRelationshipNodes(ctx, field)
+func (ec *executionContext) _Mutation_createObjectNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createObjectNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6142,35 +7099,57 @@ func (ec *executionContext) _ObjectNodesOrRelationshipNodesResponse_objectNodesO
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ObjectNodesOrRelationshipNodes, nil
+		return ec.resolvers.Mutation().CreateObjectNode(rctx, fc.Args["domain"].(string), fc.Args["name"].(string), fc.Args["type"].(string), fc.Args["labels"].([]string), fc.Args["properties"].([]*model.PropertyInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]model.ObjectNodeOrRelationshipNode)
+	res := resTmp.(*model.ObjectNodeResponse)
 	fc.Result = res
-	return ec.marshalOObjectNodeOrRelationshipNode2ᚕgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeOrRelationshipNodeᚄ(ctx, field.Selections, res)
+	return ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectNodesOrRelationshipNodesResponse_objectNodesOrRelationshipNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createObjectNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectNodesOrRelationshipNodesResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ObjectNodeOrRelationshipNode does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
+			case "objectNode":
+				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createObjectNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectNodesResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectNodesResponse_success(ctx, field)
+func (ec *executionContext) _Mutation_renameObjectNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_renameObjectNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6183,7 +7162,7 @@ func (ec *executionContext) _ObjectNodesResponse_success(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Success, nil
+		return ec.resolvers.Mutation().RenameObjectNode(rctx, fc.Args["id"].(string), fc.Args["newName"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6195,67 +7174,45 @@ func (ec *executionContext) _ObjectNodesResponse_success(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.ObjectNodeResponse)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectNodesResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_renameObjectNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectNodesResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
+			case "objectNode":
+				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
 		},
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _ObjectNodesResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectNodesResponse_message(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
-	})
-	if err != nil {
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_renameObjectNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_ObjectNodesResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ObjectNodesResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectNodesResponse_objectNodes(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectNodesResponse_objectNodes(ctx, field)
+func (ec *executionContext) _Mutation_deleteObjectNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteObjectNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6268,51 +7225,57 @@ func (ec *executionContext) _ObjectNodesResponse_objectNodes(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ObjectNodes, nil
+		return ec.resolvers.Mutation().DeleteObjectNode(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ObjectNode)
+	res := resTmp.(*model.ObjectNodeResponse)
 	fc.Result = res
-	return ec.marshalOObjectNode2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeᚄ(ctx, field.Selections, res)
+	return ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectNodesResponse_objectNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteObjectNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectNodesResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_ObjectNode_id(ctx, field)
-			case "domain":
-				return ec.fieldContext_ObjectNode_domain(ctx, field)
-			case "name":
-				return ec.fieldContext_ObjectNode_name(ctx, field)
-			case "type":
-				return ec.fieldContext_ObjectNode_type(ctx, field)
-			case "originalName":
-				return ec.fieldContext_ObjectNode_originalName(ctx, field)
-			case "labels":
-				return ec.fieldContext_ObjectNode_labels(ctx, field)
-			case "properties":
-				return ec.fieldContext_ObjectNode_properties(ctx, field)
+			case "success":
+				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
+			case "objectNode":
+				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectNode", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteObjectNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationship_id(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationship) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationship_id(ctx, field)
+func (ec *executionContext) _Mutation_addLabelsOnObjectNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_addLabelsOnObjectNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6325,7 +7288,7 @@ func (ec *executionContext) _ObjectRelationship_id(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Mutation().AddLabelsOnObjectNode(rctx, fc.Args["id"].(string), fc.Args["labels"].([]string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6337,26 +7300,45 @@ func (ec *executionContext) _ObjectRelationship_id(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.ObjectNodeResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationship_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_addLabelsOnObjectNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationship",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
+			case "objectNode":
+				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_addLabelsOnObjectNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationship_name(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationship) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationship_name(ctx, field)
+func (ec *executionContext) _Mutation_removeLabelsFromObjectNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_removeLabelsFromObjectNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6369,7 +7351,7 @@ func (ec *executionContext) _ObjectRelationship_name(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Mutation().RemoveLabelsFromObjectNode(rctx, fc.Args["id"].(string), fc.Args["labels"].([]string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6381,26 +7363,45 @@ func (ec *executionContext) _ObjectRelationship_name(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.ObjectNodeResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationship_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_removeLabelsFromObjectNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationship",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
+			case "objectNode":
+				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_removeLabelsFromObjectNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationship_originalName(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationship) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationship_originalName(ctx, field)
+func (ec *executionContext) _Mutation_updatePropertiesOnObjectNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updatePropertiesOnObjectNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6413,7 +7414,7 @@ func (ec *executionContext) _ObjectRelationship_originalName(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OriginalName, nil
+		return ec.resolvers.Mutation().UpdatePropertiesOnObjectNode(rctx, fc.Args["id"].(string), fc.Args["properties"].([]*model.PropertyInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6425,26 +7426,45 @@ func (ec *executionContext) _ObjectRelationship_originalName(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.ObjectNodeResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationship_originalName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updatePropertiesOnObjectNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationship",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
+			case "objectNode":
+				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updatePropertiesOnObjectNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationship_properties(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationship) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationship_properties(ctx, field)
+func (ec *executionContext) _Mutation_removePropertiesFromObjectNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_removePropertiesFromObjectNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6457,43 +7477,57 @@ func (ec *executionContext) _ObjectRelationship_properties(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Properties, nil
+		return ec.resolvers.Mutation().RemovePropertiesFromObjectNode(rctx, fc.Args["id"].(string), fc.Args["properties"].([]string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Property)
+	res := resTmp.(*model.ObjectNodeResponse)
 	fc.Result = res
-	return ec.marshalOProperty2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyᚄ(ctx, field.Selections, res)
+	return ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationship_properties(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_removePropertiesFromObjectNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationship",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "key":
-				return ec.fieldContext_Property_key(ctx, field)
-			case "value":
-				return ec.fieldContext_Property_value(ctx, field)
-			case "type":
-				return ec.fieldContext_Property_type(ctx, field)
+			case "success":
+				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
+			case "objectNode":
+				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_removePropertiesFromObjectNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationship_fromObjectNodeId(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationship) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationship_fromObjectNodeId(ctx, field)
+func (ec *executionContext) _Mutation_createObjectRelationship(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createObjectRelationship(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6506,7 +7540,7 @@ func (ec *executionContext) _ObjectRelationship_fromObjectNodeId(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FromObjectNodeID, nil
+		return ec.resolvers.Mutation().CreateObjectRelationship(rctx, fc.Args["name"].(string), fc.Args["properties"].([]*model.PropertyInput), fc.Args["fromObjectNodeId"].(string), fc.Args["toObjectNodeId"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6518,26 +7552,45 @@ func (ec *executionContext) _ObjectRelationship_fromObjectNodeId(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.ObjectRelationshipResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationship_fromObjectNodeId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createObjectRelationship(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationship",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
+			case "objectRelationship":
+				return ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createObjectRelationship_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationship_toObjectNodeId(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationship) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationship_toObjectNodeId(ctx, field)
+func (ec *executionContext) _Mutation_updatePropertiesOnObjectRelationship(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updatePropertiesOnObjectRelationship(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6550,7 +7603,7 @@ func (ec *executionContext) _ObjectRelationship_toObjectNodeId(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ToObjectNodeID, nil
+		return ec.resolvers.Mutation().UpdatePropertiesOnObjectRelationship(rctx, fc.Args["id"].(string), fc.Args["properties"].([]*model.PropertyInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6562,26 +7615,45 @@ func (ec *executionContext) _ObjectRelationship_toObjectNodeId(ctx context.Conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.ObjectRelationshipResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationship_toObjectNodeId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updatePropertiesOnObjectRelationship(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationship",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
+			case "objectRelationship":
+				return ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updatePropertiesOnObjectRelationship_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationshipObjectNode_id(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationshipObjectNode_id(ctx, field)
+func (ec *executionContext) _Mutation_removePropertiesFromObjectRelationship(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_removePropertiesFromObjectRelationship(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6594,7 +7666,7 @@ func (ec *executionContext) _ObjectRelationshipObjectNode_id(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return ec.resolvers.Mutation().RemovePropertiesFromObjectRelationship(rctx, fc.Args["id"].(string), fc.Args["properties"].([]string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6606,26 +7678,45 @@ func (ec *executionContext) _ObjectRelationshipObjectNode_id(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.ObjectRelationshipResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationshipObjectNode_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_removePropertiesFromObjectRelationship(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationshipObjectNode",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
+			case "objectRelationship":
+				return ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_removePropertiesFromObjectRelationship_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationshipObjectNode_fromObjectNode(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationshipObjectNode_fromObjectNode(ctx, field)
+func (ec *executionContext) _Mutation_deleteObjectRelationship(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteObjectRelationship(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6638,7 +7729,7 @@ func (ec *executionContext) _ObjectRelationshipObjectNode_fromObjectNode(ctx con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FromObjectNode, nil
+		return ec.resolvers.Mutation().DeleteObjectRelationship(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6650,42 +7741,45 @@ func (ec *executionContext) _ObjectRelationshipObjectNode_fromObjectNode(ctx con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectNode)
+	res := resTmp.(*model.ObjectRelationshipResponse)
 	fc.Result = res
-	return ec.marshalNObjectNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNode(ctx, field.Selections, res)
+	return ec.marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationshipObjectNode_fromObjectNode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteObjectRelationship(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationshipObjectNode",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_ObjectNode_id(ctx, field)
-			case "domain":
-				return ec.fieldContext_ObjectNode_domain(ctx, field)
-			case "name":
-				return ec.fieldContext_ObjectNode_name(ctx, field)
-			case "type":
-				return ec.fieldContext_ObjectNode_type(ctx, field)
-			case "originalName":
-				return ec.fieldContext_ObjectNode_originalName(ctx, field)
-			case "labels":
-				return ec.fieldContext_ObjectNode_labels(ctx, field)
-			case "properties":
-				return ec.fieldContext_ObjectNode_properties(ctx, field)
+			case "success":
+				return ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
+			case "objectRelationship":
+				return ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectNode", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteObjectRelationship_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationshipObjectNode_relationshipNode(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationshipObjectNode_relationshipNode(ctx, field)
+func (ec *executionContext) _Mutation_createDomainSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createDomainSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6698,7 +7792,7 @@ func (ec *executionContext) _ObjectRelationshipObjectNode_relationshipNode(ctx c
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RelationshipNode, nil
+		return ec.resolvers.Mutation().CreateDomainSchemaNode(rctx, fc.Args["domain"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6710,46 +7804,45 @@ func (ec *executionContext) _ObjectRelationshipObjectNode_relationshipNode(ctx c
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RelationshipSchemaNode)
+	res := resTmp.(*model.DomainSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalNRelationshipSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNode(ctx, field.Selections, res)
+	return ec.marshalNDomainSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationshipObjectNode_relationshipNode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createDomainSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationshipObjectNode",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_RelationshipSchemaNode_id(ctx, field)
-			case "domain":
-				return ec.fieldContext_RelationshipSchemaNode_domain(ctx, field)
-			case "name":
-				return ec.fieldContext_RelationshipSchemaNode_name(ctx, field)
-			case "originalName":
-				return ec.fieldContext_RelationshipSchemaNode_originalName(ctx, field)
-			case "type":
-				return ec.fieldContext_RelationshipSchemaNode_type(ctx, field)
-			case "fromTypeSchemaNodeId":
-				return ec.fieldContext_RelationshipSchemaNode_fromTypeSchemaNodeId(ctx, field)
-			case "toTypeSchemaNodeId":
-				return ec.fieldContext_RelationshipSchemaNode_toTypeSchemaNodeId(ctx, field)
-			case "properties":
-				return ec.fieldContext_RelationshipSchemaNode_properties(ctx, field)
-			case "labels":
-				return ec.fieldContext_RelationshipSchemaNode_labels(ctx, field)
+			case "success":
+				return ec.fieldContext_DomainSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_DomainSchemaNodeResponse_message(ctx, field)
+			case "domainSchemaNode":
+				return ec.fieldContext_DomainSchemaNodeResponse_domainSchemaNode(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNode", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createDomainSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationshipObjectNode_toObjectNode(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationshipObjectNode_toObjectNode(ctx, field)
+func (ec *executionContext) _Mutation_renameDomainSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_renameDomainSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6762,7 +7855,7 @@ func (ec *executionContext) _ObjectRelationshipObjectNode_toObjectNode(ctx conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ToObjectNode, nil
+		return ec.resolvers.Mutation().RenameDomainSchemaNode(rctx, fc.Args["id"].(string), fc.Args["newName"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6774,42 +7867,45 @@ func (ec *executionContext) _ObjectRelationshipObjectNode_toObjectNode(ctx conte
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectNode)
+	res := resTmp.(*model.DomainSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalNObjectNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNode(ctx, field.Selections, res)
+	return ec.marshalNDomainSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationshipObjectNode_toObjectNode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_renameDomainSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationshipObjectNode",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_ObjectNode_id(ctx, field)
-			case "domain":
-				return ec.fieldContext_ObjectNode_domain(ctx, field)
-			case "name":
-				return ec.fieldContext_ObjectNode_name(ctx, field)
-			case "type":
-				return ec.fieldContext_ObjectNode_type(ctx, field)
-			case "originalName":
-				return ec.fieldContext_ObjectNode_originalName(ctx, field)
-			case "labels":
-				return ec.fieldContext_ObjectNode_labels(ctx, field)
-			case "properties":
-				return ec.fieldContext_ObjectNode_properties(ctx, field)
+			case "success":
+				return ec.fieldContext_DomainSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_DomainSchemaNodeResponse_message(ctx, field)
+			case "domainSchemaNode":
+				return ec.fieldContext_DomainSchemaNodeResponse_domainSchemaNode(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectNode", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_renameDomainSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationshipObjectNodeResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNodeResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationshipObjectNodeResponse_success(ctx, field)
+func (ec *executionContext) _Mutation_deleteDomainSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteDomainSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6822,7 +7918,7 @@ func (ec *executionContext) _ObjectRelationshipObjectNodeResponse_success(ctx co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Success, nil
+		return ec.resolvers.Mutation().DeleteDomainSchemaNode(rctx, fc.Args["id"].(string), fc.Args["crossDomain"].(*model.CrossDomainRule))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6834,67 +7930,45 @@ func (ec *executionContext) _ObjectRelationshipObjectNodeResponse_success(ctx co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.DomainSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNDomainSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationshipObjectNodeResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteDomainSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationshipObjectNodeResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_DomainSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_DomainSchemaNodeResponse_message(ctx, field)
+			case "domainSchemaNode":
+				return ec.fieldContext_DomainSchemaNodeResponse_domainSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNodeResponse", field.Name)
 		},
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) _ObjectRelationshipObjectNodeResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNodeResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationshipObjectNodeResponse_message(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
-	})
-	if err != nil {
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteDomainSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext_ObjectRelationshipObjectNodeResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationshipObjectNodeResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationshipObjectNodeResponse_objectRelationshipObjectNodes(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNodeResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationshipObjectNodeResponse_objectRelationshipObjectNodes(ctx, field)
+func (ec *executionContext) _Mutation_createTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createTypeSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6907,45 +7981,57 @@ func (ec *executionContext) _ObjectRelationshipObjectNodeResponse_objectRelation
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ObjectRelationshipObjectNodes, nil
+		return ec.resolvers.Mutation().CreateTypeSchemaNode(rctx, fc.Args["domain"].(string), fc.Args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectRelationshipObjectNode)
+	res := resTmp.(*model.TypeSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalOObjectRelationshipObjectNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipObjectNode(ctx, field.Selections, res)
+	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationshipObjectNodeResponse_objectRelationshipObjectNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationshipObjectNodeResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_ObjectRelationshipObjectNode_id(ctx, field)
-			case "fromObjectNode":
-				return ec.fieldContext_ObjectRelationshipObjectNode_fromObjectNode(ctx, field)
-			case "relationshipNode":
-				return ec.fieldContext_ObjectRelationshipObjectNode_relationshipNode(ctx, field)
-			case "toObjectNode":
-				return ec.fieldContext_ObjectRelationshipObjectNode_toObjectNode(ctx, field)
+			case "success":
+				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
+			case "typeSchemaNode":
+				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipObjectNode", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationshipObjectNodesResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationshipObjectNodesResponse_success(ctx, field)
+func (ec *executionContext) _Mutation_renameTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_renameTypeSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -6958,7 +8044,7 @@ func (ec *executionContext) _ObjectRelationshipObjectNodesResponse_success(ctx c
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Success, nil
+		return ec.resolvers.Mutation().RenameTypeSchemaNode(rctx, fc.Args["id"].(string), fc.Args["newName"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -6970,26 +8056,45 @@ func (ec *executionContext) _ObjectRelationshipObjectNodesResponse_success(ctx c
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.TypeSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationshipObjectNodesResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_renameTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationshipObjectNodesResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
+			case "typeSchemaNode":
+				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_renameTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationshipObjectNodesResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationshipObjectNodesResponse_message(ctx, field)
+func (ec *executionContext) _Mutation_updatePropertiesOnTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updatePropertiesOnTypeSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7002,35 +8107,57 @@ func (ec *executionContext) _ObjectRelationshipObjectNodesResponse_message(ctx c
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return ec.resolvers.Mutation().UpdatePropertiesOnTypeSchemaNode(rctx, fc.Args["id"].(string), fc.Args["properties"].([]*model.PropertyInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.TypeSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationshipObjectNodesResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updatePropertiesOnTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationshipObjectNodesResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
+			case "typeSchemaNode":
+				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updatePropertiesOnTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationshipObjectNodesResponse_objectRelationshipObjectNodes(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationshipObjectNodesResponse_objectRelationshipObjectNodes(ctx, field)
+func (ec *executionContext) _Mutation_renamePropertyOnTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_renamePropertyOnTypeSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7043,45 +8170,57 @@ func (ec *executionContext) _ObjectRelationshipObjectNodesResponse_objectRelatio
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ObjectRelationshipObjectNodes, nil
+		return ec.resolvers.Mutation().RenamePropertyOnTypeSchemaNode(rctx, fc.Args["id"].(string), fc.Args["oldPropertyName"].(string), fc.Args["newPropertyName"].(string), fc.Args["propagate"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ObjectRelationshipObjectNode)
+	res := resTmp.(*model.TypeSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalOObjectRelationshipObjectNode2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipObjectNodeᚄ(ctx, field.Selections, res)
+	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationshipObjectNodesResponse_objectRelationshipObjectNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_renamePropertyOnTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationshipObjectNodesResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_ObjectRelationshipObjectNode_id(ctx, field)
-			case "fromObjectNode":
-				return ec.fieldContext_ObjectRelationshipObjectNode_fromObjectNode(ctx, field)
-			case "relationshipNode":
-				return ec.fieldContext_ObjectRelationshipObjectNode_relationshipNode(ctx, field)
-			case "toObjectNode":
-				return ec.fieldContext_ObjectRelationshipObjectNode_toObjectNode(ctx, field)
+			case "success":
+				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
+			case "typeSchemaNode":
+				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipObjectNode", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_renamePropertyOnTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationshipResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
+func (ec *executionContext) _Mutation_removePropertiesFromTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_removePropertiesFromTypeSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7094,7 +8233,7 @@ func (ec *executionContext) _ObjectRelationshipResponse_success(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Success, nil
+		return ec.resolvers.Mutation().RemovePropertiesFromTypeSchemaNode(rctx, fc.Args["id"].(string), fc.Args["properties"].([]string), fc.Args["propagate"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7106,26 +8245,45 @@ func (ec *executionContext) _ObjectRelationshipResponse_success(ctx context.Cont
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.TypeSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationshipResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_removePropertiesFromTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationshipResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
+			case "typeSchemaNode":
+				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_removePropertiesFromTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationshipResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
+func (ec *executionContext) _Mutation_setJsonSchemaOnTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setJsonSchemaOnTypeSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7138,35 +8296,57 @@ func (ec *executionContext) _ObjectRelationshipResponse_message(ctx context.Cont
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return ec.resolvers.Mutation().SetJSONSchemaOnTypeSchemaNode(rctx, fc.Args["id"].(string), fc.Args["property"].(string), fc.Args["schema"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.TypeSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationshipResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setJsonSchemaOnTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationshipResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
+			case "typeSchemaNode":
+				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setJsonSchemaOnTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationshipResponse_objectRelationship(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
+func (ec *executionContext) _Mutation_setPropertyConstraintOnTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setPropertyConstraintOnTypeSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7179,49 +8359,57 @@ func (ec *executionContext) _ObjectRelationshipResponse_objectRelationship(ctx c
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ObjectRelationship, nil
+		return ec.resolvers.Mutation().SetPropertyConstraintOnTypeSchemaNode(rctx, fc.Args["id"].(string), fc.Args["property"].(string), fc.Args["constraint"].(*model.PropertyConstraintInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectRelationship)
+	res := resTmp.(*model.TypeSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalOObjectRelationship2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationship(ctx, field.Selections, res)
+	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationshipResponse_objectRelationship(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setPropertyConstraintOnTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationshipResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_ObjectRelationship_id(ctx, field)
-			case "name":
-				return ec.fieldContext_ObjectRelationship_name(ctx, field)
-			case "originalName":
-				return ec.fieldContext_ObjectRelationship_originalName(ctx, field)
-			case "properties":
-				return ec.fieldContext_ObjectRelationship_properties(ctx, field)
-			case "fromObjectNodeId":
-				return ec.fieldContext_ObjectRelationship_fromObjectNodeId(ctx, field)
-			case "toObjectNodeId":
-				return ec.fieldContext_ObjectRelationship_toObjectNodeId(ctx, field)
+			case "success":
+				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
+			case "typeSchemaNode":
+				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationship", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setPropertyConstraintOnTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationshipsResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipsResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationshipsResponse_success(ctx, field)
+func (ec *executionContext) _Mutation_setComputedPropertyOnTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setComputedPropertyOnTypeSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7234,7 +8422,7 @@ func (ec *executionContext) _ObjectRelationshipsResponse_success(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Success, nil
+		return ec.resolvers.Mutation().SetComputedPropertyOnTypeSchemaNode(rctx, fc.Args["id"].(string), fc.Args["property"].(string), fc.Args["expression"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7246,26 +8434,45 @@ func (ec *executionContext) _ObjectRelationshipsResponse_success(ctx context.Con
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.TypeSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationshipsResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setComputedPropertyOnTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationshipsResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
-		},
-	}
-	return fc, nil
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
+			case "typeSchemaNode":
+				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setComputedPropertyOnTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationshipsResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipsResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationshipsResponse_message(ctx, field)
+func (ec *executionContext) _Mutation_setParentOnTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setParentOnTypeSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7278,35 +8485,57 @@ func (ec *executionContext) _ObjectRelationshipsResponse_message(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return ec.resolvers.Mutation().SetParentOnTypeSchemaNode(rctx, fc.Args["id"].(string), fc.Args["parentTypeSchemaNodeId"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.TypeSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationshipsResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setParentOnTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationshipsResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
+			case "typeSchemaNode":
+				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_setParentOnTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ObjectRelationshipsResponse_objectRelationships(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipsResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_ObjectRelationshipsResponse_objectRelationships(ctx, field)
+func (ec *executionContext) _Mutation_deleteTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteTypeSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7319,49 +8548,57 @@ func (ec *executionContext) _ObjectRelationshipsResponse_objectRelationships(ctx
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ObjectRelationships, nil
+		return ec.resolvers.Mutation().DeleteTypeSchemaNode(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.ObjectRelationship)
+	res := resTmp.(*model.TypeSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalOObjectRelationship2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipᚄ(ctx, field.Selections, res)
+	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_ObjectRelationshipsResponse_objectRelationships(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ObjectRelationshipsResponse",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_ObjectRelationship_id(ctx, field)
-			case "name":
-				return ec.fieldContext_ObjectRelationship_name(ctx, field)
-			case "originalName":
-				return ec.fieldContext_ObjectRelationship_originalName(ctx, field)
-			case "properties":
-				return ec.fieldContext_ObjectRelationship_properties(ctx, field)
-			case "fromObjectNodeId":
-				return ec.fieldContext_ObjectRelationship_fromObjectNodeId(ctx, field)
-			case "toObjectNodeId":
-				return ec.fieldContext_ObjectRelationship_toObjectNodeId(ctx, field)
+			case "success":
+				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
+			case "typeSchemaNode":
+				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationship", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_deleteTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Property_key(ctx context.Context, field graphql.CollectedField, obj *model.Property) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Property_key(ctx, field)
+func (ec *executionContext) _Mutation_createRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_createRelationshipSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7374,7 +8611,7 @@ func (ec *executionContext) _Property_key(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Key, nil
+		return ec.resolvers.Mutation().CreateRelationshipSchemaNode(rctx, fc.Args["name"].(string), fc.Args["domain"].(string), fc.Args["fromTypeSchemaNodeId"].(string), fc.Args["toTypeSchemaNodeId"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7386,26 +8623,45 @@ func (ec *executionContext) _Property_key(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.RelationshipSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Property_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_createRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Property",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
+			case "relationshipSchemaNode":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_createRelationshipSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Property_value(ctx context.Context, field graphql.CollectedField, obj *model.Property) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Property_value(ctx, field)
+func (ec *executionContext) _Mutation_renameRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_renameRelationshipSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7418,7 +8674,7 @@ func (ec *executionContext) _Property_value(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Value, nil
+		return ec.resolvers.Mutation().RenameRelationshipSchemaNode(rctx, fc.Args["id"].(string), fc.Args["newName"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7430,26 +8686,45 @@ func (ec *executionContext) _Property_value(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.(any)
+	res := resTmp.(*model.RelationshipSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalNAny2interface(ctx, field.Selections, res)
+	return ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Property_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_renameRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Property",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Any does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
+			case "relationshipSchemaNode":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_renameRelationshipSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Property_type(ctx context.Context, field graphql.CollectedField, obj *model.Property) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Property_type(ctx, field)
+func (ec *executionContext) _Mutation_updatePropertiesOnRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_updatePropertiesOnRelationshipSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7462,7 +8737,7 @@ func (ec *executionContext) _Property_type(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return ec.resolvers.Mutation().UpdatePropertiesOnRelationshipSchemaNode(rctx, fc.Args["id"].(string), fc.Args["properties"].([]*model.PropertyInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7474,26 +8749,45 @@ func (ec *executionContext) _Property_type(ctx context.Context, field graphql.Co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(model.PropertyType)
+	res := resTmp.(*model.RelationshipSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalNPropertyType2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyType(ctx, field.Selections, res)
+	return ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Property_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_updatePropertiesOnRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Property",
+		Object:     "Mutation",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type PropertyType does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
+			case "relationshipSchemaNode":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Mutation_updatePropertiesOnRelationshipSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getObjectNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getObjectNode(ctx, field)
+func (ec *executionContext) _Mutation_renamePropertyOnRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_renamePropertyOnRelationshipSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7506,7 +8800,7 @@ func (ec *executionContext) _Query_getObjectNode(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetObjectNode(rctx, fc.Args["id"].(string))
+		return ec.resolvers.Mutation().RenamePropertyOnRelationshipSchemaNode(rctx, fc.Args["id"].(string), fc.Args["oldPropertyName"].(string), fc.Args["newPropertyName"].(string), fc.Args["propagate"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7518,27 +8812,27 @@ func (ec *executionContext) _Query_getObjectNode(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectNodeResponse)
+	res := resTmp.(*model.RelationshipSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getObjectNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_renamePropertyOnRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "success":
-				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
+				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
 			case "message":
-				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
-			case "objectNode":
-				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
+				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
+			case "relationshipSchemaNode":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
 		},
 	}
 	defer func() {
@@ -7548,15 +8842,15 @@ func (ec *executionContext) fieldContext_Query_getObjectNode(ctx context.Context
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getObjectNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_renamePropertyOnRelationshipSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getObjectNodes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getObjectNodes(ctx, field)
+func (ec *executionContext) _Mutation_removePropertiesFromRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_removePropertiesFromRelationshipSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7569,7 +8863,7 @@ func (ec *executionContext) _Query_getObjectNodes(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetObjectNodes(rctx, fc.Args["domain"].(*string), fc.Args["type"].(*string))
+		return ec.resolvers.Mutation().RemovePropertiesFromRelationshipSchemaNode(rctx, fc.Args["id"].(string), fc.Args["properties"].([]string), fc.Args["propagate"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7581,27 +8875,27 @@ func (ec *executionContext) _Query_getObjectNodes(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectNodesResponse)
+	res := resTmp.(*model.RelationshipSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalNObjectNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodesResponse(ctx, field.Selections, res)
+	return ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getObjectNodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_removePropertiesFromRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "success":
-				return ec.fieldContext_ObjectNodesResponse_success(ctx, field)
+				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
 			case "message":
-				return ec.fieldContext_ObjectNodesResponse_message(ctx, field)
-			case "objectNodes":
-				return ec.fieldContext_ObjectNodesResponse_objectNodes(ctx, field)
+				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
+			case "relationshipSchemaNode":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectNodesResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
 		},
 	}
 	defer func() {
@@ -7611,15 +8905,15 @@ func (ec *executionContext) fieldContext_Query_getObjectNodes(ctx context.Contex
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getObjectNodes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_removePropertiesFromRelationshipSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getObjectNodeRelationship(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getObjectNodeRelationship(ctx, field)
+func (ec *executionContext) _Mutation_setCardinalityOnRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_setCardinalityOnRelationshipSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7632,7 +8926,7 @@ func (ec *executionContext) _Query_getObjectNodeRelationship(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetObjectNodeRelationship(rctx, fc.Args["id"].(string))
+		return ec.resolvers.Mutation().SetCardinalityOnRelationshipSchemaNode(rctx, fc.Args["id"].(string), fc.Args["cardinality"].(*model.RelationshipCardinalityInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7644,27 +8938,27 @@ func (ec *executionContext) _Query_getObjectNodeRelationship(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectRelationshipResponse)
+	res := resTmp.(*model.RelationshipSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx, field.Selections, res)
+	return ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getObjectNodeRelationship(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_setCardinalityOnRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "success":
-				return ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
+				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
 			case "message":
-				return ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
-			case "objectRelationship":
-				return ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
+				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
+			case "relationshipSchemaNode":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
 		},
 	}
 	defer func() {
@@ -7674,15 +8968,15 @@ func (ec *executionContext) fieldContext_Query_getObjectNodeRelationship(ctx con
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getObjectNodeRelationship_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_setCardinalityOnRelationshipSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getObjectNodeOutgoingRelationships(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getObjectNodeOutgoingRelationships(ctx, field)
+func (ec *executionContext) _Mutation_deleteRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_deleteRelationshipSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7695,7 +8989,7 @@ func (ec *executionContext) _Query_getObjectNodeOutgoingRelationships(ctx contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetObjectNodeOutgoingRelationships(rctx, fc.Args["fromObjectNodeId"].(string))
+		return ec.resolvers.Mutation().DeleteRelationshipSchemaNode(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7707,27 +9001,27 @@ func (ec *executionContext) _Query_getObjectNodeOutgoingRelationships(ctx contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectRelationshipsResponse)
+	res := resTmp.(*model.RelationshipSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalNObjectRelationshipsResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipsResponse(ctx, field.Selections, res)
+	return ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getObjectNodeOutgoingRelationships(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_deleteRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "success":
-				return ec.fieldContext_ObjectRelationshipsResponse_success(ctx, field)
+				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
 			case "message":
-				return ec.fieldContext_ObjectRelationshipsResponse_message(ctx, field)
-			case "objectRelationships":
-				return ec.fieldContext_ObjectRelationshipsResponse_objectRelationships(ctx, field)
+				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
+			case "relationshipSchemaNode":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipsResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
 		},
 	}
 	defer func() {
@@ -7737,15 +9031,15 @@ func (ec *executionContext) fieldContext_Query_getObjectNodeOutgoingRelationship
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getObjectNodeOutgoingRelationships_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_deleteRelationshipSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getObjectNodeIncomingRelationships(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getObjectNodeIncomingRelationships(ctx, field)
+func (ec *executionContext) _Mutation_applyDomainSchema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_applyDomainSchema(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7758,7 +9052,7 @@ func (ec *executionContext) _Query_getObjectNodeIncomingRelationships(ctx contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetObjectNodeIncomingRelationships(rctx, fc.Args["toObjectNodeId"].(string))
+		return ec.resolvers.Mutation().ApplyDomainSchema(rctx, fc.Args["document"].(string), fc.Args["dryRun"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7770,27 +9064,29 @@ func (ec *executionContext) _Query_getObjectNodeIncomingRelationships(ctx contex
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.ObjectRelationshipsResponse)
+	res := resTmp.(*model.SchemaPlanResponse)
 	fc.Result = res
-	return ec.marshalNObjectRelationshipsResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipsResponse(ctx, field.Selections, res)
+	return ec.marshalNSchemaPlanResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaPlanResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getObjectNodeIncomingRelationships(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_applyDomainSchema(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "success":
-				return ec.fieldContext_ObjectRelationshipsResponse_success(ctx, field)
+				return ec.fieldContext_SchemaPlanResponse_success(ctx, field)
 			case "message":
-				return ec.fieldContext_ObjectRelationshipsResponse_message(ctx, field)
-			case "objectRelationships":
-				return ec.fieldContext_ObjectRelationshipsResponse_objectRelationships(ctx, field)
+				return ec.fieldContext_SchemaPlanResponse_message(ctx, field)
+			case "dryRun":
+				return ec.fieldContext_SchemaPlanResponse_dryRun(ctx, field)
+			case "changes":
+				return ec.fieldContext_SchemaPlanResponse_changes(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipsResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SchemaPlanResponse", field.Name)
 		},
 	}
 	defer func() {
@@ -7800,15 +9096,15 @@ func (ec *executionContext) fieldContext_Query_getObjectNodeIncomingRelationship
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getObjectNodeIncomingRelationships_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Mutation_applyDomainSchema_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDomainSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getDomainSchemaNode(ctx, field)
+func (ec *executionContext) _Mutation_rebuildConstraints(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Mutation_rebuildConstraints(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7821,7 +9117,7 @@ func (ec *executionContext) _Query_getDomainSchemaNode(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetDomainSchemaNode(rctx, fc.Args["id"].(string))
+		return ec.resolvers.Mutation().RebuildConstraints(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7833,45 +9129,34 @@ func (ec *executionContext) _Query_getDomainSchemaNode(ctx context.Context, fiel
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.DomainSchemaNodeResponse)
+	res := resTmp.(*model.IndexesResponse)
 	fc.Result = res
-	return ec.marshalNDomainSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNIndexesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐIndexesResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getDomainSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Mutation_rebuildConstraints(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "Mutation",
 		Field:      field,
 		IsMethod:   true,
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
 			case "success":
-				return ec.fieldContext_DomainSchemaNodeResponse_success(ctx, field)
+				return ec.fieldContext_IndexesResponse_success(ctx, field)
 			case "message":
-				return ec.fieldContext_DomainSchemaNodeResponse_message(ctx, field)
-			case "domainSchemaNode":
-				return ec.fieldContext_DomainSchemaNodeResponse_domainSchemaNode(ctx, field)
+				return ec.fieldContext_IndexesResponse_message(ctx, field)
+			case "indexes":
+				return ec.fieldContext_IndexesResponse_indexes(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNodeResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type IndexesResponse", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getDomainSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getDomainSchemaNodes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getDomainSchemaNodes(ctx, field)
+func (ec *executionContext) _ObjectNode_id(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectNode_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7884,7 +9169,7 @@ func (ec *executionContext) _Query_getDomainSchemaNodes(ctx context.Context, fie
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetDomainSchemaNodes(rctx)
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7896,34 +9181,26 @@ func (ec *executionContext) _Query_getDomainSchemaNodes(ctx context.Context, fie
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.DomainSchemaNodesResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNDomainSchemaNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodesResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getDomainSchemaNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectNode_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ObjectNode",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_DomainSchemaNodesResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_DomainSchemaNodesResponse_message(ctx, field)
-			case "domainSchemaNodes":
-				return ec.fieldContext_DomainSchemaNodesResponse_domainSchemaNodes(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNodesResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getTypeSchemaNode(ctx, field)
+func (ec *executionContext) _ObjectNode_domain(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectNode_domain(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7936,7 +9213,7 @@ func (ec *executionContext) _Query_getTypeSchemaNode(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetTypeSchemaNode(rctx, fc.Args["id"].(string))
+		return obj.Domain, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -7948,45 +9225,26 @@ func (ec *executionContext) _Query_getTypeSchemaNode(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.TypeSchemaNodeResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectNode_domain(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ObjectNode",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
-			case "typeSchemaNode":
-				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getTypeSchemaNodes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getTypeSchemaNodes(ctx, field)
+func (ec *executionContext) _ObjectNode_name(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectNode_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -7999,7 +9257,7 @@ func (ec *executionContext) _Query_getTypeSchemaNodes(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetTypeSchemaNodes(rctx, fc.Args["domain"].(*string))
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8011,45 +9269,26 @@ func (ec *executionContext) _Query_getTypeSchemaNodes(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.TypeSchemaNodesResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNTypeSchemaNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodesResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getTypeSchemaNodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectNode_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ObjectNode",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_TypeSchemaNodesResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_TypeSchemaNodesResponse_message(ctx, field)
-			case "typeSchemaNodes":
-				return ec.fieldContext_TypeSchemaNodesResponse_typeSchemaNodes(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodesResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getTypeSchemaNodes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getTypeSchemaNodeOutgoingRelationships(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getTypeSchemaNodeOutgoingRelationships(ctx, field)
+func (ec *executionContext) _ObjectNode_type(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectNode_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8062,7 +9301,7 @@ func (ec *executionContext) _Query_getTypeSchemaNodeOutgoingRelationships(ctx co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetTypeSchemaNodeOutgoingRelationships(rctx, fc.Args["id"].(string))
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8074,45 +9313,26 @@ func (ec *executionContext) _Query_getTypeSchemaNodeOutgoingRelationships(ctx co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RelationshipSchemaNodesResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNRelationshipSchemaNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodesResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getTypeSchemaNodeOutgoingRelationships(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectNode_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ObjectNode",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_RelationshipSchemaNodesResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_RelationshipSchemaNodesResponse_message(ctx, field)
-			case "relationshipSchemaNodes":
-				return ec.fieldContext_RelationshipSchemaNodesResponse_relationshipSchemaNodes(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodesResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getTypeSchemaNodeOutgoingRelationships_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getTypeSchemaNodeIncomingRelationships(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getTypeSchemaNodeIncomingRelationships(ctx, field)
+func (ec *executionContext) _ObjectNode_originalName(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectNode_originalName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8125,7 +9345,7 @@ func (ec *executionContext) _Query_getTypeSchemaNodeIncomingRelationships(ctx co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetTypeSchemaNodeIncomingRelationships(rctx, fc.Args["id"].(string))
+		return obj.OriginalName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8137,45 +9357,26 @@ func (ec *executionContext) _Query_getTypeSchemaNodeIncomingRelationships(ctx co
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RelationshipSchemaNodesResponse)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNRelationshipSchemaNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodesResponse(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getTypeSchemaNodeIncomingRelationships(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectNode_originalName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ObjectNode",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_RelationshipSchemaNodesResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_RelationshipSchemaNodesResponse_message(ctx, field)
-			case "relationshipSchemaNodes":
-				return ec.fieldContext_RelationshipSchemaNodesResponse_relationshipSchemaNodes(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodesResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getTypeSchemaNodeIncomingRelationships_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getRelationshipSchemaNode(ctx, field)
+func (ec *executionContext) _ObjectNode_labels(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectNode_labels(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8188,57 +9389,35 @@ func (ec *executionContext) _Query_getRelationshipSchemaNode(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetRelationshipSchemaNode(rctx, fc.Args["id"].(string))
+		return obj.Labels, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RelationshipSchemaNodeResponse)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectNode_labels(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ObjectNode",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
-			case "relationshipSchemaNode":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getRelationshipSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_getRelationshipSchemaNodes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query_getRelationshipSchemaNodes(ctx, field)
+func (ec *executionContext) _ObjectNode_properties(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectNode_properties(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8251,57 +9430,45 @@ func (ec *executionContext) _Query_getRelationshipSchemaNodes(ctx context.Contex
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Query().GetRelationshipSchemaNodes(rctx, fc.Args["domain"].(*string))
+		return obj.Properties, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RelationshipSchemaNodesResponse)
+	res := resTmp.([]*model.Property)
 	fc.Result = res
-	return ec.marshalNRelationshipSchemaNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodesResponse(ctx, field.Selections, res)
+	return ec.marshalOProperty2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query_getRelationshipSchemaNodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectNode_properties(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ObjectNode",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "success":
-				return ec.fieldContext_RelationshipSchemaNodesResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_RelationshipSchemaNodesResponse_message(ctx, field)
-			case "relationshipSchemaNodes":
-				return ec.fieldContext_RelationshipSchemaNodesResponse_relationshipSchemaNodes(ctx, field)
+			case "key":
+				return ec.fieldContext_Property_key(ctx, field)
+			case "value":
+				return ec.fieldContext_Property_value(ctx, field)
+			case "type":
+				return ec.fieldContext_Property_type(ctx, field)
+			case "readOnly":
+				return ec.fieldContext_Property_readOnly(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodesResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_getRelationshipSchemaNodes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___type(ctx, field)
+func (ec *executionContext) _ObjectNodeResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectNodeResponse_success(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8314,68 +9481,38 @@ func (ec *executionContext) _Query___type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectType(fc.Args["name"].(string))
+		return obj.Success, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectNodeResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ObjectNodeResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Query___schema(ctx, field)
+func (ec *executionContext) _ObjectNodeResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectNodeResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8388,7 +9525,7 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.introspectSchema()
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8397,40 +9534,26 @@ func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.C
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Schema)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectNodeResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "ObjectNodeResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "description":
-				return ec.fieldContext___Schema_description(ctx, field)
-			case "types":
-				return ec.fieldContext___Schema_types(ctx, field)
-			case "queryType":
-				return ec.fieldContext___Schema_queryType(ctx, field)
-			case "mutationType":
-				return ec.fieldContext___Schema_mutationType(ctx, field)
-			case "subscriptionType":
-				return ec.fieldContext___Schema_subscriptionType(ctx, field)
-			case "directives":
-				return ec.fieldContext___Schema_directives(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RelationshipSchemaNode_id(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelationshipSchemaNode_id(ctx, field)
+func (ec *executionContext) _ObjectNodeResponse_objectNode(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8443,38 +9566,51 @@ func (ec *executionContext) _RelationshipSchemaNode_id(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.ObjectNode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.ObjectNode)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOObjectNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelationshipSchemaNode_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectNodeResponse_objectNode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelationshipSchemaNode",
+		Object:     "ObjectNodeResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ObjectNode_id(ctx, field)
+			case "domain":
+				return ec.fieldContext_ObjectNode_domain(ctx, field)
+			case "name":
+				return ec.fieldContext_ObjectNode_name(ctx, field)
+			case "type":
+				return ec.fieldContext_ObjectNode_type(ctx, field)
+			case "originalName":
+				return ec.fieldContext_ObjectNode_originalName(ctx, field)
+			case "labels":
+				return ec.fieldContext_ObjectNode_labels(ctx, field)
+			case "properties":
+				return ec.fieldContext_ObjectNode_properties(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNode", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RelationshipSchemaNode_domain(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelationshipSchemaNode_domain(ctx, field)
+func (ec *executionContext) _ObjectNodesOrRelationshipNodesResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodesOrRelationshipNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectNodesOrRelationshipNodesResponse_success(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8487,7 +9623,7 @@ func (ec *executionContext) _RelationshipSchemaNode_domain(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Domain, nil
+		return obj.Success, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8499,26 +9635,26 @@ func (ec *executionContext) _RelationshipSchemaNode_domain(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelationshipSchemaNode_domain(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectNodesOrRelationshipNodesResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelationshipSchemaNode",
+		Object:     "ObjectNodesOrRelationshipNodesResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RelationshipSchemaNode_name(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelationshipSchemaNode_name(ctx, field)
+func (ec *executionContext) _ObjectNodesOrRelationshipNodesResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodesOrRelationshipNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectNodesOrRelationshipNodesResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8531,26 +9667,23 @@ func (ec *executionContext) _RelationshipSchemaNode_name(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelationshipSchemaNode_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectNodesOrRelationshipNodesResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelationshipSchemaNode",
+		Object:     "ObjectNodesOrRelationshipNodesResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8561,8 +9694,8 @@ func (ec *executionContext) fieldContext_RelationshipSchemaNode_name(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _RelationshipSchemaNode_originalName(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelationshipSchemaNode_originalName(ctx, field)
+func (ec *executionContext) _ObjectNodesOrRelationshipNodesResponse_objectNodesOrRelationshipNodes(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodesOrRelationshipNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectNodesOrRelationshipNodesResponse_objectNodesOrRelationshipNodes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8575,38 +9708,35 @@ func (ec *executionContext) _RelationshipSchemaNode_originalName(ctx context.Con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OriginalName, nil
+		return obj.ObjectNodesOrRelationshipNodes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]model.ObjectNodeOrRelationshipNode)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOObjectNodeOrRelationshipNode2ᚕgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeOrRelationshipNodeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelationshipSchemaNode_originalName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectNodesOrRelationshipNodesResponse_objectNodesOrRelationshipNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelationshipSchemaNode",
+		Object:     "ObjectNodesOrRelationshipNodesResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ObjectNodeOrRelationshipNode does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RelationshipSchemaNode_type(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelationshipSchemaNode_type(ctx, field)
+func (ec *executionContext) _ObjectNodesResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectNodesResponse_success(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8619,7 +9749,7 @@ func (ec *executionContext) _RelationshipSchemaNode_type(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.Success, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8631,26 +9761,26 @@ func (ec *executionContext) _RelationshipSchemaNode_type(ctx context.Context, fi
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelationshipSchemaNode_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectNodesResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelationshipSchemaNode",
+		Object:     "ObjectNodesResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RelationshipSchemaNode_fromTypeSchemaNodeId(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelationshipSchemaNode_fromTypeSchemaNodeId(ctx, field)
+func (ec *executionContext) _ObjectNodesResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectNodesResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8663,26 +9793,23 @@ func (ec *executionContext) _RelationshipSchemaNode_fromTypeSchemaNodeId(ctx con
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.FromTypeSchemaNodeID, nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelationshipSchemaNode_fromTypeSchemaNodeId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectNodesResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelationshipSchemaNode",
+		Object:     "ObjectNodesResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8693,8 +9820,8 @@ func (ec *executionContext) fieldContext_RelationshipSchemaNode_fromTypeSchemaNo
 	return fc, nil
 }
 
-func (ec *executionContext) _RelationshipSchemaNode_toTypeSchemaNodeId(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelationshipSchemaNode_toTypeSchemaNodeId(ctx, field)
+func (ec *executionContext) _ObjectNodesResponse_objectNodes(ctx context.Context, field graphql.CollectedField, obj *model.ObjectNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectNodesResponse_objectNodes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8707,38 +9834,51 @@ func (ec *executionContext) _RelationshipSchemaNode_toTypeSchemaNodeId(ctx conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ToTypeSchemaNodeID, nil
+		return obj.ObjectNodes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.([]*model.ObjectNode)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOObjectNode2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelationshipSchemaNode_toTypeSchemaNodeId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectNodesResponse_objectNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelationshipSchemaNode",
+		Object:     "ObjectNodesResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ObjectNode_id(ctx, field)
+			case "domain":
+				return ec.fieldContext_ObjectNode_domain(ctx, field)
+			case "name":
+				return ec.fieldContext_ObjectNode_name(ctx, field)
+			case "type":
+				return ec.fieldContext_ObjectNode_type(ctx, field)
+			case "originalName":
+				return ec.fieldContext_ObjectNode_originalName(ctx, field)
+			case "labels":
+				return ec.fieldContext_ObjectNode_labels(ctx, field)
+			case "properties":
+				return ec.fieldContext_ObjectNode_properties(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNode", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RelationshipSchemaNode_properties(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelationshipSchemaNode_properties(ctx, field)
+func (ec *executionContext) _ObjectRelationship_id(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationship) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationship_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8751,43 +9891,38 @@ func (ec *executionContext) _RelationshipSchemaNode_properties(ctx context.Conte
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Properties, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Property)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOProperty2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelationshipSchemaNode_properties(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationship_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelationshipSchemaNode",
+		Object:     "ObjectRelationship",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "key":
-				return ec.fieldContext_Property_key(ctx, field)
-			case "value":
-				return ec.fieldContext_Property_value(ctx, field)
-			case "type":
-				return ec.fieldContext_Property_type(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RelationshipSchemaNode_labels(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelationshipSchemaNode_labels(ctx, field)
+func (ec *executionContext) _ObjectRelationship_name(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationship) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationship_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8800,23 +9935,26 @@ func (ec *executionContext) _RelationshipSchemaNode_labels(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Labels, nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelationshipSchemaNode_labels(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationship_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelationshipSchemaNode",
+		Object:     "ObjectRelationship",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8827,8 +9965,8 @@ func (ec *executionContext) fieldContext_RelationshipSchemaNode_labels(_ context
 	return fc, nil
 }
 
-func (ec *executionContext) _RelationshipSchemaNodeResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNodeResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
+func (ec *executionContext) _ObjectRelationship_originalName(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationship) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationship_originalName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8841,7 +9979,7 @@ func (ec *executionContext) _RelationshipSchemaNodeResponse_success(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Success, nil
+		return obj.OriginalName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8853,26 +9991,26 @@ func (ec *executionContext) _RelationshipSchemaNodeResponse_success(ctx context.
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelationshipSchemaNodeResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationship_originalName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelationshipSchemaNodeResponse",
+		Object:     "ObjectRelationship",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RelationshipSchemaNodeResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNodeResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
+func (ec *executionContext) _ObjectRelationship_properties(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationship) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationship_properties(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8885,7 +10023,7 @@ func (ec *executionContext) _RelationshipSchemaNodeResponse_message(ctx context.
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.Properties, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8894,26 +10032,36 @@ func (ec *executionContext) _RelationshipSchemaNodeResponse_message(ctx context.
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.([]*model.Property)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalOProperty2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelationshipSchemaNodeResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationship_properties(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelationshipSchemaNodeResponse",
+		Object:     "ObjectRelationship",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "key":
+				return ec.fieldContext_Property_key(ctx, field)
+			case "value":
+				return ec.fieldContext_Property_value(ctx, field)
+			case "type":
+				return ec.fieldContext_Property_type(ctx, field)
+			case "readOnly":
+				return ec.fieldContext_Property_readOnly(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNodeResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
+func (ec *executionContext) _ObjectRelationship_fromObjectNodeId(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationship) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationship_fromObjectNodeId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8926,55 +10074,38 @@ func (ec *executionContext) _RelationshipSchemaNodeResponse_relationshipSchemaNo
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RelationshipSchemaNode, nil
+		return obj.FromObjectNodeID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.RelationshipSchemaNode)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalORelationshipSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNode(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationship_fromObjectNodeId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelationshipSchemaNodeResponse",
+		Object:     "ObjectRelationship",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_RelationshipSchemaNode_id(ctx, field)
-			case "domain":
-				return ec.fieldContext_RelationshipSchemaNode_domain(ctx, field)
-			case "name":
-				return ec.fieldContext_RelationshipSchemaNode_name(ctx, field)
-			case "originalName":
-				return ec.fieldContext_RelationshipSchemaNode_originalName(ctx, field)
-			case "type":
-				return ec.fieldContext_RelationshipSchemaNode_type(ctx, field)
-			case "fromTypeSchemaNodeId":
-				return ec.fieldContext_RelationshipSchemaNode_fromTypeSchemaNodeId(ctx, field)
-			case "toTypeSchemaNodeId":
-				return ec.fieldContext_RelationshipSchemaNode_toTypeSchemaNodeId(ctx, field)
-			case "properties":
-				return ec.fieldContext_RelationshipSchemaNode_properties(ctx, field)
-			case "labels":
-				return ec.fieldContext_RelationshipSchemaNode_labels(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNode", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RelationshipSchemaNodesResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelationshipSchemaNodesResponse_success(ctx, field)
+func (ec *executionContext) _ObjectRelationship_toObjectNodeId(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationship) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationship_toObjectNodeId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -8987,7 +10118,7 @@ func (ec *executionContext) _RelationshipSchemaNodesResponse_success(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Success, nil
+		return obj.ToObjectNodeID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -8999,26 +10130,26 @@ func (ec *executionContext) _RelationshipSchemaNodesResponse_success(ctx context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelationshipSchemaNodesResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationship_toObjectNodeId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelationshipSchemaNodesResponse",
+		Object:     "ObjectRelationship",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _RelationshipSchemaNodesResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelationshipSchemaNodesResponse_message(ctx, field)
+func (ec *executionContext) _ObjectRelationshipObjectNode_id(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationshipObjectNode_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9031,23 +10162,26 @@ func (ec *executionContext) _RelationshipSchemaNodesResponse_message(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelationshipSchemaNodesResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationshipObjectNode_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelationshipSchemaNodesResponse",
+		Object:     "ObjectRelationshipObjectNode",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9058,8 +10192,8 @@ func (ec *executionContext) fieldContext_RelationshipSchemaNodesResponse_message
 	return fc, nil
 }
 
-func (ec *executionContext) _RelationshipSchemaNodesResponse_relationshipSchemaNodes(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_RelationshipSchemaNodesResponse_relationshipSchemaNodes(ctx, field)
+func (ec *executionContext) _ObjectRelationshipObjectNode_fromObjectNode(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationshipObjectNode_fromObjectNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9072,23 +10206,86 @@ func (ec *executionContext) _RelationshipSchemaNodesResponse_relationshipSchemaN
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.RelationshipSchemaNodes, nil
+		return obj.FromObjectNode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.RelationshipSchemaNode)
+	res := resTmp.(*model.ObjectNode)
 	fc.Result = res
-	return ec.marshalORelationshipSchemaNode2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeᚄ(ctx, field.Selections, res)
+	return ec.marshalNObjectNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_RelationshipSchemaNodesResponse_relationshipSchemaNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationshipObjectNode_fromObjectNode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "RelationshipSchemaNodesResponse",
+		Object:     "ObjectRelationshipObjectNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ObjectNode_id(ctx, field)
+			case "domain":
+				return ec.fieldContext_ObjectNode_domain(ctx, field)
+			case "name":
+				return ec.fieldContext_ObjectNode_name(ctx, field)
+			case "type":
+				return ec.fieldContext_ObjectNode_type(ctx, field)
+			case "originalName":
+				return ec.fieldContext_ObjectNode_originalName(ctx, field)
+			case "labels":
+				return ec.fieldContext_ObjectNode_labels(ctx, field)
+			case "properties":
+				return ec.fieldContext_ObjectNode_properties(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNode", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ObjectRelationshipObjectNode_relationshipNode(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationshipObjectNode_relationshipNode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RelationshipNode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.RelationshipSchemaNode)
+	fc.Result = res
+	return ec.marshalNRelationshipSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ObjectRelationshipObjectNode_relationshipNode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ObjectRelationshipObjectNode",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9108,6 +10305,8 @@ func (ec *executionContext) fieldContext_RelationshipSchemaNodesResponse_relatio
 				return ec.fieldContext_RelationshipSchemaNode_fromTypeSchemaNodeId(ctx, field)
 			case "toTypeSchemaNodeId":
 				return ec.fieldContext_RelationshipSchemaNode_toTypeSchemaNodeId(ctx, field)
+			case "cardinality":
+				return ec.fieldContext_RelationshipSchemaNode_cardinality(ctx, field)
 			case "properties":
 				return ec.fieldContext_RelationshipSchemaNode_properties(ctx, field)
 			case "labels":
@@ -9119,8 +10318,68 @@ func (ec *executionContext) fieldContext_RelationshipSchemaNodesResponse_relatio
 	return fc, nil
 }
 
-func (ec *executionContext) _Response_success(ctx context.Context, field graphql.CollectedField, obj *model.Response) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Response_success(ctx, field)
+func (ec *executionContext) _ObjectRelationshipObjectNode_toObjectNode(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationshipObjectNode_toObjectNode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ToObjectNode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*model.ObjectNode)
+	fc.Result = res
+	return ec.marshalNObjectNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_ObjectRelationshipObjectNode_toObjectNode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ObjectRelationshipObjectNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ObjectNode_id(ctx, field)
+			case "domain":
+				return ec.fieldContext_ObjectNode_domain(ctx, field)
+			case "name":
+				return ec.fieldContext_ObjectNode_name(ctx, field)
+			case "type":
+				return ec.fieldContext_ObjectNode_type(ctx, field)
+			case "originalName":
+				return ec.fieldContext_ObjectNode_originalName(ctx, field)
+			case "labels":
+				return ec.fieldContext_ObjectNode_labels(ctx, field)
+			case "properties":
+				return ec.fieldContext_ObjectNode_properties(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNode", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ObjectRelationshipObjectNodeResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNodeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationshipObjectNodeResponse_success(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9150,9 +10409,9 @@ func (ec *executionContext) _Response_success(ctx context.Context, field graphql
 	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Response_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationshipObjectNodeResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Response",
+		Object:     "ObjectRelationshipObjectNodeResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9163,8 +10422,8 @@ func (ec *executionContext) fieldContext_Response_success(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Response_message(ctx context.Context, field graphql.CollectedField, obj *model.Response) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Response_message(ctx, field)
+func (ec *executionContext) _ObjectRelationshipObjectNodeResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNodeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationshipObjectNodeResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9191,9 +10450,9 @@ func (ec *executionContext) _Response_message(ctx context.Context, field graphql
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Response_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationshipObjectNodeResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Response",
+		Object:     "ObjectRelationshipObjectNodeResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9204,8 +10463,8 @@ func (ec *executionContext) fieldContext_Response_message(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _Response_data(ctx context.Context, field graphql.CollectedField, obj *model.Response) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_Response_data(ctx, field)
+func (ec *executionContext) _ObjectRelationshipObjectNodeResponse_objectRelationshipObjectNodes(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNodeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationshipObjectNodeResponse_objectRelationshipObjectNodes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -9218,7 +10477,7 @@ func (ec *executionContext) _Response_data(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Data, nil
+		return obj.ObjectRelationshipObjectNodes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -9227,1016 +10486,713 @@ func (ec *executionContext) _Response_data(ctx context.Context, field graphql.Co
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]map[string]interface{})
+	res := resTmp.(*model.ObjectRelationshipObjectNode)
 	fc.Result = res
-	return ec.marshalOJSON2ᚕmapᚄ(ctx, field.Selections, res)
+	return ec.marshalOObjectRelationshipObjectNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipObjectNode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Response_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationshipObjectNodeResponse_objectRelationshipObjectNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Response",
+		Object:     "ObjectRelationshipObjectNodeResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type JSON does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_ObjectRelationshipObjectNode_id(ctx, field)
+			case "fromObjectNode":
+				return ec.fieldContext_ObjectRelationshipObjectNode_fromObjectNode(ctx, field)
+			case "relationshipNode":
+				return ec.fieldContext_ObjectRelationshipObjectNode_relationshipNode(ctx, field)
+			case "toObjectNode":
+				return ec.fieldContext_ObjectRelationshipObjectNode_toObjectNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipObjectNode", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_objectNodeCreated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_objectNodeCreated(ctx, field)
+func (ec *executionContext) _ObjectRelationshipObjectNodesResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationshipObjectNodesResponse_success(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().ObjectNodeCreated(rctx)
+		return obj.Success, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.ObjectNodeResponse):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_objectNodeCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationshipObjectNodesResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "ObjectRelationshipObjectNodesResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
-			case "objectNode":
-				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_objectNodeUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_objectNodeUpdated(ctx, field)
+func (ec *executionContext) _ObjectRelationshipObjectNodesResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationshipObjectNodesResponse_message(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().ObjectNodeUpdated(rctx)
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.ObjectNodeResponse):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_objectNodeUpdated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationshipObjectNodesResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "ObjectRelationshipObjectNodesResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
-			case "objectNode":
-				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_objectNodeDeleted(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_objectNodeDeleted(ctx, field)
+func (ec *executionContext) _ObjectRelationshipObjectNodesResponse_objectRelationshipObjectNodes(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipObjectNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationshipObjectNodesResponse_objectRelationshipObjectNodes(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().ObjectNodeDeleted(rctx)
+		return obj.ObjectRelationshipObjectNodes, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.ObjectNodeResponse):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.([]*model.ObjectRelationshipObjectNode)
+	fc.Result = res
+	return ec.marshalOObjectRelationshipObjectNode2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipObjectNodeᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_objectNodeDeleted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationshipObjectNodesResponse_objectRelationshipObjectNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "ObjectRelationshipObjectNodesResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
-			case "objectNode":
-				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
+			case "id":
+				return ec.fieldContext_ObjectRelationshipObjectNode_id(ctx, field)
+			case "fromObjectNode":
+				return ec.fieldContext_ObjectRelationshipObjectNode_fromObjectNode(ctx, field)
+			case "relationshipNode":
+				return ec.fieldContext_ObjectRelationshipObjectNode_relationshipNode(ctx, field)
+			case "toObjectNode":
+				return ec.fieldContext_ObjectRelationshipObjectNode_toObjectNode(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipObjectNode", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_objectRelationshipCreated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_objectRelationshipCreated(ctx, field)
+func (ec *executionContext) _ObjectRelationshipResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().ObjectRelationshipCreated(rctx)
+		return obj.Success, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.ObjectRelationshipResponse):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_objectRelationshipCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationshipResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "ObjectRelationshipResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
-			case "objectRelationship":
-				return ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipResponse", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_objectRelationshipUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_objectRelationshipUpdated(ctx, field)
+func (ec *executionContext) _ObjectRelationshipResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().ObjectRelationshipUpdated(rctx)
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.ObjectRelationshipResponse):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_objectRelationshipUpdated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationshipResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "ObjectRelationshipResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
-			case "objectRelationship":
-				return ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_objectRelationshipDeleted(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_objectRelationshipDeleted(ctx, field)
+func (ec *executionContext) _ObjectRelationshipResponse_objectRelationship(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().ObjectRelationshipDeleted(rctx)
+		return obj.ObjectRelationship, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.ObjectRelationshipResponse):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(*model.ObjectRelationship)
+	fc.Result = res
+	return ec.marshalOObjectRelationship2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationship(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_objectRelationshipDeleted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationshipResponse_objectRelationship(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "ObjectRelationshipResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "success":
-				return ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
-			case "objectRelationship":
-				return ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipResponse", field.Name)
+			case "id":
+				return ec.fieldContext_ObjectRelationship_id(ctx, field)
+			case "name":
+				return ec.fieldContext_ObjectRelationship_name(ctx, field)
+			case "originalName":
+				return ec.fieldContext_ObjectRelationship_originalName(ctx, field)
+			case "properties":
+				return ec.fieldContext_ObjectRelationship_properties(ctx, field)
+			case "fromObjectNodeId":
+				return ec.fieldContext_ObjectRelationship_fromObjectNodeId(ctx, field)
+			case "toObjectNodeId":
+				return ec.fieldContext_ObjectRelationship_toObjectNodeId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationship", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_domainSchemaNodeCreated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_domainSchemaNodeCreated(ctx, field)
+func (ec *executionContext) _ObjectRelationshipsResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationshipsResponse_success(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().DomainSchemaNodeCreated(rctx)
+		return obj.Success, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.DomainSchemaNodeResponse):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNDomainSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_domainSchemaNodeCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationshipsResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "ObjectRelationshipsResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_DomainSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_DomainSchemaNodeResponse_message(ctx, field)
-			case "domainSchemaNode":
-				return ec.fieldContext_DomainSchemaNodeResponse_domainSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_domainSchemaNodeUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_domainSchemaNodeUpdated(ctx, field)
+func (ec *executionContext) _ObjectRelationshipsResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationshipsResponse_message(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().DomainSchemaNodeUpdated(rctx)
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.DomainSchemaNodeResponse):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNDomainSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_domainSchemaNodeUpdated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationshipsResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "ObjectRelationshipsResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_DomainSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_DomainSchemaNodeResponse_message(ctx, field)
-			case "domainSchemaNode":
-				return ec.fieldContext_DomainSchemaNodeResponse_domainSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_domainSchemaNodeDeleted(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_domainSchemaNodeDeleted(ctx, field)
+func (ec *executionContext) _ObjectRelationshipsResponse_objectRelationships(ctx context.Context, field graphql.CollectedField, obj *model.ObjectRelationshipsResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_ObjectRelationshipsResponse_objectRelationships(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().DomainSchemaNodeDeleted(rctx)
+		return obj.ObjectRelationships, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.DomainSchemaNodeResponse):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNDomainSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.([]*model.ObjectRelationship)
+	fc.Result = res
+	return ec.marshalOObjectRelationship2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_domainSchemaNodeDeleted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ObjectRelationshipsResponse_objectRelationships(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "ObjectRelationshipsResponse",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "success":
-				return ec.fieldContext_DomainSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_DomainSchemaNodeResponse_message(ctx, field)
-			case "domainSchemaNode":
-				return ec.fieldContext_DomainSchemaNodeResponse_domainSchemaNode(ctx, field)
+			case "id":
+				return ec.fieldContext_ObjectRelationship_id(ctx, field)
+			case "name":
+				return ec.fieldContext_ObjectRelationship_name(ctx, field)
+			case "originalName":
+				return ec.fieldContext_ObjectRelationship_originalName(ctx, field)
+			case "properties":
+				return ec.fieldContext_ObjectRelationship_properties(ctx, field)
+			case "fromObjectNodeId":
+				return ec.fieldContext_ObjectRelationship_fromObjectNodeId(ctx, field)
+			case "toObjectNodeId":
+				return ec.fieldContext_ObjectRelationship_toObjectNodeId(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNodeResponse", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationship", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_typeSchemaNodeCreated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_typeSchemaNodeCreated(ctx, field)
+func (ec *executionContext) _Property_key(ctx context.Context, field graphql.CollectedField, obj *model.Property) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Property_key(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().TypeSchemaNodeCreated(rctx)
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.TypeSchemaNodeResponse):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_typeSchemaNodeCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Property_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "Property",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
-			case "typeSchemaNode":
-				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_typeSchemaNodeUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_typeSchemaNodeUpdated(ctx, field)
+func (ec *executionContext) _Property_value(ctx context.Context, field graphql.CollectedField, obj *model.Property) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Property_value(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().TypeSchemaNodeUpdated(rctx)
+		return obj.Value, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.TypeSchemaNodeResponse):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(interface{})
+	fc.Result = res
+	return ec.marshalNAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_typeSchemaNodeUpdated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Property_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "Property",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
-			case "typeSchemaNode":
-				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type Any does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_typeSchemaNodeDeleted(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_typeSchemaNodeDeleted(ctx, field)
+func (ec *executionContext) _Property_type(ctx context.Context, field graphql.CollectedField, obj *model.Property) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Property_type(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().TypeSchemaNodeDeleted(rctx)
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.TypeSchemaNodeResponse):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(model.PropertyType)
+	fc.Result = res
+	return ec.marshalNPropertyType2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_typeSchemaNodeDeleted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Property_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "Property",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
-			case "typeSchemaNode":
-				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type PropertyType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_relationshipSchemaNodeCreated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_relationshipSchemaNodeCreated(ctx, field)
+func (ec *executionContext) _Property_readOnly(ctx context.Context, field graphql.CollectedField, obj *model.Property) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Property_readOnly(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().RelationshipSchemaNodeCreated(rctx)
+		return obj.ReadOnly, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.RelationshipSchemaNodeResponse):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_relationshipSchemaNodeCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Property_readOnly(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "Property",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
-			case "relationshipSchemaNode":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_relationshipSchemaNodeUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_relationshipSchemaNodeUpdated(ctx, field)
+func (ec *executionContext) _PropertyConstraint_key(ctx context.Context, field graphql.CollectedField, obj *model.PropertyConstraint) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PropertyConstraint_key(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().RelationshipSchemaNodeUpdated(rctx)
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
 		if !graphql.HasFieldError(ctx, fc) {
 			ec.Errorf(ctx, "must not be null")
 		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.RelationshipSchemaNodeResponse):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_relationshipSchemaNodeUpdated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PropertyConstraint_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "PropertyConstraint",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
-			case "relationshipSchemaNode":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Subscription_relationshipSchemaNodeDeleted(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
-	fc, err := ec.fieldContext_Subscription_relationshipSchemaNodeDeleted(ctx, field)
+func (ec *executionContext) _PropertyConstraint_allowedValues(ctx context.Context, field graphql.CollectedField, obj *model.PropertyConstraint) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PropertyConstraint_allowedValues(ctx, field)
 	if err != nil {
-		return nil
+		return graphql.Null
 	}
 	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
 			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = nil
+			ret = graphql.Null
 		}
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return ec.resolvers.Subscription().RelationshipSchemaNodeDeleted(rctx)
+		return obj.AllowedValues, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
-		return nil
+		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
-		return nil
-	}
-	return func(ctx context.Context) graphql.Marshaler {
-		select {
-		case res, ok := <-resTmp.(<-chan *model.RelationshipSchemaNodeResponse):
-			if !ok {
-				return nil
-			}
-			return graphql.WriterFunc(func(w io.Writer) {
-				w.Write([]byte{'{'})
-				graphql.MarshalString(field.Alias).MarshalGQL(w)
-				w.Write([]byte{':'})
-				ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
-				w.Write([]byte{'}'})
-			})
-		case <-ctx.Done():
-			return nil
-		}
+		return graphql.Null
 	}
+	res := resTmp.([]interface{})
+	fc.Result = res
+	return ec.marshalOAny2ᚕinterfaceᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_Subscription_relationshipSchemaNodeDeleted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PropertyConstraint_allowedValues(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Subscription",
+		Object:     "PropertyConstraint",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "success":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
-			case "message":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
-			case "relationshipSchemaNode":
-				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
+			return nil, errors.New("field of type Any does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TypeSchemaNode_id(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TypeSchemaNode_id(ctx, field)
+func (ec *executionContext) _PropertyConstraint_min(ctx context.Context, field graphql.CollectedField, obj *model.PropertyConstraint) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PropertyConstraint_min(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10249,38 +11205,35 @@ func (ec *executionContext) _TypeSchemaNode_id(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.ID, nil
+		return obj.Min, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TypeSchemaNode_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PropertyConstraint_min(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TypeSchemaNode",
+		Object:     "PropertyConstraint",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TypeSchemaNode_domain(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TypeSchemaNode_domain(ctx, field)
+func (ec *executionContext) _PropertyConstraint_max(ctx context.Context, field graphql.CollectedField, obj *model.PropertyConstraint) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PropertyConstraint_max(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10293,38 +11246,35 @@ func (ec *executionContext) _TypeSchemaNode_domain(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Domain, nil
+		return obj.Max, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*float64)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOFloat2ᚖfloat64(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TypeSchemaNode_domain(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PropertyConstraint_max(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TypeSchemaNode",
+		Object:     "PropertyConstraint",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TypeSchemaNode_name(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TypeSchemaNode_name(ctx, field)
+func (ec *executionContext) _PropertyConstraint_minLength(ctx context.Context, field graphql.CollectedField, obj *model.PropertyConstraint) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PropertyConstraint_minLength(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10337,38 +11287,35 @@ func (ec *executionContext) _TypeSchemaNode_name(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return obj.MinLength, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TypeSchemaNode_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PropertyConstraint_minLength(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TypeSchemaNode",
+		Object:     "PropertyConstraint",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TypeSchemaNode_type(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TypeSchemaNode_type(ctx, field)
+func (ec *executionContext) _PropertyConstraint_maxLength(ctx context.Context, field graphql.CollectedField, obj *model.PropertyConstraint) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PropertyConstraint_maxLength(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10381,38 +11328,35 @@ func (ec *executionContext) _TypeSchemaNode_type(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return obj.MaxLength, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*int)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOInt2ᚖint(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TypeSchemaNode_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PropertyConstraint_maxLength(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TypeSchemaNode",
+		Object:     "PropertyConstraint",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TypeSchemaNode_originalName(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TypeSchemaNode_originalName(ctx, field)
+func (ec *executionContext) _PropertyConstraint_pattern(ctx context.Context, field graphql.CollectedField, obj *model.PropertyConstraint) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PropertyConstraint_pattern(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10425,26 +11369,23 @@ func (ec *executionContext) _TypeSchemaNode_originalName(ctx context.Context, fi
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OriginalName, nil
+		return obj.Pattern, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*string)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TypeSchemaNode_originalName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PropertyConstraint_pattern(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TypeSchemaNode",
+		Object:     "PropertyConstraint",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10455,8 +11396,8 @@ func (ec *executionContext) fieldContext_TypeSchemaNode_originalName(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _TypeSchemaNode_labels(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TypeSchemaNode_labels(ctx, field)
+func (ec *executionContext) _PropertyConstraint_unique(ctx context.Context, field graphql.CollectedField, obj *model.PropertyConstraint) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PropertyConstraint_unique(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10469,35 +11410,38 @@ func (ec *executionContext) _TypeSchemaNode_labels(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Labels, nil
+		return obj.Unique, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TypeSchemaNode_labels(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PropertyConstraint_unique(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TypeSchemaNode",
+		Object:     "PropertyConstraint",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TypeSchemaNode_properties(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TypeSchemaNode_properties(ctx, field)
+func (ec *executionContext) _PropertyConstraint_defaultValue(ctx context.Context, field graphql.CollectedField, obj *model.PropertyConstraint) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PropertyConstraint_defaultValue(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10510,7 +11454,7 @@ func (ec *executionContext) _TypeSchemaNode_properties(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Properties, nil
+		return obj.DefaultValue, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10519,34 +11463,26 @@ func (ec *executionContext) _TypeSchemaNode_properties(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]*model.Property)
+	res := resTmp.(interface{})
 	fc.Result = res
-	return ec.marshalOProperty2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyᚄ(ctx, field.Selections, res)
+	return ec.marshalOAny2interface(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TypeSchemaNode_properties(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PropertyConstraint_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TypeSchemaNode",
+		Object:     "PropertyConstraint",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "key":
-				return ec.fieldContext_Property_key(ctx, field)
-			case "value":
-				return ec.fieldContext_Property_value(ctx, field)
-			case "type":
-				return ec.fieldContext_Property_type(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
+			return nil, errors.New("field of type Any does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TypeSchemaNodeResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNodeResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
+func (ec *executionContext) _PropertyJsonSchema_key(ctx context.Context, field graphql.CollectedField, obj *model.PropertyJSONSchema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PropertyJsonSchema_key(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10559,7 +11495,7 @@ func (ec *executionContext) _TypeSchemaNodeResponse_success(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Success, nil
+		return obj.Key, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10571,26 +11507,26 @@ func (ec *executionContext) _TypeSchemaNodeResponse_success(ctx context.Context,
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TypeSchemaNodeResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PropertyJsonSchema_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TypeSchemaNodeResponse",
+		Object:     "PropertyJsonSchema",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TypeSchemaNodeResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNodeResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
+func (ec *executionContext) _PropertyJsonSchema_schema(ctx context.Context, field graphql.CollectedField, obj *model.PropertyJSONSchema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_PropertyJsonSchema_schema(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10603,23 +11539,26 @@ func (ec *executionContext) _TypeSchemaNodeResponse_message(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return obj.Schema, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TypeSchemaNodeResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PropertyJsonSchema_schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TypeSchemaNodeResponse",
+		Object:     "PropertyJsonSchema",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -10630,8 +11569,8 @@ func (ec *executionContext) fieldContext_TypeSchemaNodeResponse_message(_ contex
 	return fc, nil
 }
 
-func (ec *executionContext) _TypeSchemaNodeResponse_typeSchemaNode(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNodeResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
+func (ec *executionContext) _Query_getObjectNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getObjectNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10644,51 +11583,57 @@ func (ec *executionContext) _TypeSchemaNodeResponse_typeSchemaNode(ctx context.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TypeSchemaNode, nil
+		return ec.resolvers.Query().GetObjectNode(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*model.TypeSchemaNode)
+	res := resTmp.(*model.ObjectNodeResponse)
 	fc.Result = res
-	return ec.marshalOTypeSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNode(ctx, field.Selections, res)
+	return ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TypeSchemaNodeResponse_typeSchemaNode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getObjectNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TypeSchemaNodeResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_TypeSchemaNode_id(ctx, field)
-			case "domain":
-				return ec.fieldContext_TypeSchemaNode_domain(ctx, field)
-			case "name":
-				return ec.fieldContext_TypeSchemaNode_name(ctx, field)
-			case "type":
-				return ec.fieldContext_TypeSchemaNode_type(ctx, field)
-			case "originalName":
-				return ec.fieldContext_TypeSchemaNode_originalName(ctx, field)
-			case "labels":
-				return ec.fieldContext_TypeSchemaNode_labels(ctx, field)
-			case "properties":
-				return ec.fieldContext_TypeSchemaNode_properties(ctx, field)
+			case "success":
+				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
+			case "objectNode":
+				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNode", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getObjectNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TypeSchemaNodesResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TypeSchemaNodesResponse_success(ctx, field)
+func (ec *executionContext) _Query_getObjectNodes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getObjectNodes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10701,7 +11646,7 @@ func (ec *executionContext) _TypeSchemaNodesResponse_success(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Success, nil
+		return ec.resolvers.Query().GetObjectNodes(rctx, fc.Args["domain"].(*string), fc.Args["type"].(*string), fc.Args["where"].([]*model.PropertyFilter), fc.Args["orderBy"].([]*model.PropertySort))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10713,26 +11658,45 @@ func (ec *executionContext) _TypeSchemaNodesResponse_success(ctx context.Context
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.ObjectNodesResponse)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNObjectNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodesResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TypeSchemaNodesResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getObjectNodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TypeSchemaNodesResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectNodesResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectNodesResponse_message(ctx, field)
+			case "objectNodes":
+				return ec.fieldContext_ObjectNodesResponse_objectNodes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNodesResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getObjectNodes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TypeSchemaNodesResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TypeSchemaNodesResponse_message(ctx, field)
+func (ec *executionContext) _Query_objectNodesWithinDistance(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_objectNodesWithinDistance(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10745,35 +11709,57 @@ func (ec *executionContext) _TypeSchemaNodesResponse_message(ctx context.Context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Message, nil
+		return ec.resolvers.Query().ObjectNodesWithinDistance(rctx, fc.Args["domain"].(string), fc.Args["type"].(string), fc.Args["property"].(string), fc.Args["center"].(model.PointInput), fc.Args["meters"].(float64))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.ObjectNodesResponse)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNObjectNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodesResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TypeSchemaNodesResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_objectNodesWithinDistance(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TypeSchemaNodesResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectNodesResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectNodesResponse_message(ctx, field)
+			case "objectNodes":
+				return ec.fieldContext_ObjectNodesResponse_objectNodes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNodesResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_objectNodesWithinDistance_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) _TypeSchemaNodesResponse_typeSchemaNodes(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNodesResponse) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext_TypeSchemaNodesResponse_typeSchemaNodes(ctx, field)
+func (ec *executionContext) _Query_objectNodesInBoundingBox(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_objectNodesInBoundingBox(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10786,51 +11772,57 @@ func (ec *executionContext) _TypeSchemaNodesResponse_typeSchemaNodes(ctx context
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.TypeSchemaNodes, nil
+		return ec.resolvers.Query().ObjectNodesInBoundingBox(rctx, fc.Args["domain"].(string), fc.Args["type"].(string), fc.Args["property"].(string), fc.Args["lowerLeft"].(model.PointInput), fc.Args["upperRight"].(model.PointInput))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]*model.TypeSchemaNode)
+	res := resTmp.(*model.ObjectNodesResponse)
 	fc.Result = res
-	return ec.marshalOTypeSchemaNode2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeᚄ(ctx, field.Selections, res)
+	return ec.marshalNObjectNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodesResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext_TypeSchemaNodesResponse_typeSchemaNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_objectNodesInBoundingBox(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "TypeSchemaNodesResponse",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_TypeSchemaNode_id(ctx, field)
-			case "domain":
-				return ec.fieldContext_TypeSchemaNode_domain(ctx, field)
-			case "name":
-				return ec.fieldContext_TypeSchemaNode_name(ctx, field)
-			case "type":
-				return ec.fieldContext_TypeSchemaNode_type(ctx, field)
-			case "originalName":
-				return ec.fieldContext_TypeSchemaNode_originalName(ctx, field)
-			case "labels":
-				return ec.fieldContext_TypeSchemaNode_labels(ctx, field)
-			case "properties":
-				return ec.fieldContext_TypeSchemaNode_properties(ctx, field)
+			case "success":
+				return ec.fieldContext_ObjectNodesResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectNodesResponse_message(ctx, field)
+			case "objectNodes":
+				return ec.fieldContext_ObjectNodesResponse_objectNodes(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNode", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNodesResponse", field.Name)
 		},
 	}
-	return fc, nil
-}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_objectNodesInBoundingBox_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
 
-func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_name(ctx, field)
+func (ec *executionContext) _Query_getObjectNodeRelationship(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getObjectNodeRelationship(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10843,7 +11835,7 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Query().GetObjectNodeRelationship(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10855,26 +11847,45 @@ func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.ObjectRelationshipResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getObjectNodeRelationship(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
+			case "objectRelationship":
+				return ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getObjectNodeRelationship_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_description(ctx, field)
+func (ec *executionContext) _Query_getObjectNodeOutgoingRelationships(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getObjectNodeOutgoingRelationships(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10887,35 +11898,57 @@ func (ec *executionContext) ___Directive_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return ec.resolvers.Query().GetObjectNodeOutgoingRelationships(rctx, fc.Args["fromObjectNodeId"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.ObjectRelationshipsResponse)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNObjectRelationshipsResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipsResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getObjectNodeOutgoingRelationships(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectRelationshipsResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectRelationshipsResponse_message(ctx, field)
+			case "objectRelationships":
+				return ec.fieldContext_ObjectRelationshipsResponse_objectRelationships(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipsResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getObjectNodeOutgoingRelationships_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+func (ec *executionContext) _Query_getObjectNodeIncomingRelationships(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getObjectNodeIncomingRelationships(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10928,7 +11961,7 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Locations, nil
+		return ec.resolvers.Query().GetObjectNodeIncomingRelationships(rctx, fc.Args["toObjectNodeId"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10940,26 +11973,45 @@ func (ec *executionContext) ___Directive_locations(ctx context.Context, field gr
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]string)
+	res := resTmp.(*model.ObjectRelationshipsResponse)
 	fc.Result = res
-	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+	return ec.marshalNObjectRelationshipsResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipsResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getObjectNodeIncomingRelationships(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectRelationshipsResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectRelationshipsResponse_message(ctx, field)
+			case "objectRelationships":
+				return ec.fieldContext_ObjectRelationshipsResponse_objectRelationships(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipsResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getObjectNodeIncomingRelationships_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_args(ctx, field)
+func (ec *executionContext) _Query_getDomainSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getDomainSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -10972,7 +12024,7 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return ec.resolvers.Query().GetDomainSchemaNode(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -10984,36 +12036,45 @@ func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*model.DomainSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNDomainSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_args(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getDomainSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "success":
+				return ec.fieldContext_DomainSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_DomainSchemaNodeResponse_message(ctx, field)
+			case "domainSchemaNode":
+				return ec.fieldContext_DomainSchemaNodeResponse_domainSchemaNode(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDomainSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+func (ec *executionContext) _Query_getDomainSchemaNodes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getDomainSchemaNodes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11026,7 +12087,7 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsRepeatable, nil
+		return ec.resolvers.Query().GetDomainSchemaNodes(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11038,26 +12099,34 @@ func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.DomainSchemaNodesResponse)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNDomainSchemaNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodesResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getDomainSchemaNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Directive",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_DomainSchemaNodesResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_DomainSchemaNodesResponse_message(ctx, field)
+			case "domainSchemaNodes":
+				return ec.fieldContext_DomainSchemaNodesResponse_domainSchemaNodes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNodesResponse", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+func (ec *executionContext) _Query_getDomainExternalDependencies(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getDomainExternalDependencies(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11070,7 +12139,7 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Query().GetDomainExternalDependencies(rctx, fc.Args["domain"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11082,26 +12151,45 @@ func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.DomainDependenciesResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNDomainDependenciesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainDependenciesResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getDomainExternalDependencies(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_DomainDependenciesResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_DomainDependenciesResponse_message(ctx, field)
+			case "dependencies":
+				return ec.fieldContext_DomainDependenciesResponse_dependencies(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DomainDependenciesResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getDomainExternalDependencies_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+func (ec *executionContext) _Query_getTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getTypeSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11114,35 +12202,57 @@ func (ec *executionContext) ___EnumValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return ec.resolvers.Query().GetTypeSchemaNode(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.TypeSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getTypeSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
+			case "typeSchemaNode":
+				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getTypeSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_getTypeSchemaNodes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getTypeSchemaNodes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11155,7 +12265,7 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return ec.resolvers.Query().GetTypeSchemaNodes(rctx, fc.Args["domain"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11167,26 +12277,45 @@ func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.TypeSchemaNodesResponse)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNTypeSchemaNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodesResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getTypeSchemaNodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_TypeSchemaNodesResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_TypeSchemaNodesResponse_message(ctx, field)
+			case "typeSchemaNodes":
+				return ec.fieldContext_TypeSchemaNodesResponse_typeSchemaNodes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodesResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getTypeSchemaNodes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+func (ec *executionContext) _Query_getTypeSchemaNodeOutgoingRelationships(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getTypeSchemaNodeOutgoingRelationships(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11199,35 +12328,57 @@ func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context,
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
+		return ec.resolvers.Query().GetTypeSchemaNodeOutgoingRelationships(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.RelationshipSchemaNodesResponse)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNRelationshipSchemaNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodesResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getTypeSchemaNodeOutgoingRelationships(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__EnumValue",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_RelationshipSchemaNodesResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_RelationshipSchemaNodesResponse_message(ctx, field)
+			case "relationshipSchemaNodes":
+				return ec.fieldContext_RelationshipSchemaNodesResponse_relationshipSchemaNodes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodesResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getTypeSchemaNodeOutgoingRelationships_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_name(ctx, field)
+func (ec *executionContext) _Query_getTypeSchemaNodeIncomingRelationships(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getTypeSchemaNodeIncomingRelationships(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11240,7 +12391,7 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Query().GetTypeSchemaNodeIncomingRelationships(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11252,26 +12403,45 @@ func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.RelationshipSchemaNodesResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNRelationshipSchemaNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodesResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getTypeSchemaNodeIncomingRelationships(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_RelationshipSchemaNodesResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_RelationshipSchemaNodesResponse_message(ctx, field)
+			case "relationshipSchemaNodes":
+				return ec.fieldContext_RelationshipSchemaNodesResponse_relationshipSchemaNodes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodesResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getTypeSchemaNodeIncomingRelationships_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_description(ctx, field)
+func (ec *executionContext) _Query_getRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getRelationshipSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11284,35 +12454,57 @@ func (ec *executionContext) ___Field_description(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return ec.resolvers.Query().GetRelationshipSchemaNode(rctx, fc.Args["id"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.RelationshipSchemaNodeResponse)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getRelationshipSchemaNode(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
+			case "relationshipSchemaNode":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getRelationshipSchemaNode_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_args(ctx, field)
+func (ec *executionContext) _Query_getRelationshipSchemaNodes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_getRelationshipSchemaNodes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11325,7 +12517,7 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Args, nil
+		return ec.resolvers.Query().GetRelationshipSchemaNodes(rctx, fc.Args["domain"].(*string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11337,36 +12529,45 @@ func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*model.RelationshipSchemaNodesResponse)
 	fc.Result = res
-	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalNRelationshipSchemaNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodesResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_args(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_getRelationshipSchemaNodes(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
-			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			case "success":
+				return ec.fieldContext_RelationshipSchemaNodesResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_RelationshipSchemaNodesResponse_message(ctx, field)
+			case "relationshipSchemaNodes":
+				return ec.fieldContext_RelationshipSchemaNodesResponse_relationshipSchemaNodes(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodesResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_getRelationshipSchemaNodes_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_type(ctx, field)
+func (ec *executionContext) _Query_relationshipCardinalityReport(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_relationshipCardinalityReport(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11379,7 +12580,7 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return ec.resolvers.Query().RelationshipCardinalityReport(rctx, fc.Args["domain"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11391,48 +12592,45 @@ func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.Col
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(*model.CardinalityReportResponse)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNCardinalityReportResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐCardinalityReportResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_relationshipCardinalityReport(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "success":
+				return ec.fieldContext_CardinalityReportResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_CardinalityReportResponse_message(ctx, field)
+			case "violations":
+				return ec.fieldContext_CardinalityReportResponse_violations(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type CardinalityReportResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_relationshipCardinalityReport_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+func (ec *executionContext) _Query_schemaDiff(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_schemaDiff(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11445,7 +12643,7 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.IsDeprecated(), nil
+		return ec.resolvers.Query().SchemaDiff(rctx, fc.Args["domain"].(string), fc.Args["against"].(model.SchemaDiffAgainst))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11457,67 +12655,45 @@ func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field gra
 		}
 		return graphql.Null
 	}
-	res := resTmp.(bool)
+	res := resTmp.(*model.SchemaDiffResponse)
 	fc.Result = res
-	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+	return ec.marshalNSchemaDiffResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaDiffResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_schemaDiff(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Field",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_SchemaDiffResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_SchemaDiffResponse_message(ctx, field)
+			case "changes":
+				return ec.fieldContext_SchemaDiffResponse_changes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SchemaDiffResponse", field.Name)
 		},
 	}
-	return fc, nil
-}
-
-func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
-	if err != nil {
-		return graphql.Null
-	}
-	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			ec.Error(ctx, ec.Recover(ctx, r))
-			ret = graphql.Null
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
 		}
 	}()
-	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
-		ctx = rctx // use context from middleware stack in children
-		return obj.DeprecationReason(), nil
-	})
-	if err != nil {
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_schemaDiff_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
-		return graphql.Null
-	}
-	if resTmp == nil {
-		return graphql.Null
-	}
-	res := resTmp.(*string)
-	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
-}
-
-func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "__Field",
-		Field:      field,
-		IsMethod:   true,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
+		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+func (ec *executionContext) _Query_exportSchemaDiagram(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_exportSchemaDiagram(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11530,7 +12706,7 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name, nil
+		return ec.resolvers.Query().ExportSchemaDiagram(rctx, fc.Args["domain"].(string), fc.Args["format"].(model.SchemaDiagramFormat), fc.Args["includeCounts"].(*bool))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11542,26 +12718,47 @@ func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphq
 		}
 		return graphql.Null
 	}
-	res := resTmp.(string)
+	res := resTmp.(*model.SchemaDiagramResponse)
 	fc.Result = res
-	return ec.marshalNString2string(ctx, field.Selections, res)
+	return ec.marshalNSchemaDiagramResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaDiagramResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_exportSchemaDiagram(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
+		IsMethod:   true,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_SchemaDiagramResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_SchemaDiagramResponse_message(ctx, field)
+			case "format":
+				return ec.fieldContext_SchemaDiagramResponse_format(ctx, field)
+			case "diagram":
+				return ec.fieldContext_SchemaDiagramResponse_diagram(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SchemaDiagramResponse", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_exportSchemaDiagram_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+func (ec *executionContext) _Query_indexes(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query_indexes(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11574,35 +12771,46 @@ func (ec *executionContext) ___InputValue_description(ctx context.Context, field
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return ec.resolvers.Query().Indexes(rctx)
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*model.IndexesResponse)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNIndexesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐIndexesResponse(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_indexes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Query",
 		Field:      field,
 		IsMethod:   true,
-		IsResolver: false,
+		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_IndexesResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_IndexesResponse_message(ctx, field)
+			case "indexes":
+				return ec.fieldContext_IndexesResponse_indexes(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type IndexesResponse", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+func (ec *executionContext) _Query___type(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11615,28 +12823,25 @@ func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Type, nil
+		return ec.introspectType(fc.Args["name"].(string))
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
-		if !graphql.HasFieldError(ctx, fc) {
-			ec.Errorf(ctx, "must not be null")
-		}
 		return graphql.Null
 	}
 	res := resTmp.(*introspection.Type)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___type(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
@@ -11664,11 +12869,22 @@ func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, fi
 			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
 		},
 	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query___type_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+func (ec *executionContext) _Query___schema(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Query___schema(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11681,7 +12897,7 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.DefaultValue, nil
+		return ec.introspectSchema()
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11690,26 +12906,40 @@ func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, fiel
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(*introspection.Schema)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalO__Schema2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐSchema(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__InputValue",
+		Object:     "Query",
 		Field:      field,
-		IsMethod:   false,
+		IsMethod:   true,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "description":
+				return ec.fieldContext___Schema_description(ctx, field)
+			case "types":
+				return ec.fieldContext___Schema_types(ctx, field)
+			case "queryType":
+				return ec.fieldContext___Schema_queryType(ctx, field)
+			case "mutationType":
+				return ec.fieldContext___Schema_mutationType(ctx, field)
+			case "subscriptionType":
+				return ec.fieldContext___Schema_subscriptionType(ctx, field)
+			case "directives":
+				return ec.fieldContext___Schema_directives(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Schema", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_description(ctx, field)
+func (ec *executionContext) _RelationshipCardinality_cardinality(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipCardinality) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipCardinality_cardinality(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11722,35 +12952,38 @@ func (ec *executionContext) ___Schema_description(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.Cardinality, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(model.Cardinality)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNCardinality2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐCardinality(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelationshipCardinality_cardinality(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "RelationshipCardinality",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Cardinality does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_types(ctx, field)
+func (ec *executionContext) _RelationshipCardinality_fromRequired(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipCardinality) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipCardinality_fromRequired(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11763,7 +12996,7 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Types(), nil
+		return obj.FromRequired, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11775,48 +13008,26 @@ func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.C
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelationshipCardinality_fromRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "RelationshipCardinality",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+func (ec *executionContext) _RelationshipCardinality_toRequired(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipCardinality) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipCardinality_toRequired(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11829,7 +13040,7 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.QueryType(), nil
+		return obj.ToRequired, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -11841,48 +13052,26 @@ func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graph
 		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelationshipCardinality_toRequired(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "RelationshipCardinality",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+func (ec *executionContext) _RelationshipSchemaNode_id(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipSchemaNode_id(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11895,57 +13084,38 @@ func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.MutationType(), nil
+		return obj.ID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelationshipSchemaNode_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "RelationshipSchemaNode",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+func (ec *executionContext) _RelationshipSchemaNode_domain(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipSchemaNode_domain(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -11958,57 +13128,38 @@ func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, fiel
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SubscriptionType(), nil
+		return obj.Domain, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelationshipSchemaNode_domain(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "RelationshipSchemaNode",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+func (ec *executionContext) _RelationshipSchemaNode_name(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipSchemaNode_name(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12021,7 +13172,7 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Directives(), nil
+		return obj.Name, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12033,38 +13184,26 @@ func (ec *executionContext) ___Schema_directives(ctx context.Context, field grap
 		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Directive)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelationshipSchemaNode_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Schema",
+		Object:     "RelationshipSchemaNode",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Directive_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Directive_description(ctx, field)
-			case "locations":
-				return ec.fieldContext___Directive_locations(ctx, field)
-			case "args":
-				return ec.fieldContext___Directive_args(ctx, field)
-			case "isRepeatable":
-				return ec.fieldContext___Directive_isRepeatable(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_kind(ctx, field)
+func (ec *executionContext) _RelationshipSchemaNode_originalName(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipSchemaNode_originalName(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12077,7 +13216,7 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Kind(), nil
+		return obj.OriginalName, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12091,24 +13230,24 @@ func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.Coll
 	}
 	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelationshipSchemaNode_originalName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RelationshipSchemaNode",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type __TypeKind does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_name(ctx, field)
+func (ec *executionContext) _RelationshipSchemaNode_type(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipSchemaNode_type(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12121,25 +13260,28 @@ func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.Coll
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Name(), nil
+		return obj.Type, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelationshipSchemaNode_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RelationshipSchemaNode",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -12148,8 +13290,8 @@ func (ec *executionContext) fieldContext___Type_name(_ context.Context, field gr
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_description(ctx, field)
+func (ec *executionContext) _RelationshipSchemaNode_fromTypeSchemaNodeId(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipSchemaNode_fromTypeSchemaNodeId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12162,25 +13304,28 @@ func (ec *executionContext) ___Type_description(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Description(), nil
+		return obj.FromTypeSchemaNodeID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*string)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelationshipSchemaNode_fromTypeSchemaNodeId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RelationshipSchemaNode",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -12189,8 +13334,8 @@ func (ec *executionContext) fieldContext___Type_description(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_fields(ctx, field)
+func (ec *executionContext) _RelationshipSchemaNode_toTypeSchemaNodeId(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipSchemaNode_toTypeSchemaNodeId(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12203,60 +13348,38 @@ func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+		return obj.ToTypeSchemaNodeID, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Field)
+	res := resTmp.(string)
 	fc.Result = res
-	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+	return ec.marshalNString2string(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelationshipSchemaNode_toTypeSchemaNodeId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RelationshipSchemaNode",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___Field_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Field_description(ctx, field)
-			case "args":
-				return ec.fieldContext___Field_args(ctx, field)
-			case "type":
-				return ec.fieldContext___Field_type(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___Field_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___Field_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
-	defer func() {
-		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
-		}
-	}()
-	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
-		ec.Error(ctx, err)
-		return fc, err
-	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+func (ec *executionContext) _RelationshipSchemaNode_cardinality(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipSchemaNode_cardinality(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12269,7 +13392,7 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.Interfaces(), nil
+		return obj.Cardinality, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12278,48 +13401,34 @@ func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.(*model.RelationshipCardinality)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalORelationshipCardinality2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipCardinality(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelationshipSchemaNode_cardinality(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RelationshipSchemaNode",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			case "cardinality":
+				return ec.fieldContext_RelationshipCardinality_cardinality(ctx, field)
+			case "fromRequired":
+				return ec.fieldContext_RelationshipCardinality_fromRequired(ctx, field)
+			case "toRequired":
+				return ec.fieldContext_RelationshipCardinality_toRequired(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipCardinality", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+func (ec *executionContext) _RelationshipSchemaNode_properties(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipSchemaNode_properties(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12332,7 +13441,7 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.PossibleTypes(), nil
+		return obj.Properties, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12341,48 +13450,36 @@ func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field gra
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.Type)
+	res := resTmp.([]*model.Property)
 	fc.Result = res
-	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+	return ec.marshalOProperty2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelationshipSchemaNode_properties(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RelationshipSchemaNode",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			case "key":
+				return ec.fieldContext_Property_key(ctx, field)
+			case "value":
+				return ec.fieldContext_Property_value(ctx, field)
+			case "type":
+				return ec.fieldContext_Property_type(ctx, field)
+			case "readOnly":
+				return ec.fieldContext_Property_readOnly(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+func (ec *executionContext) _RelationshipSchemaNode_labels(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipSchemaNode_labels(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12395,7 +13492,7 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+		return obj.Labels, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12404,47 +13501,111 @@ func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphq
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.EnumValue)
+	res := resTmp.([]string)
 	fc.Result = res
-	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelationshipSchemaNode_labels(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RelationshipSchemaNode",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext___EnumValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___EnumValue_description(ctx, field)
-			case "isDeprecated":
-				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
-			case "deprecationReason":
-				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelationshipSchemaNodeResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNodeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
 	defer func() {
 		if r := recover(); r != nil {
-			err = ec.Recover(ctx, r)
-			ec.Error(ctx, err)
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
 		}
 	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Success, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RelationshipSchemaNodeResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelationshipSchemaNodeResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RelationshipSchemaNodeResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNodeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
 		ec.Error(ctx, err)
-		return fc, err
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RelationshipSchemaNodeResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelationshipSchemaNodeResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+func (ec *executionContext) _RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNodeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12457,7 +13618,7 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.InputFields(), nil
+		return obj.RelationshipSchemaNode, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12466,36 +13627,48 @@ func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graph
 	if resTmp == nil {
 		return graphql.Null
 	}
-	res := resTmp.([]introspection.InputValue)
+	res := resTmp.(*model.RelationshipSchemaNode)
 	fc.Result = res
-	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+	return ec.marshalORelationshipSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNode(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RelationshipSchemaNodeResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "id":
+				return ec.fieldContext_RelationshipSchemaNode_id(ctx, field)
+			case "domain":
+				return ec.fieldContext_RelationshipSchemaNode_domain(ctx, field)
 			case "name":
-				return ec.fieldContext___InputValue_name(ctx, field)
-			case "description":
-				return ec.fieldContext___InputValue_description(ctx, field)
+				return ec.fieldContext_RelationshipSchemaNode_name(ctx, field)
+			case "originalName":
+				return ec.fieldContext_RelationshipSchemaNode_originalName(ctx, field)
 			case "type":
-				return ec.fieldContext___InputValue_type(ctx, field)
-			case "defaultValue":
-				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+				return ec.fieldContext_RelationshipSchemaNode_type(ctx, field)
+			case "fromTypeSchemaNodeId":
+				return ec.fieldContext_RelationshipSchemaNode_fromTypeSchemaNodeId(ctx, field)
+			case "toTypeSchemaNodeId":
+				return ec.fieldContext_RelationshipSchemaNode_toTypeSchemaNodeId(ctx, field)
+			case "cardinality":
+				return ec.fieldContext_RelationshipSchemaNode_cardinality(ctx, field)
+			case "properties":
+				return ec.fieldContext_RelationshipSchemaNode_properties(ctx, field)
+			case "labels":
+				return ec.fieldContext_RelationshipSchemaNode_labels(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNode", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_ofType(ctx, field)
+func (ec *executionContext) _RelationshipSchemaNodesResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipSchemaNodesResponse_success(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12508,57 +13681,38 @@ func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.Co
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.OfType(), nil
+		return obj.Success, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
 		return graphql.Null
 	}
 	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
 		return graphql.Null
 	}
-	res := resTmp.(*introspection.Type)
+	res := resTmp.(bool)
 	fc.Result = res
-	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelationshipSchemaNodesResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RelationshipSchemaNodesResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "kind":
-				return ec.fieldContext___Type_kind(ctx, field)
-			case "name":
-				return ec.fieldContext___Type_name(ctx, field)
-			case "description":
-				return ec.fieldContext___Type_description(ctx, field)
-			case "fields":
-				return ec.fieldContext___Type_fields(ctx, field)
-			case "interfaces":
-				return ec.fieldContext___Type_interfaces(ctx, field)
-			case "possibleTypes":
-				return ec.fieldContext___Type_possibleTypes(ctx, field)
-			case "enumValues":
-				return ec.fieldContext___Type_enumValues(ctx, field)
-			case "inputFields":
-				return ec.fieldContext___Type_inputFields(ctx, field)
-			case "ofType":
-				return ec.fieldContext___Type_ofType(ctx, field)
-			case "specifiedByURL":
-				return ec.fieldContext___Type_specifiedByURL(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
-	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+func (ec *executionContext) _RelationshipSchemaNodesResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipSchemaNodesResponse_message(ctx, field)
 	if err != nil {
 		return graphql.Null
 	}
@@ -12571,7 +13725,7 @@ func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field gr
 	}()
 	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
 		ctx = rctx // use context from middleware stack in children
-		return obj.SpecifiedByURL(), nil
+		return obj.Message, nil
 	})
 	if err != nil {
 		ec.Error(ctx, err)
@@ -12585,11 +13739,11 @@ func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field gr
 	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
 }
 
-func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_RelationshipSchemaNodesResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "__Type",
+		Object:     "RelationshipSchemaNodesResponse",
 		Field:      field,
-		IsMethod:   true,
+		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			return nil, errors.New("field of type String does not have child fields")
@@ -12598,254 +13752,6181 @@ func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
-func (ec *executionContext) unmarshalInputDeleteObjectNodeInput(ctx context.Context, obj interface{}) (model.DeleteObjectNodeInput, error) {
-	var it model.DeleteObjectNodeInput
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
+func (ec *executionContext) _RelationshipSchemaNodesResponse_relationshipSchemaNodes(ctx context.Context, field graphql.CollectedField, obj *model.RelationshipSchemaNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_RelationshipSchemaNodesResponse_relationshipSchemaNodes(ctx, field)
+	if err != nil {
+		return graphql.Null
 	}
-
-	fieldsInOrder := [...]string{"domain", "name", "type"}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.RelationshipSchemaNodes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.RelationshipSchemaNode)
+	fc.Result = res
+	return ec.marshalORelationshipSchemaNode2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_RelationshipSchemaNodesResponse_relationshipSchemaNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RelationshipSchemaNodesResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_RelationshipSchemaNode_id(ctx, field)
+			case "domain":
+				return ec.fieldContext_RelationshipSchemaNode_domain(ctx, field)
+			case "name":
+				return ec.fieldContext_RelationshipSchemaNode_name(ctx, field)
+			case "originalName":
+				return ec.fieldContext_RelationshipSchemaNode_originalName(ctx, field)
+			case "type":
+				return ec.fieldContext_RelationshipSchemaNode_type(ctx, field)
+			case "fromTypeSchemaNodeId":
+				return ec.fieldContext_RelationshipSchemaNode_fromTypeSchemaNodeId(ctx, field)
+			case "toTypeSchemaNodeId":
+				return ec.fieldContext_RelationshipSchemaNode_toTypeSchemaNodeId(ctx, field)
+			case "cardinality":
+				return ec.fieldContext_RelationshipSchemaNode_cardinality(ctx, field)
+			case "properties":
+				return ec.fieldContext_RelationshipSchemaNode_properties(ctx, field)
+			case "labels":
+				return ec.fieldContext_RelationshipSchemaNode_labels(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNode", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Response_success(ctx context.Context, field graphql.CollectedField, obj *model.Response) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Response_success(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Success, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Response_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Response",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Response_message(ctx context.Context, field graphql.CollectedField, obj *model.Response) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Response_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Response_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Response",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Response_data(ctx context.Context, field graphql.CollectedField, obj *model.Response) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_Response_data(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Data, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]map[string]interface{})
+	fc.Result = res
+	return ec.marshalOJSON2ᚕmapᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_Response_data(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Response",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type JSON does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaChange_action(ctx context.Context, field graphql.CollectedField, obj *model.SchemaChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaChange_action(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Action, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.SchemaChangeAction)
+	fc.Result = res
+	return ec.marshalNSchemaChangeAction2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaChangeAction(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaChange_action(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type SchemaChangeAction does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaChange_kind(ctx context.Context, field graphql.CollectedField, obj *model.SchemaChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaChange_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.SchemaChangeKind)
+	fc.Result = res
+	return ec.marshalNSchemaChangeKind2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaChangeKind(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaChange_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type SchemaChangeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaChange_target(ctx context.Context, field graphql.CollectedField, obj *model.SchemaChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaChange_target(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Target, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaChange_target(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaChange_description(ctx context.Context, field graphql.CollectedField, obj *model.SchemaChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaChange_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaChange_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaChange_compatibility(ctx context.Context, field graphql.CollectedField, obj *model.SchemaChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaChange_compatibility(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Compatibility, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.SchemaChangeCompatibility)
+	fc.Result = res
+	return ec.marshalNSchemaChangeCompatibility2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaChangeCompatibility(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaChange_compatibility(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type SchemaChangeCompatibility does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaChange_affectedObjectNodes(ctx context.Context, field graphql.CollectedField, obj *model.SchemaChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaChange_affectedObjectNodes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AffectedObjectNodes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaChange_affectedObjectNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaChange_affectedObjectRelationships(ctx context.Context, field graphql.CollectedField, obj *model.SchemaChange) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaChange_affectedObjectRelationships(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.AffectedObjectRelationships, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(int)
+	fc.Result = res
+	return ec.marshalNInt2int(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaChange_affectedObjectRelationships(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaChange",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaDiagramResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.SchemaDiagramResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaDiagramResponse_success(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Success, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaDiagramResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaDiagramResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaDiagramResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.SchemaDiagramResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaDiagramResponse_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaDiagramResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaDiagramResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaDiagramResponse_format(ctx context.Context, field graphql.CollectedField, obj *model.SchemaDiagramResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaDiagramResponse_format(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Format, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(model.SchemaDiagramFormat)
+	fc.Result = res
+	return ec.marshalNSchemaDiagramFormat2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaDiagramFormat(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaDiagramResponse_format(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaDiagramResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type SchemaDiagramFormat does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaDiagramResponse_diagram(ctx context.Context, field graphql.CollectedField, obj *model.SchemaDiagramResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaDiagramResponse_diagram(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Diagram, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaDiagramResponse_diagram(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaDiagramResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaDiffResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.SchemaDiffResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaDiffResponse_success(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Success, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaDiffResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaDiffResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaDiffResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.SchemaDiffResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaDiffResponse_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaDiffResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaDiffResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaDiffResponse_changes(ctx context.Context, field graphql.CollectedField, obj *model.SchemaDiffResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaDiffResponse_changes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Changes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.SchemaChange)
+	fc.Result = res
+	return ec.marshalOSchemaChange2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaChangeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaDiffResponse_changes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaDiffResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "action":
+				return ec.fieldContext_SchemaChange_action(ctx, field)
+			case "kind":
+				return ec.fieldContext_SchemaChange_kind(ctx, field)
+			case "target":
+				return ec.fieldContext_SchemaChange_target(ctx, field)
+			case "description":
+				return ec.fieldContext_SchemaChange_description(ctx, field)
+			case "compatibility":
+				return ec.fieldContext_SchemaChange_compatibility(ctx, field)
+			case "affectedObjectNodes":
+				return ec.fieldContext_SchemaChange_affectedObjectNodes(ctx, field)
+			case "affectedObjectRelationships":
+				return ec.fieldContext_SchemaChange_affectedObjectRelationships(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SchemaChange", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaPlanResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.SchemaPlanResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaPlanResponse_success(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Success, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaPlanResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaPlanResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaPlanResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.SchemaPlanResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaPlanResponse_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaPlanResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaPlanResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaPlanResponse_dryRun(ctx context.Context, field graphql.CollectedField, obj *model.SchemaPlanResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaPlanResponse_dryRun(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DryRun, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaPlanResponse_dryRun(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaPlanResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SchemaPlanResponse_changes(ctx context.Context, field graphql.CollectedField, obj *model.SchemaPlanResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_SchemaPlanResponse_changes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Changes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.SchemaChange)
+	fc.Result = res
+	return ec.marshalOSchemaChange2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaChangeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_SchemaPlanResponse_changes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SchemaPlanResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "action":
+				return ec.fieldContext_SchemaChange_action(ctx, field)
+			case "kind":
+				return ec.fieldContext_SchemaChange_kind(ctx, field)
+			case "target":
+				return ec.fieldContext_SchemaChange_target(ctx, field)
+			case "description":
+				return ec.fieldContext_SchemaChange_description(ctx, field)
+			case "compatibility":
+				return ec.fieldContext_SchemaChange_compatibility(ctx, field)
+			case "affectedObjectNodes":
+				return ec.fieldContext_SchemaChange_affectedObjectNodes(ctx, field)
+			case "affectedObjectRelationships":
+				return ec.fieldContext_SchemaChange_affectedObjectRelationships(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SchemaChange", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_objectNodeCreated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_objectNodeCreated(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().ObjectNodeCreated(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.ObjectNodeResponse):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_objectNodeCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
+			case "objectNode":
+				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_objectNodeUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_objectNodeUpdated(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().ObjectNodeUpdated(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.ObjectNodeResponse):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_objectNodeUpdated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
+			case "objectNode":
+				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_objectNodeDeleted(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_objectNodeDeleted(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().ObjectNodeDeleted(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.ObjectNodeResponse):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_objectNodeDeleted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectNodeResponse_message(ctx, field)
+			case "objectNode":
+				return ec.fieldContext_ObjectNodeResponse_objectNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectNodeResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_objectRelationshipCreated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_objectRelationshipCreated(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().ObjectRelationshipCreated(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.ObjectRelationshipResponse):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_objectRelationshipCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
+			case "objectRelationship":
+				return ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_objectRelationshipUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_objectRelationshipUpdated(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().ObjectRelationshipUpdated(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.ObjectRelationshipResponse):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_objectRelationshipUpdated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
+			case "objectRelationship":
+				return ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_objectRelationshipDeleted(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_objectRelationshipDeleted(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().ObjectRelationshipDeleted(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.ObjectRelationshipResponse):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_objectRelationshipDeleted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_ObjectRelationshipResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_ObjectRelationshipResponse_message(ctx, field)
+			case "objectRelationship":
+				return ec.fieldContext_ObjectRelationshipResponse_objectRelationship(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ObjectRelationshipResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_domainSchemaNodeCreated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_domainSchemaNodeCreated(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().DomainSchemaNodeCreated(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.DomainSchemaNodeResponse):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNDomainSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_domainSchemaNodeCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_DomainSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_DomainSchemaNodeResponse_message(ctx, field)
+			case "domainSchemaNode":
+				return ec.fieldContext_DomainSchemaNodeResponse_domainSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNodeResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_domainSchemaNodeUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_domainSchemaNodeUpdated(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().DomainSchemaNodeUpdated(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.DomainSchemaNodeResponse):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNDomainSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_domainSchemaNodeUpdated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_DomainSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_DomainSchemaNodeResponse_message(ctx, field)
+			case "domainSchemaNode":
+				return ec.fieldContext_DomainSchemaNodeResponse_domainSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNodeResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_domainSchemaNodeDeleted(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_domainSchemaNodeDeleted(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().DomainSchemaNodeDeleted(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.DomainSchemaNodeResponse):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNDomainSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_domainSchemaNodeDeleted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_DomainSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_DomainSchemaNodeResponse_message(ctx, field)
+			case "domainSchemaNode":
+				return ec.fieldContext_DomainSchemaNodeResponse_domainSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DomainSchemaNodeResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_typeSchemaNodeCreated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_typeSchemaNodeCreated(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().TypeSchemaNodeCreated(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.TypeSchemaNodeResponse):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_typeSchemaNodeCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
+			case "typeSchemaNode":
+				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_typeSchemaNodeUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_typeSchemaNodeUpdated(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().TypeSchemaNodeUpdated(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.TypeSchemaNodeResponse):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_typeSchemaNodeUpdated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
+			case "typeSchemaNode":
+				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_typeSchemaNodeDeleted(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_typeSchemaNodeDeleted(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().TypeSchemaNodeDeleted(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.TypeSchemaNodeResponse):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_typeSchemaNodeDeleted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
+			case "typeSchemaNode":
+				return ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNodeResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_relationshipSchemaNodeCreated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_relationshipSchemaNodeCreated(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().RelationshipSchemaNodeCreated(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.RelationshipSchemaNodeResponse):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_relationshipSchemaNodeCreated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
+			case "relationshipSchemaNode":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_relationshipSchemaNodeUpdated(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_relationshipSchemaNodeUpdated(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().RelationshipSchemaNodeUpdated(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.RelationshipSchemaNodeResponse):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_relationshipSchemaNodeUpdated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
+			case "relationshipSchemaNode":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_relationshipSchemaNodeDeleted(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	fc, err := ec.fieldContext_Subscription_relationshipSchemaNodeDeleted(ctx, field)
+	if err != nil {
+		return nil
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = nil
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return ec.resolvers.Subscription().RelationshipSchemaNodeDeleted(rctx)
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return nil
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return nil
+	}
+	return func(ctx context.Context) graphql.Marshaler {
+		select {
+		case res, ok := <-resTmp.(<-chan *model.RelationshipSchemaNodeResponse):
+			if !ok {
+				return nil
+			}
+			return graphql.WriterFunc(func(w io.Writer) {
+				w.Write([]byte{'{'})
+				graphql.MarshalString(field.Alias).MarshalGQL(w)
+				w.Write([]byte{':'})
+				ec.marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx, field.Selections, res).MarshalGQL(w)
+				w.Write([]byte{'}'})
+			})
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (ec *executionContext) fieldContext_Subscription_relationshipSchemaNodeDeleted(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "success":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_success(ctx, field)
+			case "message":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_message(ctx, field)
+			case "relationshipSchemaNode":
+				return ec.fieldContext_RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RelationshipSchemaNodeResponse", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNode_id(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNode_id(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNode_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNode_domain(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNode_domain(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Domain, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNode_domain(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNode_name(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNode_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNode_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNode_type(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNode_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNode_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNode_originalName(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNode_originalName(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OriginalName, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNode_originalName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNode_labels(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNode_labels(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Labels, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalOString2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNode_labels(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNode_properties(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNode_properties(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Properties, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.Property)
+	fc.Result = res
+	return ec.marshalOProperty2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNode_properties(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "key":
+				return ec.fieldContext_Property_key(ctx, field)
+			case "value":
+				return ec.fieldContext_Property_value(ctx, field)
+			case "type":
+				return ec.fieldContext_Property_type(ctx, field)
+			case "readOnly":
+				return ec.fieldContext_Property_readOnly(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Property", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNode_jsonSchemas(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNode_jsonSchemas(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.JSONSchemas, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PropertyJSONSchema)
+	fc.Result = res
+	return ec.marshalOPropertyJsonSchema2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyJSONSchemaᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNode_jsonSchemas(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "key":
+				return ec.fieldContext_PropertyJsonSchema_key(ctx, field)
+			case "schema":
+				return ec.fieldContext_PropertyJsonSchema_schema(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PropertyJsonSchema", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNode_constraints(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNode_constraints(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Constraints, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.PropertyConstraint)
+	fc.Result = res
+	return ec.marshalOPropertyConstraint2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyConstraintᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNode_constraints(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "key":
+				return ec.fieldContext_PropertyConstraint_key(ctx, field)
+			case "allowedValues":
+				return ec.fieldContext_PropertyConstraint_allowedValues(ctx, field)
+			case "min":
+				return ec.fieldContext_PropertyConstraint_min(ctx, field)
+			case "max":
+				return ec.fieldContext_PropertyConstraint_max(ctx, field)
+			case "minLength":
+				return ec.fieldContext_PropertyConstraint_minLength(ctx, field)
+			case "maxLength":
+				return ec.fieldContext_PropertyConstraint_maxLength(ctx, field)
+			case "pattern":
+				return ec.fieldContext_PropertyConstraint_pattern(ctx, field)
+			case "unique":
+				return ec.fieldContext_PropertyConstraint_unique(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext_PropertyConstraint_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PropertyConstraint", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNode_computedProperties(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNode_computedProperties(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ComputedProperties, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.ComputedProperty)
+	fc.Result = res
+	return ec.marshalOComputedProperty2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐComputedPropertyᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNode_computedProperties(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "key":
+				return ec.fieldContext_ComputedProperty_key(ctx, field)
+			case "expression":
+				return ec.fieldContext_ComputedProperty_expression(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ComputedProperty", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNode_parentTypeSchemaNodeId(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNode) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNode_parentTypeSchemaNodeId(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.ParentTypeSchemaNodeID, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNode_parentTypeSchemaNodeId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNode",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNodeResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNodeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNodeResponse_success(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Success, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNodeResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNodeResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNodeResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNodeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNodeResponse_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNodeResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNodeResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNodeResponse_typeSchemaNode(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNodeResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNodeResponse_typeSchemaNode(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TypeSchemaNode, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*model.TypeSchemaNode)
+	fc.Result = res
+	return ec.marshalOTypeSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNode(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNodeResponse_typeSchemaNode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNodeResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TypeSchemaNode_id(ctx, field)
+			case "domain":
+				return ec.fieldContext_TypeSchemaNode_domain(ctx, field)
+			case "name":
+				return ec.fieldContext_TypeSchemaNode_name(ctx, field)
+			case "type":
+				return ec.fieldContext_TypeSchemaNode_type(ctx, field)
+			case "originalName":
+				return ec.fieldContext_TypeSchemaNode_originalName(ctx, field)
+			case "labels":
+				return ec.fieldContext_TypeSchemaNode_labels(ctx, field)
+			case "properties":
+				return ec.fieldContext_TypeSchemaNode_properties(ctx, field)
+			case "jsonSchemas":
+				return ec.fieldContext_TypeSchemaNode_jsonSchemas(ctx, field)
+			case "constraints":
+				return ec.fieldContext_TypeSchemaNode_constraints(ctx, field)
+			case "computedProperties":
+				return ec.fieldContext_TypeSchemaNode_computedProperties(ctx, field)
+			case "parentTypeSchemaNodeId":
+				return ec.fieldContext_TypeSchemaNode_parentTypeSchemaNodeId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNode", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNodesResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNodesResponse_success(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Success, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNodesResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNodesResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNodesResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNodesResponse_message(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Message, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNodesResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNodesResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _TypeSchemaNodesResponse_typeSchemaNodes(ctx context.Context, field graphql.CollectedField, obj *model.TypeSchemaNodesResponse) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext_TypeSchemaNodesResponse_typeSchemaNodes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.TypeSchemaNodes, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]*model.TypeSchemaNode)
+	fc.Result = res
+	return ec.marshalOTypeSchemaNode2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext_TypeSchemaNodesResponse_typeSchemaNodes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "TypeSchemaNodesResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_TypeSchemaNode_id(ctx, field)
+			case "domain":
+				return ec.fieldContext_TypeSchemaNode_domain(ctx, field)
+			case "name":
+				return ec.fieldContext_TypeSchemaNode_name(ctx, field)
+			case "type":
+				return ec.fieldContext_TypeSchemaNode_type(ctx, field)
+			case "originalName":
+				return ec.fieldContext_TypeSchemaNode_originalName(ctx, field)
+			case "labels":
+				return ec.fieldContext_TypeSchemaNode_labels(ctx, field)
+			case "properties":
+				return ec.fieldContext_TypeSchemaNode_properties(ctx, field)
+			case "jsonSchemas":
+				return ec.fieldContext_TypeSchemaNode_jsonSchemas(ctx, field)
+			case "constraints":
+				return ec.fieldContext_TypeSchemaNode_constraints(ctx, field)
+			case "computedProperties":
+				return ec.fieldContext_TypeSchemaNode_computedProperties(ctx, field)
+			case "parentTypeSchemaNodeId":
+				return ec.fieldContext_TypeSchemaNode_parentTypeSchemaNodeId(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type TypeSchemaNode", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_locations(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_locations(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Locations, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]string)
+	fc.Result = res
+	return ec.marshalN__DirectiveLocation2ᚕstringᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_locations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __DirectiveLocation does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_args(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Directive_isRepeatable(ctx context.Context, field graphql.CollectedField, obj *introspection.Directive) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Directive_isRepeatable(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsRepeatable, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Directive_isRepeatable(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Directive",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___EnumValue_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.EnumValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___EnumValue_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__EnumValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_args(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_args(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Args, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_args(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_type(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_isDeprecated(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_isDeprecated(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.IsDeprecated(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(bool)
+	fc.Result = res
+	return ec.marshalNBoolean2bool(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_isDeprecated(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Field_deprecationReason(ctx context.Context, field graphql.CollectedField, obj *introspection.Field) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Field_deprecationReason(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DeprecationReason(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Field_deprecationReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Field",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_name(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalNString2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_description(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_type(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_type(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Type, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___InputValue_defaultValue(ctx context.Context, field graphql.CollectedField, obj *introspection.InputValue) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___InputValue_defaultValue(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.DefaultValue, nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___InputValue_defaultValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__InputValue",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_types(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_types(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Types(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_types(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_queryType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_queryType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.QueryType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_queryType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_mutationType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_mutationType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.MutationType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_mutationType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_subscriptionType(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_subscriptionType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SubscriptionType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_subscriptionType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Schema_directives(ctx context.Context, field graphql.CollectedField, obj *introspection.Schema) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Schema_directives(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Directives(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Directive)
+	fc.Result = res
+	return ec.marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Schema_directives(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Schema",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Directive_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Directive_description(ctx, field)
+			case "locations":
+				return ec.fieldContext___Directive_locations(ctx, field)
+			case "args":
+				return ec.fieldContext___Directive_args(ctx, field)
+			case "isRepeatable":
+				return ec.fieldContext___Directive_isRepeatable(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Directive", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_kind(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_kind(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Kind(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		if !graphql.HasFieldError(ctx, fc) {
+			ec.Errorf(ctx, "must not be null")
+		}
+		return graphql.Null
+	}
+	res := resTmp.(string)
+	fc.Result = res
+	return ec.marshalN__TypeKind2string(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type __TypeKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_name(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_name(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Name(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_description(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_description(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Description(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_fields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_fields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Fields(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Field)
+	fc.Result = res
+	return ec.marshalO__Field2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐFieldᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_fields(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___Field_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Field_description(ctx, field)
+			case "args":
+				return ec.fieldContext___Field_args(ctx, field)
+			case "type":
+				return ec.fieldContext___Field_type(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___Field_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___Field_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Field", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_fields_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_interfaces(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_interfaces(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.Interfaces(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_interfaces(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_possibleTypes(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_possibleTypes(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.PossibleTypes(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_possibleTypes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_enumValues(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_enumValues(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.EnumValues(fc.Args["includeDeprecated"].(bool)), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.EnumValue)
+	fc.Result = res
+	return ec.marshalO__EnumValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_enumValues(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___EnumValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___EnumValue_description(ctx, field)
+			case "isDeprecated":
+				return ec.fieldContext___EnumValue_isDeprecated(ctx, field)
+			case "deprecationReason":
+				return ec.fieldContext___EnumValue_deprecationReason(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __EnumValue", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field___Type_enumValues_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_inputFields(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_inputFields(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.InputFields(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.([]introspection.InputValue)
+	fc.Result = res
+	return ec.marshalO__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_inputFields(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext___InputValue_name(ctx, field)
+			case "description":
+				return ec.fieldContext___InputValue_description(ctx, field)
+			case "type":
+				return ec.fieldContext___InputValue_type(ctx, field)
+			case "defaultValue":
+				return ec.fieldContext___InputValue_defaultValue(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __InputValue", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_ofType(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_ofType(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.OfType(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*introspection.Type)
+	fc.Result = res
+	return ec.marshalO__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_ofType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "kind":
+				return ec.fieldContext___Type_kind(ctx, field)
+			case "name":
+				return ec.fieldContext___Type_name(ctx, field)
+			case "description":
+				return ec.fieldContext___Type_description(ctx, field)
+			case "fields":
+				return ec.fieldContext___Type_fields(ctx, field)
+			case "interfaces":
+				return ec.fieldContext___Type_interfaces(ctx, field)
+			case "possibleTypes":
+				return ec.fieldContext___Type_possibleTypes(ctx, field)
+			case "enumValues":
+				return ec.fieldContext___Type_enumValues(ctx, field)
+			case "inputFields":
+				return ec.fieldContext___Type_inputFields(ctx, field)
+			case "ofType":
+				return ec.fieldContext___Type_ofType(ctx, field)
+			case "specifiedByURL":
+				return ec.fieldContext___Type_specifiedByURL(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type __Type", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) ___Type_specifiedByURL(ctx context.Context, field graphql.CollectedField, obj *introspection.Type) (ret graphql.Marshaler) {
+	fc, err := ec.fieldContext___Type_specifiedByURL(ctx, field)
+	if err != nil {
+		return graphql.Null
+	}
+	ctx = graphql.WithFieldContext(ctx, fc)
+	defer func() {
+		if r := recover(); r != nil {
+			ec.Error(ctx, ec.Recover(ctx, r))
+			ret = graphql.Null
+		}
+	}()
+	resTmp, err := ec.ResolverMiddleware(ctx, func(rctx context.Context) (interface{}, error) {
+		ctx = rctx // use context from middleware stack in children
+		return obj.SpecifiedByURL(), nil
+	})
+	if err != nil {
+		ec.Error(ctx, err)
+		return graphql.Null
+	}
+	if resTmp == nil {
+		return graphql.Null
+	}
+	res := resTmp.(*string)
+	fc.Result = res
+	return ec.marshalOString2ᚖstring(ctx, field.Selections, res)
+}
+
+func (ec *executionContext) fieldContext___Type_specifiedByURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "__Type",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputDeleteObjectNodeInput(ctx context.Context, obj interface{}) (model.DeleteObjectNodeInput, error) {
+	var it model.DeleteObjectNodeInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"domain", "name", "type"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "domain":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Domain = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputObjectNodeInput(ctx context.Context, obj interface{}) (model.ObjectNodeInput, error) {
+	var it model.ObjectNodeInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"domain", "name", "type", "labels", "properties"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "domain":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Domain = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		case "labels":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("labels"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Labels = data
+		case "properties":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("properties"))
+			data, err := ec.unmarshalOPropertyInput2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Properties = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPointInput(ctx context.Context, obj interface{}) (model.PointInput, error) {
+	var it model.PointInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"latitude", "longitude", "x", "y"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "latitude":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("latitude"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Latitude = data
+		case "longitude":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("longitude"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Longitude = data
+		case "x":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("x"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.X = data
+		case "y":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("y"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Y = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPropertyConstraintInput(ctx context.Context, obj interface{}) (model.PropertyConstraintInput, error) {
+	var it model.PropertyConstraintInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["unique"]; !present {
+		asMap["unique"] = false
+	}
+
+	fieldsInOrder := [...]string{"allowedValues", "min", "max", "minLength", "maxLength", "pattern", "unique", "defaultValue"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "allowedValues":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("allowedValues"))
+			data, err := ec.unmarshalOAny2ᚕinterfaceᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AllowedValues = data
+		case "min":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("min"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Min = data
+		case "max":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("max"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Max = data
+		case "minLength":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minLength"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MinLength = data
+		case "maxLength":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxLength"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxLength = data
+		case "pattern":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pattern"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Pattern = data
+		case "unique":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("unique"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Unique = data
+		case "defaultValue":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("defaultValue"))
+			data, err := ec.unmarshalOAny2interface(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DefaultValue = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPropertyFilter(ctx context.Context, obj interface{}) (model.PropertyFilter, error) {
+	var it model.PropertyFilter
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"key", "operator", "value", "type", "path"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "key":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Key = data
+		case "operator":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("operator"))
+			data, err := ec.unmarshalNFilterOperator2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐFilterOperator(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Operator = data
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalNAny2interface(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalNPropertyType2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		case "path":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Path = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPropertyInput(ctx context.Context, obj interface{}) (model.PropertyInput, error) {
+	var it model.PropertyInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"key", "value", "type"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "key":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Key = data
+		case "value":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
+			data, err := ec.unmarshalOAny2interface(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Value = data
+		case "type":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
+			data, err := ec.unmarshalNPropertyType2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyType(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Type = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPropertySort(ctx context.Context, obj interface{}) (model.PropertySort, error) {
+	var it model.PropertySort
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["direction"]; !present {
+		asMap["direction"] = "ASC"
+	}
+
+	fieldsInOrder := [...]string{"key", "direction"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "key":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Key = data
+		case "direction":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("direction"))
+			data, err := ec.unmarshalOSortDirection2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSortDirection(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Direction = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRelationshipCardinalityInput(ctx context.Context, obj interface{}) (model.RelationshipCardinalityInput, error) {
+	var it model.RelationshipCardinalityInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["fromRequired"]; !present {
+		asMap["fromRequired"] = false
+	}
+	if _, present := asMap["toRequired"]; !present {
+		asMap["toRequired"] = false
+	}
+
+	fieldsInOrder := [...]string{"cardinality", "fromRequired", "toRequired"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
-		switch k {
+		switch k {
+		case "cardinality":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("cardinality"))
+			data, err := ec.unmarshalNCardinality2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐCardinality(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Cardinality = data
+		case "fromRequired":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fromRequired"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FromRequired = data
+		case "toRequired":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("toRequired"))
+			data, err := ec.unmarshalOBoolean2ᚖbool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ToRequired = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSchemaDiffAgainst(ctx context.Context, obj interface{}) (model.SchemaDiffAgainst, error) {
+	var it model.SchemaDiffAgainst
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"document", "domain"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "document":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("document"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Document = data
+		case "domain":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Domain = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateObjectNodeInput(ctx context.Context, obj interface{}) (model.UpdateObjectNodeInput, error) {
+	var it model.UpdateObjectNodeInput
+	asMap := map[string]interface{}{}
+	for k, v := range obj.(map[string]interface{}) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"name", "labels", "properties"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "labels":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("labels"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Labels = data
+		case "properties":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("properties"))
+			data, err := ec.unmarshalOPropertyInput2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Properties = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+func (ec *executionContext) _ObjectNodeOrRelationshipNode(ctx context.Context, sel ast.SelectionSet, obj model.ObjectNodeOrRelationshipNode) graphql.Marshaler {
+	switch obj := (obj).(type) {
+	case nil:
+		return graphql.Null
+	case model.ObjectNode:
+		return ec._ObjectNode(ctx, sel, &obj)
+	case *model.ObjectNode:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._ObjectNode(ctx, sel, obj)
+	case model.ObjectRelationship:
+		return ec._ObjectRelationship(ctx, sel, &obj)
+	case *model.ObjectRelationship:
+		if obj == nil {
+			return graphql.Null
+		}
+		return ec._ObjectRelationship(ctx, sel, obj)
+	default:
+		panic(fmt.Errorf("unexpected type %T", obj))
+	}
+}
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var cardinalityReportResponseImplementors = []string{"CardinalityReportResponse"}
+
+func (ec *executionContext) _CardinalityReportResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CardinalityReportResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cardinalityReportResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CardinalityReportResponse")
+		case "success":
+			out.Values[i] = ec._CardinalityReportResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._CardinalityReportResponse_message(ctx, field, obj)
+		case "violations":
+			out.Values[i] = ec._CardinalityReportResponse_violations(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var cardinalityViolationImplementors = []string{"CardinalityViolation"}
+
+func (ec *executionContext) _CardinalityViolation(ctx context.Context, sel ast.SelectionSet, obj *model.CardinalityViolation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cardinalityViolationImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CardinalityViolation")
+		case "relationshipSchemaNodeId":
+			out.Values[i] = ec._CardinalityViolation_relationshipSchemaNodeId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "relationship":
+			out.Values[i] = ec._CardinalityViolation_relationship(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "objectNodeId":
+			out.Values[i] = ec._CardinalityViolation_objectNodeId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "side":
+			out.Values[i] = ec._CardinalityViolation_side(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "count":
+			out.Values[i] = ec._CardinalityViolation_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._CardinalityViolation_message(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var computedPropertyImplementors = []string{"ComputedProperty"}
+
+func (ec *executionContext) _ComputedProperty(ctx context.Context, sel ast.SelectionSet, obj *model.ComputedProperty) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, computedPropertyImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ComputedProperty")
+		case "key":
+			out.Values[i] = ec._ComputedProperty_key(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expression":
+			out.Values[i] = ec._ComputedProperty_expression(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var domainDependenciesResponseImplementors = []string{"DomainDependenciesResponse"}
+
+func (ec *executionContext) _DomainDependenciesResponse(ctx context.Context, sel ast.SelectionSet, obj *model.DomainDependenciesResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, domainDependenciesResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DomainDependenciesResponse")
+		case "success":
+			out.Values[i] = ec._DomainDependenciesResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._DomainDependenciesResponse_message(ctx, field, obj)
+		case "dependencies":
+			out.Values[i] = ec._DomainDependenciesResponse_dependencies(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var domainDependencyImplementors = []string{"DomainDependency"}
+
+func (ec *executionContext) _DomainDependency(ctx context.Context, sel ast.SelectionSet, obj *model.DomainDependency) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, domainDependencyImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DomainDependency")
+		case "direction":
+			out.Values[i] = ec._DomainDependency_direction(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "domain":
+			out.Values[i] = ec._DomainDependency_domain(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "relationship":
+			out.Values[i] = ec._DomainDependency_relationship(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "relationshipSchemaNodeId":
+			out.Values[i] = ec._DomainDependency_relationshipSchemaNodeId(ctx, field, obj)
+		case "objectRelationshipCount":
+			out.Values[i] = ec._DomainDependency_objectRelationshipCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var domainSchemaNodeImplementors = []string{"DomainSchemaNode"}
+
+func (ec *executionContext) _DomainSchemaNode(ctx context.Context, sel ast.SelectionSet, obj *model.DomainSchemaNode) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, domainSchemaNodeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DomainSchemaNode")
+		case "id":
+			out.Values[i] = ec._DomainSchemaNode_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "domain":
+			out.Values[i] = ec._DomainSchemaNode_domain(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._DomainSchemaNode_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec._DomainSchemaNode_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "labels":
+			out.Values[i] = ec._DomainSchemaNode_labels(ctx, field, obj)
+		case "properties":
+			out.Values[i] = ec._DomainSchemaNode_properties(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var domainSchemaNodeResponseImplementors = []string{"DomainSchemaNodeResponse"}
+
+func (ec *executionContext) _DomainSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, obj *model.DomainSchemaNodeResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, domainSchemaNodeResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DomainSchemaNodeResponse")
+		case "success":
+			out.Values[i] = ec._DomainSchemaNodeResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._DomainSchemaNodeResponse_message(ctx, field, obj)
+		case "domainSchemaNode":
+			out.Values[i] = ec._DomainSchemaNodeResponse_domainSchemaNode(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var domainSchemaNodesResponseImplementors = []string{"DomainSchemaNodesResponse"}
+
+func (ec *executionContext) _DomainSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, obj *model.DomainSchemaNodesResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, domainSchemaNodesResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DomainSchemaNodesResponse")
+		case "success":
+			out.Values[i] = ec._DomainSchemaNodesResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._DomainSchemaNodesResponse_message(ctx, field, obj)
+		case "domainSchemaNodes":
+			out.Values[i] = ec._DomainSchemaNodesResponse_domainSchemaNodes(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var indexImplementors = []string{"Index"}
+
+func (ec *executionContext) _Index(ctx context.Context, sel ast.SelectionSet, obj *model.Index) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, indexImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Index")
+		case "name":
+			out.Values[i] = ec._Index_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec._Index_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "entityType":
+			out.Values[i] = ec._Index_entityType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "labelsOrTypes":
+			out.Values[i] = ec._Index_labelsOrTypes(ctx, field, obj)
+		case "properties":
+			out.Values[i] = ec._Index_properties(ctx, field, obj)
+		case "state":
+			out.Values[i] = ec._Index_state(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "owningConstraint":
+			out.Values[i] = ec._Index_owningConstraint(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var indexesResponseImplementors = []string{"IndexesResponse"}
+
+func (ec *executionContext) _IndexesResponse(ctx context.Context, sel ast.SelectionSet, obj *model.IndexesResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, indexesResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("IndexesResponse")
+		case "success":
+			out.Values[i] = ec._IndexesResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._IndexesResponse_message(ctx, field, obj)
+		case "indexes":
+			out.Values[i] = ec._IndexesResponse_indexes(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var mutationImplementors = []string{"Mutation"}
+
+func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Mutation",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Mutation")
+		case "createObjectNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createObjectNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "renameObjectNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_renameObjectNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteObjectNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteObjectNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "addLabelsOnObjectNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_addLabelsOnObjectNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "removeLabelsFromObjectNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_removeLabelsFromObjectNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatePropertiesOnObjectNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updatePropertiesOnObjectNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "removePropertiesFromObjectNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_removePropertiesFromObjectNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createObjectRelationship":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createObjectRelationship(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatePropertiesOnObjectRelationship":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updatePropertiesOnObjectRelationship(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "removePropertiesFromObjectRelationship":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_removePropertiesFromObjectRelationship(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteObjectRelationship":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteObjectRelationship(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createDomainSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createDomainSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "renameDomainSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_renameDomainSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteDomainSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteDomainSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createTypeSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createTypeSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "renameTypeSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_renameTypeSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatePropertiesOnTypeSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updatePropertiesOnTypeSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "renamePropertyOnTypeSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_renamePropertyOnTypeSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "removePropertiesFromTypeSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_removePropertiesFromTypeSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setJsonSchemaOnTypeSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setJsonSchemaOnTypeSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setPropertyConstraintOnTypeSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setPropertyConstraintOnTypeSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setComputedPropertyOnTypeSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setComputedPropertyOnTypeSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setParentOnTypeSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setParentOnTypeSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteTypeSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteTypeSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createRelationshipSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_createRelationshipSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "renameRelationshipSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_renameRelationshipSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatePropertiesOnRelationshipSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_updatePropertiesOnRelationshipSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "renamePropertyOnRelationshipSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_renamePropertyOnRelationshipSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "removePropertiesFromRelationshipSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_removePropertiesFromRelationshipSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "setCardinalityOnRelationshipSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_setCardinalityOnRelationshipSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deleteRelationshipSchemaNode":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_deleteRelationshipSchemaNode(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "applyDomainSchema":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_applyDomainSchema(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "rebuildConstraints":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Mutation_rebuildConstraints(ctx, field)
+			})
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var objectNodeImplementors = []string{"ObjectNode", "ObjectNodeOrRelationshipNode"}
+
+func (ec *executionContext) _ObjectNode(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectNode) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, objectNodeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ObjectNode")
+		case "id":
+			out.Values[i] = ec._ObjectNode_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "domain":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._ObjectNode_domain(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Domain = data
 		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._ObjectNode_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Name = data
 		case "type":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._ObjectNode_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "originalName":
+			out.Values[i] = ec._ObjectNode_originalName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "labels":
+			out.Values[i] = ec._ObjectNode_labels(ctx, field, obj)
+		case "properties":
+			out.Values[i] = ec._ObjectNode_properties(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var objectNodeResponseImplementors = []string{"ObjectNodeResponse"}
+
+func (ec *executionContext) _ObjectNodeResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectNodeResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, objectNodeResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ObjectNodeResponse")
+		case "success":
+			out.Values[i] = ec._ObjectNodeResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._ObjectNodeResponse_message(ctx, field, obj)
+		case "objectNode":
+			out.Values[i] = ec._ObjectNodeResponse_objectNode(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var objectNodesOrRelationshipNodesResponseImplementors = []string{"ObjectNodesOrRelationshipNodesResponse"}
+
+func (ec *executionContext) _ObjectNodesOrRelationshipNodesResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectNodesOrRelationshipNodesResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, objectNodesOrRelationshipNodesResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ObjectNodesOrRelationshipNodesResponse")
+		case "success":
+			out.Values[i] = ec._ObjectNodesOrRelationshipNodesResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._ObjectNodesOrRelationshipNodesResponse_message(ctx, field, obj)
+		case "objectNodesOrRelationshipNodes":
+			out.Values[i] = ec._ObjectNodesOrRelationshipNodesResponse_objectNodesOrRelationshipNodes(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var objectNodesResponseImplementors = []string{"ObjectNodesResponse"}
+
+func (ec *executionContext) _ObjectNodesResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectNodesResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, objectNodesResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ObjectNodesResponse")
+		case "success":
+			out.Values[i] = ec._ObjectNodesResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Type = data
+		case "message":
+			out.Values[i] = ec._ObjectNodesResponse_message(ctx, field, obj)
+		case "objectNodes":
+			out.Values[i] = ec._ObjectNodesResponse_objectNodes(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputObjectNodeInput(ctx context.Context, obj interface{}) (model.ObjectNodeInput, error) {
-	var it model.ObjectNodeInput
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"domain", "name", "type", "labels", "properties"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "domain":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("domain"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+	return out
+}
+
+var objectRelationshipImplementors = []string{"ObjectRelationship", "ObjectNodeOrRelationshipNode"}
+
+func (ec *executionContext) _ObjectRelationship(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectRelationship) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, objectRelationshipImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ObjectRelationship")
+		case "id":
+			out.Values[i] = ec._ObjectRelationship_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Domain = data
 		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Name = data
-		case "type":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._ObjectRelationship_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Type = data
-		case "labels":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("labels"))
-			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
-			if err != nil {
-				return it, err
+		case "originalName":
+			out.Values[i] = ec._ObjectRelationship_originalName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Labels = data
 		case "properties":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("properties"))
-			data, err := ec.unmarshalOPropertyInput2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._ObjectRelationship_properties(ctx, field, obj)
+		case "fromObjectNodeId":
+			out.Values[i] = ec._ObjectRelationship_fromObjectNodeId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Properties = data
+		case "toObjectNodeId":
+			out.Values[i] = ec._ObjectRelationship_toObjectNodeId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputPropertyInput(ctx context.Context, obj interface{}) (model.PropertyInput, error) {
-	var it model.PropertyInput
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
 
-	fieldsInOrder := [...]string{"key", "value", "type"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "key":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("key"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Key = data
-		case "value":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("value"))
-			data, err := ec.unmarshalNAny2interface(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Value = data
-		case "type":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("type"))
-			data, err := ec.unmarshalNPropertyType2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyType(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Type = data
-		}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	return it, nil
+	return out
 }
 
-func (ec *executionContext) unmarshalInputUpdateObjectNodeInput(ctx context.Context, obj interface{}) (model.UpdateObjectNodeInput, error) {
-	var it model.UpdateObjectNodeInput
-	asMap := map[string]interface{}{}
-	for k, v := range obj.(map[string]interface{}) {
-		asMap[k] = v
-	}
+var objectRelationshipObjectNodeImplementors = []string{"ObjectRelationshipObjectNode"}
 
-	fieldsInOrder := [...]string{"name", "labels", "properties"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "name":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) _ObjectRelationshipObjectNode(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectRelationshipObjectNode) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, objectRelationshipObjectNodeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ObjectRelationshipObjectNode")
+		case "id":
+			out.Values[i] = ec._ObjectRelationshipObjectNode_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Name = data
-		case "labels":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("labels"))
-			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
-			if err != nil {
-				return it, err
+		case "fromObjectNode":
+			out.Values[i] = ec._ObjectRelationshipObjectNode_fromObjectNode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Labels = data
-		case "properties":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("properties"))
-			data, err := ec.unmarshalOPropertyInput2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
+		case "relationshipNode":
+			out.Values[i] = ec._ObjectRelationshipObjectNode_relationshipNode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Properties = data
+		case "toObjectNode":
+			out.Values[i] = ec._ObjectRelationshipObjectNode_toObjectNode(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
-
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) _ObjectNodeOrRelationshipNode(ctx context.Context, sel ast.SelectionSet, obj model.ObjectNodeOrRelationshipNode) graphql.Marshaler {
-	switch obj := (obj).(type) {
-	case nil:
-		return graphql.Null
-	case model.ObjectNode:
-		return ec._ObjectNode(ctx, sel, &obj)
-	case *model.ObjectNode:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._ObjectNode(ctx, sel, obj)
-	case model.ObjectRelationship:
-		return ec._ObjectRelationship(ctx, sel, &obj)
-	case *model.ObjectRelationship:
-		if obj == nil {
-			return graphql.Null
-		}
-		return ec._ObjectRelationship(ctx, sel, obj)
-	default:
-		panic(fmt.Errorf("unexpected type %T", obj))
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
-}
-
-// endregion ************************** interface.gotpl ***************************
 
-// region    **************************** object.gotpl ****************************
+	return out
+}
 
-var domainSchemaNodeImplementors = []string{"DomainSchemaNode"}
+var objectRelationshipObjectNodeResponseImplementors = []string{"ObjectRelationshipObjectNodeResponse"}
 
-func (ec *executionContext) _DomainSchemaNode(ctx context.Context, sel ast.SelectionSet, obj *model.DomainSchemaNode) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, domainSchemaNodeImplementors)
+func (ec *executionContext) _ObjectRelationshipObjectNodeResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectRelationshipObjectNodeResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, objectRelationshipObjectNodeResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("DomainSchemaNode")
-		case "id":
-			out.Values[i] = ec._DomainSchemaNode_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "domain":
-			out.Values[i] = ec._DomainSchemaNode_domain(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec._DomainSchemaNode_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "type":
-			out.Values[i] = ec._DomainSchemaNode_type(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ObjectRelationshipObjectNodeResponse")
+		case "success":
+			out.Values[i] = ec._ObjectRelationshipObjectNodeResponse_success(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "labels":
-			out.Values[i] = ec._DomainSchemaNode_labels(ctx, field, obj)
-		case "properties":
-			out.Values[i] = ec._DomainSchemaNode_properties(ctx, field, obj)
+		case "message":
+			out.Values[i] = ec._ObjectRelationshipObjectNodeResponse_message(ctx, field, obj)
+		case "objectRelationshipObjectNodes":
+			out.Values[i] = ec._ObjectRelationshipObjectNodeResponse_objectRelationshipObjectNodes(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -12869,26 +19950,26 @@ func (ec *executionContext) _DomainSchemaNode(ctx context.Context, sel ast.Selec
 	return out
 }
 
-var domainSchemaNodeResponseImplementors = []string{"DomainSchemaNodeResponse"}
+var objectRelationshipObjectNodesResponseImplementors = []string{"ObjectRelationshipObjectNodesResponse"}
 
-func (ec *executionContext) _DomainSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, obj *model.DomainSchemaNodeResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, domainSchemaNodeResponseImplementors)
+func (ec *executionContext) _ObjectRelationshipObjectNodesResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectRelationshipObjectNodesResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, objectRelationshipObjectNodesResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("DomainSchemaNodeResponse")
+			out.Values[i] = graphql.MarshalString("ObjectRelationshipObjectNodesResponse")
 		case "success":
-			out.Values[i] = ec._DomainSchemaNodeResponse_success(ctx, field, obj)
+			out.Values[i] = ec._ObjectRelationshipObjectNodesResponse_success(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "message":
-			out.Values[i] = ec._DomainSchemaNodeResponse_message(ctx, field, obj)
-		case "domainSchemaNode":
-			out.Values[i] = ec._DomainSchemaNodeResponse_domainSchemaNode(ctx, field, obj)
+			out.Values[i] = ec._ObjectRelationshipObjectNodesResponse_message(ctx, field, obj)
+		case "objectRelationshipObjectNodes":
+			out.Values[i] = ec._ObjectRelationshipObjectNodesResponse_objectRelationshipObjectNodes(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -12912,26 +19993,26 @@ func (ec *executionContext) _DomainSchemaNodeResponse(ctx context.Context, sel a
 	return out
 }
 
-var domainSchemaNodesResponseImplementors = []string{"DomainSchemaNodesResponse"}
+var objectRelationshipResponseImplementors = []string{"ObjectRelationshipResponse"}
 
-func (ec *executionContext) _DomainSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, obj *model.DomainSchemaNodesResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, domainSchemaNodesResponseImplementors)
+func (ec *executionContext) _ObjectRelationshipResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectRelationshipResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, objectRelationshipResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("DomainSchemaNodesResponse")
+			out.Values[i] = graphql.MarshalString("ObjectRelationshipResponse")
 		case "success":
-			out.Values[i] = ec._DomainSchemaNodesResponse_success(ctx, field, obj)
+			out.Values[i] = ec._ObjectRelationshipResponse_success(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "message":
-			out.Values[i] = ec._DomainSchemaNodesResponse_message(ctx, field, obj)
-		case "domainSchemaNodes":
-			out.Values[i] = ec._DomainSchemaNodesResponse_domainSchemaNodes(ctx, field, obj)
+			out.Values[i] = ec._ObjectRelationshipResponse_message(ctx, field, obj)
+		case "objectRelationship":
+			out.Values[i] = ec._ObjectRelationshipResponse_objectRelationship(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -12955,204 +20036,179 @@ func (ec *executionContext) _DomainSchemaNodesResponse(ctx context.Context, sel
 	return out
 }
 
-var mutationImplementors = []string{"Mutation"}
+var objectRelationshipsResponseImplementors = []string{"ObjectRelationshipsResponse"}
 
-func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, mutationImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Mutation",
-	})
+func (ec *executionContext) _ObjectRelationshipsResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectRelationshipsResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, objectRelationshipsResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Mutation")
-		case "createObjectNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createObjectNode(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "renameObjectNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_renameObjectNode(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deleteObjectNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteObjectNode(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "addLabelsOnObjectNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_addLabelsOnObjectNode(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "removeLabelsFromObjectNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_removeLabelsFromObjectNode(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "updatePropertiesOnObjectNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updatePropertiesOnObjectNode(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "removePropertiesFromObjectNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_removePropertiesFromObjectNode(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "createObjectRelationship":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createObjectRelationship(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "updatePropertiesOnObjectRelationship":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updatePropertiesOnObjectRelationship(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "removePropertiesFromObjectRelationship":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_removePropertiesFromObjectRelationship(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deleteObjectRelationship":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteObjectRelationship(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "createDomainSchemaNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createDomainSchemaNode(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "renameDomainSchemaNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_renameDomainSchemaNode(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deleteDomainSchemaNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteDomainSchemaNode(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "createTypeSchemaNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createTypeSchemaNode(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "renameTypeSchemaNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_renameTypeSchemaNode(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "updatePropertiesOnTypeSchemaNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updatePropertiesOnTypeSchemaNode(ctx, field)
-			})
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "renamePropertyOnTypeSchemaNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_renamePropertyOnTypeSchemaNode(ctx, field)
-			})
+			out.Values[i] = graphql.MarshalString("ObjectRelationshipsResponse")
+		case "success":
+			out.Values[i] = ec._ObjectRelationshipsResponse_success(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "removePropertiesFromTypeSchemaNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_removePropertiesFromTypeSchemaNode(ctx, field)
-			})
+		case "message":
+			out.Values[i] = ec._ObjectRelationshipsResponse_message(ctx, field, obj)
+		case "objectRelationships":
+			out.Values[i] = ec._ObjectRelationshipsResponse_objectRelationships(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var propertyImplementors = []string{"Property"}
+
+func (ec *executionContext) _Property(ctx context.Context, sel ast.SelectionSet, obj *model.Property) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, propertyImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Property")
+		case "key":
+			out.Values[i] = ec._Property_key(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "deleteTypeSchemaNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteTypeSchemaNode(ctx, field)
-			})
+		case "value":
+			out.Values[i] = ec._Property_value(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "createRelationshipSchemaNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_createRelationshipSchemaNode(ctx, field)
-			})
+		case "type":
+			out.Values[i] = ec._Property_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "renameRelationshipSchemaNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_renameRelationshipSchemaNode(ctx, field)
-			})
+		case "readOnly":
+			out.Values[i] = ec._Property_readOnly(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "updatePropertiesOnRelationshipSchemaNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_updatePropertiesOnRelationshipSchemaNode(ctx, field)
-			})
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var propertyConstraintImplementors = []string{"PropertyConstraint"}
+
+func (ec *executionContext) _PropertyConstraint(ctx context.Context, sel ast.SelectionSet, obj *model.PropertyConstraint) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, propertyConstraintImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PropertyConstraint")
+		case "key":
+			out.Values[i] = ec._PropertyConstraint_key(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "renamePropertyOnRelationshipSchemaNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_renamePropertyOnRelationshipSchemaNode(ctx, field)
-			})
+		case "allowedValues":
+			out.Values[i] = ec._PropertyConstraint_allowedValues(ctx, field, obj)
+		case "min":
+			out.Values[i] = ec._PropertyConstraint_min(ctx, field, obj)
+		case "max":
+			out.Values[i] = ec._PropertyConstraint_max(ctx, field, obj)
+		case "minLength":
+			out.Values[i] = ec._PropertyConstraint_minLength(ctx, field, obj)
+		case "maxLength":
+			out.Values[i] = ec._PropertyConstraint_maxLength(ctx, field, obj)
+		case "pattern":
+			out.Values[i] = ec._PropertyConstraint_pattern(ctx, field, obj)
+		case "unique":
+			out.Values[i] = ec._PropertyConstraint_unique(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "removePropertiesFromRelationshipSchemaNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_removePropertiesFromRelationshipSchemaNode(ctx, field)
-			})
+		case "defaultValue":
+			out.Values[i] = ec._PropertyConstraint_defaultValue(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var propertyJsonSchemaImplementors = []string{"PropertyJsonSchema"}
+
+func (ec *executionContext) _PropertyJsonSchema(ctx context.Context, sel ast.SelectionSet, obj *model.PropertyJSONSchema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, propertyJsonSchemaImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PropertyJsonSchema")
+		case "key":
+			out.Values[i] = ec._PropertyJsonSchema_key(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "deleteRelationshipSchemaNode":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Mutation_deleteRelationshipSchemaNode(ctx, field)
-			})
+		case "schema":
+			out.Values[i] = ec._PropertyJsonSchema_schema(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -13165,304 +20221,487 @@ func (ec *executionContext) _Mutation(ctx context.Context, sel ast.SelectionSet)
 		return graphql.Null
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var queryImplementors = []string{"Query"}
+
+func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Query",
+	})
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
+			Object: field.Name,
+			Field:  field,
+		})
+
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Query")
+		case "getObjectNode":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getObjectNode(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getObjectNodes":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getObjectNodes(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "objectNodesWithinDistance":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_objectNodesWithinDistance(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "objectNodesInBoundingBox":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_objectNodesInBoundingBox(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getObjectNodeRelationship":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getObjectNodeRelationship(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getObjectNodeOutgoingRelationships":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getObjectNodeOutgoingRelationships(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getObjectNodeIncomingRelationships":
+			field := field
 
-	return out
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getObjectNodeIncomingRelationships(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-var objectNodeImplementors = []string{"ObjectNode", "ObjectNodeOrRelationshipNode"}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) _ObjectNode(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectNode) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, objectNodeImplementors)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getDomainSchemaNode":
+			field := field
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ObjectNode")
-		case "id":
-			out.Values[i] = ec._ObjectNode_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "domain":
-			out.Values[i] = ec._ObjectNode_domain(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec._ObjectNode_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getDomainSchemaNode(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "type":
-			out.Values[i] = ec._ObjectNode_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "originalName":
-			out.Values[i] = ec._ObjectNode_originalName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getDomainSchemaNodes":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getDomainSchemaNodes(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "labels":
-			out.Values[i] = ec._ObjectNode_labels(ctx, field, obj)
-		case "properties":
-			out.Values[i] = ec._ObjectNode_properties(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getDomainExternalDependencies":
+			field := field
 
-	return out
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getDomainExternalDependencies(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-var objectNodeResponseImplementors = []string{"ObjectNodeResponse"}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) _ObjectNodeResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectNodeResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, objectNodeResponseImplementors)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getTypeSchemaNode":
+			field := field
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ObjectNodeResponse")
-		case "success":
-			out.Values[i] = ec._ObjectNodeResponse_success(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getTypeSchemaNode(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "message":
-			out.Values[i] = ec._ObjectNodeResponse_message(ctx, field, obj)
-		case "objectNode":
-			out.Values[i] = ec._ObjectNodeResponse_objectNode(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getTypeSchemaNodes":
+			field := field
 
-	return out
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getTypeSchemaNodes(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-var objectNodesOrRelationshipNodesResponseImplementors = []string{"ObjectNodesOrRelationshipNodesResponse"}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) _ObjectNodesOrRelationshipNodesResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectNodesOrRelationshipNodesResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, objectNodesOrRelationshipNodesResponseImplementors)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getTypeSchemaNodeOutgoingRelationships":
+			field := field
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ObjectNodesOrRelationshipNodesResponse")
-		case "success":
-			out.Values[i] = ec._ObjectNodesOrRelationshipNodesResponse_success(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getTypeSchemaNodeOutgoingRelationships(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "message":
-			out.Values[i] = ec._ObjectNodesOrRelationshipNodesResponse_message(ctx, field, obj)
-		case "objectNodesOrRelationshipNodes":
-			out.Values[i] = ec._ObjectNodesOrRelationshipNodesResponse_objectNodesOrRelationshipNodes(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getTypeSchemaNodeIncomingRelationships":
+			field := field
 
-	return out
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getTypeSchemaNodeIncomingRelationships(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-var objectNodesResponseImplementors = []string{"ObjectNodesResponse"}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) _ObjectNodesResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectNodesResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, objectNodesResponseImplementors)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getRelationshipSchemaNode":
+			field := field
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ObjectNodesResponse")
-		case "success":
-			out.Values[i] = ec._ObjectNodesResponse_success(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRelationshipSchemaNode(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "message":
-			out.Values[i] = ec._ObjectNodesResponse_message(ctx, field, obj)
-		case "objectNodes":
-			out.Values[i] = ec._ObjectNodesResponse_objectNodes(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "getRelationshipSchemaNodes":
+			field := field
 
-	return out
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_getRelationshipSchemaNodes(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-var objectRelationshipImplementors = []string{"ObjectRelationship", "ObjectNodeOrRelationshipNode"}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) _ObjectRelationship(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectRelationship) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, objectRelationshipImplementors)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "relationshipCardinalityReport":
+			field := field
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ObjectRelationship")
-		case "id":
-			out.Values[i] = ec._ObjectRelationship_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec._ObjectRelationship_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "originalName":
-			out.Values[i] = ec._ObjectRelationship_originalName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "properties":
-			out.Values[i] = ec._ObjectRelationship_properties(ctx, field, obj)
-		case "fromObjectNodeId":
-			out.Values[i] = ec._ObjectRelationship_fromObjectNodeId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "toObjectNodeId":
-			out.Values[i] = ec._ObjectRelationship_toObjectNodeId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_relationshipCardinalityReport(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "schemaDiff":
+			field := field
 
-	return out
-}
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_schemaDiff(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
 
-var objectRelationshipObjectNodeImplementors = []string{"ObjectRelationshipObjectNode"}
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
 
-func (ec *executionContext) _ObjectRelationshipObjectNode(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectRelationshipObjectNode) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, objectRelationshipObjectNodeImplementors)
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "exportSchemaDiagram":
+			field := field
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ObjectRelationshipObjectNode")
-		case "id":
-			out.Values[i] = ec._ObjectRelationshipObjectNode_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_exportSchemaDiagram(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "fromObjectNode":
-			out.Values[i] = ec._ObjectRelationshipObjectNode_fromObjectNode(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
-		case "relationshipNode":
-			out.Values[i] = ec._ObjectRelationshipObjectNode_relationshipNode(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "indexes":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_indexes(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
 			}
-		case "toObjectNode":
-			out.Values[i] = ec._ObjectRelationshipObjectNode_toObjectNode(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "__type":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___type(ctx, field)
+			})
+		case "__schema":
+			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
+				return ec._Query___schema(ctx, field)
+			})
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -13486,26 +20725,32 @@ func (ec *executionContext) _ObjectRelationshipObjectNode(ctx context.Context, s
 	return out
 }
 
-var objectRelationshipObjectNodeResponseImplementors = []string{"ObjectRelationshipObjectNodeResponse"}
+var relationshipCardinalityImplementors = []string{"RelationshipCardinality"}
 
-func (ec *executionContext) _ObjectRelationshipObjectNodeResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectRelationshipObjectNodeResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, objectRelationshipObjectNodeResponseImplementors)
+func (ec *executionContext) _RelationshipCardinality(ctx context.Context, sel ast.SelectionSet, obj *model.RelationshipCardinality) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, relationshipCardinalityImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ObjectRelationshipObjectNodeResponse")
-		case "success":
-			out.Values[i] = ec._ObjectRelationshipObjectNodeResponse_success(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("RelationshipCardinality")
+		case "cardinality":
+			out.Values[i] = ec._RelationshipCardinality_cardinality(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fromRequired":
+			out.Values[i] = ec._RelationshipCardinality_fromRequired(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "toRequired":
+			out.Values[i] = ec._RelationshipCardinality_toRequired(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "message":
-			out.Values[i] = ec._ObjectRelationshipObjectNodeResponse_message(ctx, field, obj)
-		case "objectRelationshipObjectNodes":
-			out.Values[i] = ec._ObjectRelationshipObjectNodeResponse_objectRelationshipObjectNodes(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -13529,26 +20774,58 @@ func (ec *executionContext) _ObjectRelationshipObjectNodeResponse(ctx context.Co
 	return out
 }
 
-var objectRelationshipObjectNodesResponseImplementors = []string{"ObjectRelationshipObjectNodesResponse"}
+var relationshipSchemaNodeImplementors = []string{"RelationshipSchemaNode"}
 
-func (ec *executionContext) _ObjectRelationshipObjectNodesResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectRelationshipObjectNodesResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, objectRelationshipObjectNodesResponseImplementors)
+func (ec *executionContext) _RelationshipSchemaNode(ctx context.Context, sel ast.SelectionSet, obj *model.RelationshipSchemaNode) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, relationshipSchemaNodeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ObjectRelationshipObjectNodesResponse")
-		case "success":
-			out.Values[i] = ec._ObjectRelationshipObjectNodesResponse_success(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("RelationshipSchemaNode")
+		case "id":
+			out.Values[i] = ec._RelationshipSchemaNode_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "message":
-			out.Values[i] = ec._ObjectRelationshipObjectNodesResponse_message(ctx, field, obj)
-		case "objectRelationshipObjectNodes":
-			out.Values[i] = ec._ObjectRelationshipObjectNodesResponse_objectRelationshipObjectNodes(ctx, field, obj)
+		case "domain":
+			out.Values[i] = ec._RelationshipSchemaNode_domain(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "name":
+			out.Values[i] = ec._RelationshipSchemaNode_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "originalName":
+			out.Values[i] = ec._RelationshipSchemaNode_originalName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec._RelationshipSchemaNode_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "fromTypeSchemaNodeId":
+			out.Values[i] = ec._RelationshipSchemaNode_fromTypeSchemaNodeId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "toTypeSchemaNodeId":
+			out.Values[i] = ec._RelationshipSchemaNode_toTypeSchemaNodeId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "cardinality":
+			out.Values[i] = ec._RelationshipSchemaNode_cardinality(ctx, field, obj)
+		case "properties":
+			out.Values[i] = ec._RelationshipSchemaNode_properties(ctx, field, obj)
+		case "labels":
+			out.Values[i] = ec._RelationshipSchemaNode_labels(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -13572,26 +20849,26 @@ func (ec *executionContext) _ObjectRelationshipObjectNodesResponse(ctx context.C
 	return out
 }
 
-var objectRelationshipResponseImplementors = []string{"ObjectRelationshipResponse"}
+var relationshipSchemaNodeResponseImplementors = []string{"RelationshipSchemaNodeResponse"}
 
-func (ec *executionContext) _ObjectRelationshipResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectRelationshipResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, objectRelationshipResponseImplementors)
+func (ec *executionContext) _RelationshipSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, obj *model.RelationshipSchemaNodeResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, relationshipSchemaNodeResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ObjectRelationshipResponse")
+			out.Values[i] = graphql.MarshalString("RelationshipSchemaNodeResponse")
 		case "success":
-			out.Values[i] = ec._ObjectRelationshipResponse_success(ctx, field, obj)
+			out.Values[i] = ec._RelationshipSchemaNodeResponse_success(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "message":
-			out.Values[i] = ec._ObjectRelationshipResponse_message(ctx, field, obj)
-		case "objectRelationship":
-			out.Values[i] = ec._ObjectRelationshipResponse_objectRelationship(ctx, field, obj)
+			out.Values[i] = ec._RelationshipSchemaNodeResponse_message(ctx, field, obj)
+		case "relationshipSchemaNode":
+			out.Values[i] = ec._RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -13615,26 +20892,26 @@ func (ec *executionContext) _ObjectRelationshipResponse(ctx context.Context, sel
 	return out
 }
 
-var objectRelationshipsResponseImplementors = []string{"ObjectRelationshipsResponse"}
+var relationshipSchemaNodesResponseImplementors = []string{"RelationshipSchemaNodesResponse"}
 
-func (ec *executionContext) _ObjectRelationshipsResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ObjectRelationshipsResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, objectRelationshipsResponseImplementors)
+func (ec *executionContext) _RelationshipSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, obj *model.RelationshipSchemaNodesResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, relationshipSchemaNodesResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ObjectRelationshipsResponse")
+			out.Values[i] = graphql.MarshalString("RelationshipSchemaNodesResponse")
 		case "success":
-			out.Values[i] = ec._ObjectRelationshipsResponse_success(ctx, field, obj)
+			out.Values[i] = ec._RelationshipSchemaNodesResponse_success(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "message":
-			out.Values[i] = ec._ObjectRelationshipsResponse_message(ctx, field, obj)
-		case "objectRelationships":
-			out.Values[i] = ec._ObjectRelationshipsResponse_objectRelationships(ctx, field, obj)
+			out.Values[i] = ec._RelationshipSchemaNodesResponse_message(ctx, field, obj)
+		case "relationshipSchemaNodes":
+			out.Values[i] = ec._RelationshipSchemaNodesResponse_relationshipSchemaNodes(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -13658,32 +20935,26 @@ func (ec *executionContext) _ObjectRelationshipsResponse(ctx context.Context, se
 	return out
 }
 
-var propertyImplementors = []string{"Property"}
+var responseImplementors = []string{"Response"}
 
-func (ec *executionContext) _Property(ctx context.Context, sel ast.SelectionSet, obj *model.Property) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, propertyImplementors)
+func (ec *executionContext) _Response(ctx context.Context, sel ast.SelectionSet, obj *model.Response) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, responseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Property")
-		case "key":
-			out.Values[i] = ec._Property_key(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "value":
-			out.Values[i] = ec._Property_value(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "type":
-			out.Values[i] = ec._Property_type(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Response")
+		case "success":
+			out.Values[i] = ec._Response_success(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "message":
+			out.Values[i] = ec._Response_message(ctx, field, obj)
+		case "data":
+			out.Values[i] = ec._Response_data(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -13707,319 +20978,191 @@ func (ec *executionContext) _Property(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var queryImplementors = []string{"Query"}
+var schemaChangeImplementors = []string{"SchemaChange"}
 
-func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, queryImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Query",
-	})
+func (ec *executionContext) _SchemaChange(ctx context.Context, sel ast.SelectionSet, obj *model.SchemaChange) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, schemaChangeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
-		innerCtx := graphql.WithRootFieldContext(ctx, &graphql.RootFieldContext{
-			Object: field.Name,
-			Field:  field,
-		})
-
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Query")
-		case "getObjectNode":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getObjectNode(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getObjectNodes":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getObjectNodes(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getObjectNodeRelationship":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getObjectNodeRelationship(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getObjectNodeOutgoingRelationships":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getObjectNodeOutgoingRelationships(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+			out.Values[i] = graphql.MarshalString("SchemaChange")
+		case "action":
+			out.Values[i] = ec._SchemaChange_action(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "kind":
+			out.Values[i] = ec._SchemaChange_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getObjectNodeIncomingRelationships":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getObjectNodeIncomingRelationships(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+		case "target":
+			out.Values[i] = ec._SchemaChange_target(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "description":
+			out.Values[i] = ec._SchemaChange_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getDomainSchemaNode":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getDomainSchemaNode(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+		case "compatibility":
+			out.Values[i] = ec._SchemaChange_compatibility(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "affectedObjectNodes":
+			out.Values[i] = ec._SchemaChange_affectedObjectNodes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getDomainSchemaNodes":
-			field := field
-
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getDomainSchemaNodes(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+		case "affectedObjectRelationships":
+			out.Values[i] = ec._SchemaChange_affectedObjectRelationships(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getTypeSchemaNode":
-			field := field
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getTypeSchemaNode(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	return out
+}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+var schemaDiagramResponseImplementors = []string{"SchemaDiagramResponse"}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getTypeSchemaNodes":
-			field := field
+func (ec *executionContext) _SchemaDiagramResponse(ctx context.Context, sel ast.SelectionSet, obj *model.SchemaDiagramResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, schemaDiagramResponseImplementors)
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getTypeSchemaNodes(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SchemaDiagramResponse")
+		case "success":
+			out.Values[i] = ec._SchemaDiagramResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+		case "message":
+			out.Values[i] = ec._SchemaDiagramResponse_message(ctx, field, obj)
+		case "format":
+			out.Values[i] = ec._SchemaDiagramResponse_format(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		case "diagram":
+			out.Values[i] = ec._SchemaDiagramResponse_diagram(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getTypeSchemaNodeOutgoingRelationships":
-			field := field
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getTypeSchemaNodeOutgoingRelationships(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	return out
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getTypeSchemaNodeIncomingRelationships":
-			field := field
+var schemaDiffResponseImplementors = []string{"SchemaDiffResponse"}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getTypeSchemaNodeIncomingRelationships(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+func (ec *executionContext) _SchemaDiffResponse(ctx context.Context, sel ast.SelectionSet, obj *model.SchemaDiffResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, schemaDiffResponseImplementors)
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SchemaDiffResponse")
+		case "success":
+			out.Values[i] = ec._SchemaDiffResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
+		case "message":
+			out.Values[i] = ec._SchemaDiffResponse_message(ctx, field, obj)
+		case "changes":
+			out.Values[i] = ec._SchemaDiffResponse_changes(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getRelationshipSchemaNode":
-			field := field
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getRelationshipSchemaNode(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
-			}
+	return out
+}
 
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "getRelationshipSchemaNodes":
-			field := field
+var schemaPlanResponseImplementors = []string{"SchemaPlanResponse"}
 
-			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
-				defer func() {
-					if r := recover(); r != nil {
-						ec.Error(ctx, ec.Recover(ctx, r))
-					}
-				}()
-				res = ec._Query_getRelationshipSchemaNodes(ctx, field)
-				if res == graphql.Null {
-					atomic.AddUint32(&fs.Invalids, 1)
-				}
-				return res
-			}
+func (ec *executionContext) _SchemaPlanResponse(ctx context.Context, sel ast.SelectionSet, obj *model.SchemaPlanResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, schemaPlanResponseImplementors)
 
-			rrm := func(ctx context.Context) graphql.Marshaler {
-				return ec.OperationContext.RootResolverMiddleware(ctx,
-					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SchemaPlanResponse")
+		case "success":
+			out.Values[i] = ec._SchemaPlanResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-
-			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
-		case "__type":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___type(ctx, field)
-			})
-		case "__schema":
-			out.Values[i] = ec.OperationContext.RootResolverMiddleware(innerCtx, func(ctx context.Context) (res graphql.Marshaler) {
-				return ec._Query___schema(ctx, field)
-			})
+		case "message":
+			out.Values[i] = ec._SchemaPlanResponse_message(ctx, field, obj)
+		case "dryRun":
+			out.Values[i] = ec._SchemaPlanResponse_dryRun(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "changes":
+			out.Values[i] = ec._SchemaPlanResponse_changes(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -14043,56 +21186,102 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
-var relationshipSchemaNodeImplementors = []string{"RelationshipSchemaNode"}
+var subscriptionImplementors = []string{"Subscription"}
 
-func (ec *executionContext) _RelationshipSchemaNode(ctx context.Context, sel ast.SelectionSet, obj *model.RelationshipSchemaNode) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, relationshipSchemaNodeImplementors)
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		ec.Errorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "objectNodeCreated":
+		return ec._Subscription_objectNodeCreated(ctx, fields[0])
+	case "objectNodeUpdated":
+		return ec._Subscription_objectNodeUpdated(ctx, fields[0])
+	case "objectNodeDeleted":
+		return ec._Subscription_objectNodeDeleted(ctx, fields[0])
+	case "objectRelationshipCreated":
+		return ec._Subscription_objectRelationshipCreated(ctx, fields[0])
+	case "objectRelationshipUpdated":
+		return ec._Subscription_objectRelationshipUpdated(ctx, fields[0])
+	case "objectRelationshipDeleted":
+		return ec._Subscription_objectRelationshipDeleted(ctx, fields[0])
+	case "domainSchemaNodeCreated":
+		return ec._Subscription_domainSchemaNodeCreated(ctx, fields[0])
+	case "domainSchemaNodeUpdated":
+		return ec._Subscription_domainSchemaNodeUpdated(ctx, fields[0])
+	case "domainSchemaNodeDeleted":
+		return ec._Subscription_domainSchemaNodeDeleted(ctx, fields[0])
+	case "typeSchemaNodeCreated":
+		return ec._Subscription_typeSchemaNodeCreated(ctx, fields[0])
+	case "typeSchemaNodeUpdated":
+		return ec._Subscription_typeSchemaNodeUpdated(ctx, fields[0])
+	case "typeSchemaNodeDeleted":
+		return ec._Subscription_typeSchemaNodeDeleted(ctx, fields[0])
+	case "relationshipSchemaNodeCreated":
+		return ec._Subscription_relationshipSchemaNodeCreated(ctx, fields[0])
+	case "relationshipSchemaNodeUpdated":
+		return ec._Subscription_relationshipSchemaNodeUpdated(ctx, fields[0])
+	case "relationshipSchemaNodeDeleted":
+		return ec._Subscription_relationshipSchemaNodeDeleted(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
+var typeSchemaNodeImplementors = []string{"TypeSchemaNode"}
+
+func (ec *executionContext) _TypeSchemaNode(ctx context.Context, sel ast.SelectionSet, obj *model.TypeSchemaNode) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, typeSchemaNodeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RelationshipSchemaNode")
+			out.Values[i] = graphql.MarshalString("TypeSchemaNode")
 		case "id":
-			out.Values[i] = ec._RelationshipSchemaNode_id(ctx, field, obj)
+			out.Values[i] = ec._TypeSchemaNode_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "domain":
-			out.Values[i] = ec._RelationshipSchemaNode_domain(ctx, field, obj)
+			out.Values[i] = ec._TypeSchemaNode_domain(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "name":
-			out.Values[i] = ec._RelationshipSchemaNode_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "originalName":
-			out.Values[i] = ec._RelationshipSchemaNode_originalName(ctx, field, obj)
+			out.Values[i] = ec._TypeSchemaNode_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "type":
-			out.Values[i] = ec._RelationshipSchemaNode_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "fromTypeSchemaNodeId":
-			out.Values[i] = ec._RelationshipSchemaNode_fromTypeSchemaNodeId(ctx, field, obj)
+			out.Values[i] = ec._TypeSchemaNode_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "toTypeSchemaNodeId":
-			out.Values[i] = ec._RelationshipSchemaNode_toTypeSchemaNodeId(ctx, field, obj)
+		case "originalName":
+			out.Values[i] = ec._TypeSchemaNode_originalName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "properties":
-			out.Values[i] = ec._RelationshipSchemaNode_properties(ctx, field, obj)
 		case "labels":
-			out.Values[i] = ec._RelationshipSchemaNode_labels(ctx, field, obj)
+			out.Values[i] = ec._TypeSchemaNode_labels(ctx, field, obj)
+		case "properties":
+			out.Values[i] = ec._TypeSchemaNode_properties(ctx, field, obj)
+		case "jsonSchemas":
+			out.Values[i] = ec._TypeSchemaNode_jsonSchemas(ctx, field, obj)
+		case "constraints":
+			out.Values[i] = ec._TypeSchemaNode_constraints(ctx, field, obj)
+		case "computedProperties":
+			out.Values[i] = ec._TypeSchemaNode_computedProperties(ctx, field, obj)
+		case "parentTypeSchemaNodeId":
+			out.Values[i] = ec._TypeSchemaNode_parentTypeSchemaNodeId(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -14116,26 +21305,26 @@ func (ec *executionContext) _RelationshipSchemaNode(ctx context.Context, sel ast
 	return out
 }
 
-var relationshipSchemaNodeResponseImplementors = []string{"RelationshipSchemaNodeResponse"}
+var typeSchemaNodeResponseImplementors = []string{"TypeSchemaNodeResponse"}
 
-func (ec *executionContext) _RelationshipSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, obj *model.RelationshipSchemaNodeResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, relationshipSchemaNodeResponseImplementors)
+func (ec *executionContext) _TypeSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, obj *model.TypeSchemaNodeResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, typeSchemaNodeResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RelationshipSchemaNodeResponse")
+			out.Values[i] = graphql.MarshalString("TypeSchemaNodeResponse")
 		case "success":
-			out.Values[i] = ec._RelationshipSchemaNodeResponse_success(ctx, field, obj)
+			out.Values[i] = ec._TypeSchemaNodeResponse_success(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "message":
-			out.Values[i] = ec._RelationshipSchemaNodeResponse_message(ctx, field, obj)
-		case "relationshipSchemaNode":
-			out.Values[i] = ec._RelationshipSchemaNodeResponse_relationshipSchemaNode(ctx, field, obj)
+			out.Values[i] = ec._TypeSchemaNodeResponse_message(ctx, field, obj)
+		case "typeSchemaNode":
+			out.Values[i] = ec._TypeSchemaNodeResponse_typeSchemaNode(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -14159,26 +21348,26 @@ func (ec *executionContext) _RelationshipSchemaNodeResponse(ctx context.Context,
 	return out
 }
 
-var relationshipSchemaNodesResponseImplementors = []string{"RelationshipSchemaNodesResponse"}
+var typeSchemaNodesResponseImplementors = []string{"TypeSchemaNodesResponse"}
 
-func (ec *executionContext) _RelationshipSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, obj *model.RelationshipSchemaNodesResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, relationshipSchemaNodesResponseImplementors)
+func (ec *executionContext) _TypeSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, obj *model.TypeSchemaNodesResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, typeSchemaNodesResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("RelationshipSchemaNodesResponse")
+			out.Values[i] = graphql.MarshalString("TypeSchemaNodesResponse")
 		case "success":
-			out.Values[i] = ec._RelationshipSchemaNodesResponse_success(ctx, field, obj)
+			out.Values[i] = ec._TypeSchemaNodesResponse_success(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "message":
-			out.Values[i] = ec._RelationshipSchemaNodesResponse_message(ctx, field, obj)
-		case "relationshipSchemaNodes":
-			out.Values[i] = ec._RelationshipSchemaNodesResponse_relationshipSchemaNodes(ctx, field, obj)
+			out.Values[i] = ec._TypeSchemaNodesResponse_message(ctx, field, obj)
+		case "typeSchemaNodes":
+			out.Values[i] = ec._TypeSchemaNodesResponse_typeSchemaNodes(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -14202,26 +21391,145 @@ func (ec *executionContext) _RelationshipSchemaNodesResponse(ctx context.Context
 	return out
 }
 
-var responseImplementors = []string{"Response"}
+var __DirectiveImplementors = []string{"__Directive"}
 
-func (ec *executionContext) _Response(ctx context.Context, sel ast.SelectionSet, obj *model.Response) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, responseImplementors)
+func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Response")
-		case "success":
-			out.Values[i] = ec._Response_success(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Directive")
+		case "name":
+			out.Values[i] = ec.___Directive_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "message":
-			out.Values[i] = ec._Response_message(ctx, field, obj)
-		case "data":
-			out.Values[i] = ec._Response_data(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Directive_description(ctx, field, obj)
+		case "locations":
+			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "args":
+			out.Values[i] = ec.___Directive_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "isRepeatable":
+			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __EnumValueImplementors = []string{"__EnumValue"}
+
+func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__EnumValue")
+		case "name":
+			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
+		case "isDeprecated":
+			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var __FieldImplementors = []string{"__Field"}
+
+func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("__Field")
+		case "name":
+			out.Values[i] = ec.___Field_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "description":
+			out.Values[i] = ec.___Field_description(ctx, field, obj)
+		case "args":
+			out.Values[i] = ec.___Field_args(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec.___Field_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "isDeprecated":
+			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "deprecationReason":
+			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -14245,94 +21553,31 @@ func (ec *executionContext) _Response(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var subscriptionImplementors = []string{"Subscription"}
-
-func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
-	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
-		Object: "Subscription",
-	})
-	if len(fields) != 1 {
-		ec.Errorf(ctx, "must subscribe to exactly one stream")
-		return nil
-	}
-
-	switch fields[0].Name {
-	case "objectNodeCreated":
-		return ec._Subscription_objectNodeCreated(ctx, fields[0])
-	case "objectNodeUpdated":
-		return ec._Subscription_objectNodeUpdated(ctx, fields[0])
-	case "objectNodeDeleted":
-		return ec._Subscription_objectNodeDeleted(ctx, fields[0])
-	case "objectRelationshipCreated":
-		return ec._Subscription_objectRelationshipCreated(ctx, fields[0])
-	case "objectRelationshipUpdated":
-		return ec._Subscription_objectRelationshipUpdated(ctx, fields[0])
-	case "objectRelationshipDeleted":
-		return ec._Subscription_objectRelationshipDeleted(ctx, fields[0])
-	case "domainSchemaNodeCreated":
-		return ec._Subscription_domainSchemaNodeCreated(ctx, fields[0])
-	case "domainSchemaNodeUpdated":
-		return ec._Subscription_domainSchemaNodeUpdated(ctx, fields[0])
-	case "domainSchemaNodeDeleted":
-		return ec._Subscription_domainSchemaNodeDeleted(ctx, fields[0])
-	case "typeSchemaNodeCreated":
-		return ec._Subscription_typeSchemaNodeCreated(ctx, fields[0])
-	case "typeSchemaNodeUpdated":
-		return ec._Subscription_typeSchemaNodeUpdated(ctx, fields[0])
-	case "typeSchemaNodeDeleted":
-		return ec._Subscription_typeSchemaNodeDeleted(ctx, fields[0])
-	case "relationshipSchemaNodeCreated":
-		return ec._Subscription_relationshipSchemaNodeCreated(ctx, fields[0])
-	case "relationshipSchemaNodeUpdated":
-		return ec._Subscription_relationshipSchemaNodeUpdated(ctx, fields[0])
-	case "relationshipSchemaNodeDeleted":
-		return ec._Subscription_relationshipSchemaNodeDeleted(ctx, fields[0])
-	default:
-		panic("unknown field " + strconv.Quote(fields[0].Name))
-	}
-}
-
-var typeSchemaNodeImplementors = []string{"TypeSchemaNode"}
+var __InputValueImplementors = []string{"__InputValue"}
 
-func (ec *executionContext) _TypeSchemaNode(ctx context.Context, sel ast.SelectionSet, obj *model.TypeSchemaNode) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, typeSchemaNodeImplementors)
+func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("TypeSchemaNode")
-		case "id":
-			out.Values[i] = ec._TypeSchemaNode_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "domain":
-			out.Values[i] = ec._TypeSchemaNode_domain(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
+			out.Values[i] = graphql.MarshalString("__InputValue")
 		case "name":
-			out.Values[i] = ec._TypeSchemaNode_name(ctx, field, obj)
+			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "description":
+			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
 		case "type":
-			out.Values[i] = ec._TypeSchemaNode_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "originalName":
-			out.Values[i] = ec._TypeSchemaNode_originalName(ctx, field, obj)
+			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "labels":
-			out.Values[i] = ec._TypeSchemaNode_labels(ctx, field, obj)
-		case "properties":
-			out.Values[i] = ec._TypeSchemaNode_properties(ctx, field, obj)
+		case "defaultValue":
+			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -14356,26 +21601,38 @@ func (ec *executionContext) _TypeSchemaNode(ctx context.Context, sel ast.Selecti
 	return out
 }
 
-var typeSchemaNodeResponseImplementors = []string{"TypeSchemaNodeResponse"}
+var __SchemaImplementors = []string{"__Schema"}
 
-func (ec *executionContext) _TypeSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, obj *model.TypeSchemaNodeResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, typeSchemaNodeResponseImplementors)
+func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("TypeSchemaNodeResponse")
-		case "success":
-			out.Values[i] = ec._TypeSchemaNodeResponse_success(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Schema")
+		case "description":
+			out.Values[i] = ec.___Schema_description(ctx, field, obj)
+		case "types":
+			out.Values[i] = ec.___Schema_types(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "queryType":
+			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mutationType":
+			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
+		case "subscriptionType":
+			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
+		case "directives":
+			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "message":
-			out.Values[i] = ec._TypeSchemaNodeResponse_message(ctx, field, obj)
-		case "typeSchemaNode":
-			out.Values[i] = ec._TypeSchemaNodeResponse_typeSchemaNode(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -14399,26 +21656,40 @@ func (ec *executionContext) _TypeSchemaNodeResponse(ctx context.Context, sel ast
 	return out
 }
 
-var typeSchemaNodesResponseImplementors = []string{"TypeSchemaNodesResponse"}
+var __TypeImplementors = []string{"__Type"}
 
-func (ec *executionContext) _TypeSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, obj *model.TypeSchemaNodesResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, typeSchemaNodesResponseImplementors)
+func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("TypeSchemaNodesResponse")
-		case "success":
-			out.Values[i] = ec._TypeSchemaNodesResponse_success(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("__Type")
+		case "kind":
+			out.Values[i] = ec.___Type_kind(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "message":
-			out.Values[i] = ec._TypeSchemaNodesResponse_message(ctx, field, obj)
-		case "typeSchemaNodes":
-			out.Values[i] = ec._TypeSchemaNodesResponse_typeSchemaNodes(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec.___Type_name(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec.___Type_description(ctx, field, obj)
+		case "fields":
+			out.Values[i] = ec.___Type_fields(ctx, field, obj)
+		case "interfaces":
+			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
+		case "possibleTypes":
+			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
+		case "enumValues":
+			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
+		case "inputFields":
+			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
+		case "ofType":
+			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
+		case "specifiedByURL":
+			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -14442,419 +21713,572 @@ func (ec *executionContext) _TypeSchemaNodesResponse(ctx context.Context, sel as
 	return out
 }
 
-var __DirectiveImplementors = []string{"__Directive"}
+// endregion **************************** object.gotpl ****************************
 
-func (ec *executionContext) ___Directive(ctx context.Context, sel ast.SelectionSet, obj *introspection.Directive) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __DirectiveImplementors)
+// region    ***************************** type.gotpl *****************************
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Directive")
-		case "name":
-			out.Values[i] = ec.___Directive_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Directive_description(ctx, field, obj)
-		case "locations":
-			out.Values[i] = ec.___Directive_locations(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "args":
-			out.Values[i] = ec.___Directive_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isRepeatable":
-			out.Values[i] = ec.___Directive_isRepeatable(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) unmarshalNAny2interface(ctx context.Context, v interface{}) (interface{}, error) {
+	res, err := graphql.UnmarshalAny(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAny2interface(ctx context.Context, sel ast.SelectionSet, v interface{}) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	res := graphql.MarshalAny(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	res := graphql.MarshalBoolean(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
+func (ec *executionContext) unmarshalNCardinality2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐCardinality(ctx context.Context, v interface{}) (model.Cardinality, error) {
+	var res model.Cardinality
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNCardinality2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐCardinality(ctx context.Context, sel ast.SelectionSet, v model.Cardinality) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNCardinalityReportResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐCardinalityReportResponse(ctx context.Context, sel ast.SelectionSet, v model.CardinalityReportResponse) graphql.Marshaler {
+	return ec._CardinalityReportResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCardinalityReportResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐCardinalityReportResponse(ctx context.Context, sel ast.SelectionSet, v *model.CardinalityReportResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CardinalityReportResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCardinalityViolation2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐCardinalityViolation(ctx context.Context, sel ast.SelectionSet, v *model.CardinalityViolation) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CardinalityViolation(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNComputedProperty2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐComputedProperty(ctx context.Context, sel ast.SelectionSet, v *model.ComputedProperty) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ComputedProperty(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNDependencyDirection2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDependencyDirection(ctx context.Context, v interface{}) (model.DependencyDirection, error) {
+	var res model.DependencyDirection
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNDependencyDirection2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDependencyDirection(ctx context.Context, sel ast.SelectionSet, v model.DependencyDirection) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNDomainDependenciesResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainDependenciesResponse(ctx context.Context, sel ast.SelectionSet, v model.DomainDependenciesResponse) graphql.Marshaler {
+	return ec._DomainDependenciesResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDomainDependenciesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainDependenciesResponse(ctx context.Context, sel ast.SelectionSet, v *model.DomainDependenciesResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	return ec._DomainDependenciesResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNDomainDependency2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainDependency(ctx context.Context, sel ast.SelectionSet, v *model.DomainDependency) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._DomainDependency(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNDomainSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNode(ctx context.Context, sel ast.SelectionSet, v *model.DomainSchemaNode) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DomainSchemaNode(ctx, sel, v)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNDomainSchemaNodeResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, v model.DomainSchemaNodeResponse) graphql.Marshaler {
+	return ec._DomainSchemaNodeResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDomainSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, v *model.DomainSchemaNodeResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._DomainSchemaNodeResponse(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) marshalNDomainSchemaNodesResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, v model.DomainSchemaNodesResponse) graphql.Marshaler {
+	return ec._DomainSchemaNodesResponse(ctx, sel, &v)
 }
 
-var __EnumValueImplementors = []string{"__EnumValue"}
+func (ec *executionContext) marshalNDomainSchemaNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, v *model.DomainSchemaNodesResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DomainSchemaNodesResponse(ctx, sel, v)
+}
 
-func (ec *executionContext) ___EnumValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.EnumValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __EnumValueImplementors)
+func (ec *executionContext) unmarshalNFilterOperator2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐFilterOperator(ctx context.Context, v interface{}) (model.FilterOperator, error) {
+	var res model.FilterOperator
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__EnumValue")
-		case "name":
-			out.Values[i] = ec.___EnumValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___EnumValue_description(ctx, field, obj)
-		case "isDeprecated":
-			out.Values[i] = ec.___EnumValue_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___EnumValue_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNFilterOperator2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐFilterOperator(ctx context.Context, sel ast.SelectionSet, v model.FilterOperator) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNFloat2float64(ctx context.Context, v interface{}) (float64, error) {
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNFloat2float64(ctx context.Context, sel ast.SelectionSet, v float64) graphql.Marshaler {
+	res := graphql.MarshalFloatContext(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	return graphql.WrapContextMarshaler(ctx, res)
+}
+
+func (ec *executionContext) marshalNIndex2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐIndex(ctx context.Context, sel ast.SelectionSet, v *model.Index) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._Index(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNIndexesResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐIndexesResponse(ctx context.Context, sel ast.SelectionSet, v model.IndexesResponse) graphql.Marshaler {
+	return ec._IndexesResponse(ctx, sel, &v)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNIndexesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐIndexesResponse(ctx context.Context, sel ast.SelectionSet, v *model.IndexesResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._IndexesResponse(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v interface{}) (int, error) {
+	res, err := graphql.UnmarshalInt(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __FieldImplementors = []string{"__Field"}
+func (ec *executionContext) marshalNInt2int(ctx context.Context, sel ast.SelectionSet, v int) graphql.Marshaler {
+	res := graphql.MarshalInt(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-func (ec *executionContext) ___Field(ctx context.Context, sel ast.SelectionSet, obj *introspection.Field) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __FieldImplementors)
+func (ec *executionContext) unmarshalNJSON2map(ctx context.Context, v interface{}) (map[string]interface{}, error) {
+	res, err := graphql.UnmarshalMap(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Field")
-		case "name":
-			out.Values[i] = ec.___Field_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___Field_description(ctx, field, obj)
-		case "args":
-			out.Values[i] = ec.___Field_args(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "type":
-			out.Values[i] = ec.___Field_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "isDeprecated":
-			out.Values[i] = ec.___Field_isDeprecated(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "deprecationReason":
-			out.Values[i] = ec.___Field_deprecationReason(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNJSON2map(ctx context.Context, sel ast.SelectionSet, v map[string]interface{}) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	res := graphql.MarshalMap(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNObjectNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNode(ctx context.Context, sel ast.SelectionSet, v *model.ObjectNode) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ObjectNode(ctx, sel, v)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNObjectNodeOrRelationshipNode2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeOrRelationshipNode(ctx context.Context, sel ast.SelectionSet, v model.ObjectNodeOrRelationshipNode) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._ObjectNodeOrRelationshipNode(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) marshalNObjectNodeResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx context.Context, sel ast.SelectionSet, v model.ObjectNodeResponse) graphql.Marshaler {
+	return ec._ObjectNodeResponse(ctx, sel, &v)
 }
 
-var __InputValueImplementors = []string{"__InputValue"}
+func (ec *executionContext) marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx context.Context, sel ast.SelectionSet, v *model.ObjectNodeResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ObjectNodeResponse(ctx, sel, v)
+}
 
-func (ec *executionContext) ___InputValue(ctx context.Context, sel ast.SelectionSet, obj *introspection.InputValue) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __InputValueImplementors)
+func (ec *executionContext) marshalNObjectNodesResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodesResponse(ctx context.Context, sel ast.SelectionSet, v model.ObjectNodesResponse) graphql.Marshaler {
+	return ec._ObjectNodesResponse(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__InputValue")
-		case "name":
-			out.Values[i] = ec.___InputValue_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "description":
-			out.Values[i] = ec.___InputValue_description(ctx, field, obj)
-		case "type":
-			out.Values[i] = ec.___InputValue_type(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "defaultValue":
-			out.Values[i] = ec.___InputValue_defaultValue(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNObjectNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodesResponse(ctx context.Context, sel ast.SelectionSet, v *model.ObjectNodesResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ObjectNodesResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNObjectRelationship2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationship(ctx context.Context, sel ast.SelectionSet, v *model.ObjectRelationship) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+	return ec._ObjectRelationship(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNObjectRelationshipObjectNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipObjectNode(ctx context.Context, sel ast.SelectionSet, v *model.ObjectRelationshipObjectNode) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._ObjectRelationshipObjectNode(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNObjectRelationshipResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx context.Context, sel ast.SelectionSet, v model.ObjectRelationshipResponse) graphql.Marshaler {
+	return ec._ObjectRelationshipResponse(ctx, sel, &v)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx context.Context, sel ast.SelectionSet, v *model.ObjectRelationshipResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._ObjectRelationshipResponse(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) marshalNObjectRelationshipsResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipsResponse(ctx context.Context, sel ast.SelectionSet, v model.ObjectRelationshipsResponse) graphql.Marshaler {
+	return ec._ObjectRelationshipsResponse(ctx, sel, &v)
 }
 
-var __SchemaImplementors = []string{"__Schema"}
+func (ec *executionContext) marshalNObjectRelationshipsResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipsResponse(ctx context.Context, sel ast.SelectionSet, v *model.ObjectRelationshipsResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ObjectRelationshipsResponse(ctx, sel, v)
+}
 
-func (ec *executionContext) ___Schema(ctx context.Context, sel ast.SelectionSet, obj *introspection.Schema) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __SchemaImplementors)
+func (ec *executionContext) unmarshalNPointInput2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPointInput(ctx context.Context, v interface{}) (model.PointInput, error) {
+	res, err := ec.unmarshalInputPointInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Schema")
-		case "description":
-			out.Values[i] = ec.___Schema_description(ctx, field, obj)
-		case "types":
-			out.Values[i] = ec.___Schema_types(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "queryType":
-			out.Values[i] = ec.___Schema_queryType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "mutationType":
-			out.Values[i] = ec.___Schema_mutationType(ctx, field, obj)
-		case "subscriptionType":
-			out.Values[i] = ec.___Schema_subscriptionType(ctx, field, obj)
-		case "directives":
-			out.Values[i] = ec.___Schema_directives(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNProperty2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐProperty(ctx context.Context, sel ast.SelectionSet, v *model.Property) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._Property(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNPropertyConstraint2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyConstraint(ctx context.Context, sel ast.SelectionSet, v *model.PropertyConstraint) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._PropertyConstraint(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNPropertyFilter2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyFilter(ctx context.Context, v interface{}) (*model.PropertyFilter, error) {
+	res, err := ec.unmarshalInputPropertyFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-var __TypeImplementors = []string{"__Type"}
+func (ec *executionContext) unmarshalNPropertyInput2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInputᚄ(ctx context.Context, v interface{}) ([]*model.PropertyInput, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.PropertyInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNPropertyInput2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
 
-func (ec *executionContext) ___Type(ctx context.Context, sel ast.SelectionSet, obj *introspection.Type) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, __TypeImplementors)
+func (ec *executionContext) unmarshalNPropertyInput2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInput(ctx context.Context, v interface{}) (*model.PropertyInput, error) {
+	res, err := ec.unmarshalInputPropertyInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("__Type")
-		case "kind":
-			out.Values[i] = ec.___Type_kind(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec.___Type_name(ctx, field, obj)
-		case "description":
-			out.Values[i] = ec.___Type_description(ctx, field, obj)
-		case "fields":
-			out.Values[i] = ec.___Type_fields(ctx, field, obj)
-		case "interfaces":
-			out.Values[i] = ec.___Type_interfaces(ctx, field, obj)
-		case "possibleTypes":
-			out.Values[i] = ec.___Type_possibleTypes(ctx, field, obj)
-		case "enumValues":
-			out.Values[i] = ec.___Type_enumValues(ctx, field, obj)
-		case "inputFields":
-			out.Values[i] = ec.___Type_inputFields(ctx, field, obj)
-		case "ofType":
-			out.Values[i] = ec.___Type_ofType(ctx, field, obj)
-		case "specifiedByURL":
-			out.Values[i] = ec.___Type_specifiedByURL(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNPropertyJsonSchema2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyJSONSchema(ctx context.Context, sel ast.SelectionSet, v *model.PropertyJSONSchema) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._PropertyJsonSchema(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+func (ec *executionContext) unmarshalNPropertySort2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertySort(ctx context.Context, v interface{}) (*model.PropertySort, error) {
+	res, err := ec.unmarshalInputPropertySort(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-	return out
+func (ec *executionContext) unmarshalNPropertyType2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyType(ctx context.Context, v interface{}) (model.PropertyType, error) {
+	var res model.PropertyType
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-// endregion **************************** object.gotpl ****************************
+func (ec *executionContext) marshalNPropertyType2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyType(ctx context.Context, sel ast.SelectionSet, v model.PropertyType) graphql.Marshaler {
+	return v
+}
 
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) marshalNRelationshipSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNode(ctx context.Context, sel ast.SelectionSet, v *model.RelationshipSchemaNode) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._RelationshipSchemaNode(ctx, sel, v)
+}
 
-func (ec *executionContext) unmarshalNAny2interface(ctx context.Context, v interface{}) (any, error) {
-	res, err := graphql.UnmarshalAny(v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNRelationshipSchemaNodeResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, v model.RelationshipSchemaNodeResponse) graphql.Marshaler {
+	return ec._RelationshipSchemaNodeResponse(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNAny2interface(ctx context.Context, sel ast.SelectionSet, v any) graphql.Marshaler {
+func (ec *executionContext) marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, v *model.RelationshipSchemaNodeResponse) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	res := graphql.MarshalAny(v)
-	if res == graphql.Null {
+	return ec._RelationshipSchemaNodeResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNRelationshipSchemaNodesResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, v model.RelationshipSchemaNodesResponse) graphql.Marshaler {
+	return ec._RelationshipSchemaNodesResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNRelationshipSchemaNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, v *model.RelationshipSchemaNodesResponse) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._RelationshipSchemaNodesResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
+func (ec *executionContext) unmarshalNRelationshipSide2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSide(ctx context.Context, v interface{}) (model.RelationshipSide, error) {
+	var res model.RelationshipSide
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	res := graphql.MarshalBoolean(v)
-	if res == graphql.Null {
+func (ec *executionContext) marshalNRelationshipSide2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSide(ctx context.Context, sel ast.SelectionSet, v model.RelationshipSide) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNSchemaChange2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaChange(ctx context.Context, sel ast.SelectionSet, v *model.SchemaChange) graphql.Marshaler {
+	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
+		return graphql.Null
 	}
-	return res
+	return ec._SchemaChange(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNSchemaChangeAction2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaChangeAction(ctx context.Context, v interface{}) (model.SchemaChangeAction, error) {
+	var res model.SchemaChangeAction
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSchemaChangeAction2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaChangeAction(ctx context.Context, sel ast.SelectionSet, v model.SchemaChangeAction) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNSchemaChangeCompatibility2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaChangeCompatibility(ctx context.Context, v interface{}) (model.SchemaChangeCompatibility, error) {
+	var res model.SchemaChangeCompatibility
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSchemaChangeCompatibility2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaChangeCompatibility(ctx context.Context, sel ast.SelectionSet, v model.SchemaChangeCompatibility) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNSchemaChangeKind2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaChangeKind(ctx context.Context, v interface{}) (model.SchemaChangeKind, error) {
+	var res model.SchemaChangeKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNSchemaChangeKind2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaChangeKind(ctx context.Context, sel ast.SelectionSet, v model.SchemaChangeKind) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNSchemaDiagramFormat2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaDiagramFormat(ctx context.Context, v interface{}) (model.SchemaDiagramFormat, error) {
+	var res model.SchemaDiagramFormat
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNDomainSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNode(ctx context.Context, sel ast.SelectionSet, v *model.DomainSchemaNode) graphql.Marshaler {
+func (ec *executionContext) marshalNSchemaDiagramFormat2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaDiagramFormat(ctx context.Context, sel ast.SelectionSet, v model.SchemaDiagramFormat) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNSchemaDiagramResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaDiagramResponse(ctx context.Context, sel ast.SelectionSet, v model.SchemaDiagramResponse) graphql.Marshaler {
+	return ec._SchemaDiagramResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSchemaDiagramResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaDiagramResponse(ctx context.Context, sel ast.SelectionSet, v *model.SchemaDiagramResponse) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._DomainSchemaNode(ctx, sel, v)
+	return ec._SchemaDiagramResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNDomainSchemaNodeResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, v model.DomainSchemaNodeResponse) graphql.Marshaler {
-	return ec._DomainSchemaNodeResponse(ctx, sel, &v)
+func (ec *executionContext) unmarshalNSchemaDiffAgainst2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaDiffAgainst(ctx context.Context, v interface{}) (model.SchemaDiffAgainst, error) {
+	res, err := ec.unmarshalInputSchemaDiffAgainst(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNDomainSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, v *model.DomainSchemaNodeResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNSchemaDiffResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaDiffResponse(ctx context.Context, sel ast.SelectionSet, v model.SchemaDiffResponse) graphql.Marshaler {
+	return ec._SchemaDiffResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNSchemaDiffResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaDiffResponse(ctx context.Context, sel ast.SelectionSet, v *model.SchemaDiffResponse) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._DomainSchemaNodeResponse(ctx, sel, v)
+	return ec._SchemaDiffResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNDomainSchemaNodesResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, v model.DomainSchemaNodesResponse) graphql.Marshaler {
-	return ec._DomainSchemaNodesResponse(ctx, sel, &v)
+func (ec *executionContext) marshalNSchemaPlanResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaPlanResponse(ctx context.Context, sel ast.SelectionSet, v model.SchemaPlanResponse) graphql.Marshaler {
+	return ec._SchemaPlanResponse(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNDomainSchemaNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, v *model.DomainSchemaNodesResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNSchemaPlanResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaPlanResponse(ctx context.Context, sel ast.SelectionSet, v *model.SchemaPlanResponse) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._DomainSchemaNodesResponse(ctx, sel, v)
+	return ec._SchemaPlanResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNJSON2map(ctx context.Context, v interface{}) (map[string]interface{}, error) {
-	res, err := graphql.UnmarshalMap(v)
+func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNJSON2map(ctx context.Context, sel ast.SelectionSet, v map[string]interface{}) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	res := graphql.MarshalMap(v)
+func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
 	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
@@ -14863,207 +22287,358 @@ func (ec *executionContext) marshalNJSON2map(ctx context.Context, sel ast.Select
 	return res
 }
 
-func (ec *executionContext) marshalNObjectNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNode(ctx context.Context, sel ast.SelectionSet, v *model.ObjectNode) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
 		}
-		return graphql.Null
 	}
-	return ec._ObjectNode(ctx, sel, v)
+	return res, nil
 }
 
-func (ec *executionContext) marshalNObjectNodeOrRelationshipNode2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeOrRelationshipNode(ctx context.Context, sel ast.SelectionSet, v model.ObjectNodeOrRelationshipNode) graphql.Marshaler {
+func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	for i := range v {
+		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+	}
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNTypeSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNode(ctx context.Context, sel ast.SelectionSet, v *model.TypeSchemaNode) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ObjectNodeOrRelationshipNode(ctx, sel, v)
+	return ec._TypeSchemaNode(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNObjectNodeResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx context.Context, sel ast.SelectionSet, v model.ObjectNodeResponse) graphql.Marshaler {
-	return ec._ObjectNodeResponse(ctx, sel, &v)
+func (ec *executionContext) marshalNTypeSchemaNodeResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, v model.TypeSchemaNodeResponse) graphql.Marshaler {
+	return ec._TypeSchemaNodeResponse(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNObjectNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodeResponse(ctx context.Context, sel ast.SelectionSet, v *model.ObjectNodeResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, v *model.TypeSchemaNodeResponse) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ObjectNodeResponse(ctx, sel, v)
+	return ec._TypeSchemaNodeResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNObjectNodesResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodesResponse(ctx context.Context, sel ast.SelectionSet, v model.ObjectNodesResponse) graphql.Marshaler {
-	return ec._ObjectNodesResponse(ctx, sel, &v)
+func (ec *executionContext) marshalNTypeSchemaNodesResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, v model.TypeSchemaNodesResponse) graphql.Marshaler {
+	return ec._TypeSchemaNodesResponse(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNObjectNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectNodesResponse(ctx context.Context, sel ast.SelectionSet, v *model.ObjectNodesResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNTypeSchemaNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, v *model.TypeSchemaNodesResponse) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ObjectNodesResponse(ctx, sel, v)
+	return ec._TypeSchemaNodesResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNObjectRelationship2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationship(ctx context.Context, sel ast.SelectionSet, v *model.ObjectRelationship) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
+	return ec.___Directive(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
 		}
-		return graphql.Null
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	return ec._ObjectRelationship(ctx, sel, v)
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-func (ec *executionContext) marshalNObjectRelationshipObjectNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipObjectNode(ctx context.Context, sel ast.SelectionSet, v *model.ObjectRelationshipObjectNode) graphql.Marshaler {
-	if v == nil {
+func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-		return graphql.Null
 	}
-	return ec._ObjectRelationshipObjectNode(ctx, sel, v)
+	return res
 }
 
-func (ec *executionContext) marshalNObjectRelationshipResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx context.Context, sel ast.SelectionSet, v model.ObjectRelationshipResponse) graphql.Marshaler {
-	return ec._ObjectRelationshipResponse(ctx, sel, &v)
+func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]string, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
 }
 
-func (ec *executionContext) marshalNObjectRelationshipResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipResponse(ctx context.Context, sel ast.SelectionSet, v *model.ObjectRelationshipResponse) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
+	return ec.___EnumValue(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
+	return ec.___Field(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
+	return ec.___InputValue(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-		return graphql.Null
-	}
-	return ec._ObjectRelationshipResponse(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNObjectRelationshipsResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipsResponse(ctx context.Context, sel ast.SelectionSet, v model.ObjectRelationshipsResponse) graphql.Marshaler {
-	return ec._ObjectRelationshipsResponse(ctx, sel, &v)
-}
 
-func (ec *executionContext) marshalNObjectRelationshipsResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐObjectRelationshipsResponse(ctx context.Context, sel ast.SelectionSet, v *model.ObjectRelationshipsResponse) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
 	}
-	return ec._ObjectRelationshipsResponse(ctx, sel, v)
-}
+	wg.Wait()
 
-func (ec *executionContext) marshalNProperty2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐProperty(ctx context.Context, sel ast.SelectionSet, v *model.Property) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
-		return graphql.Null
 	}
-	return ec._Property(ctx, sel, v)
-}
 
-func (ec *executionContext) unmarshalNPropertyInput2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInputᚄ(ctx context.Context, v interface{}) ([]*model.PropertyInput, error) {
-	var vSlice []interface{}
-	if v != nil {
-		vSlice = graphql.CoerceList(v)
-	}
-	var err error
-	res := make([]*model.PropertyInput, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNPropertyInput2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInput(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
-	}
-	return res, nil
+	return ret
 }
 
-func (ec *executionContext) unmarshalNPropertyInput2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInput(ctx context.Context, v interface{}) (*model.PropertyInput, error) {
-	res, err := ec.unmarshalInputPropertyInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
+	return ec.___Type(ctx, sel, &v)
 }
 
-func (ec *executionContext) unmarshalNPropertyType2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyType(ctx context.Context, v interface{}) (model.PropertyType, error) {
-	var res model.PropertyType
-	err := res.UnmarshalGQL(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
+func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) marshalNPropertyType2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyType(ctx context.Context, sel ast.SelectionSet, v model.PropertyType) graphql.Marshaler {
-	return v
-}
+	}
+	wg.Wait()
 
-func (ec *executionContext) marshalNRelationshipSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNode(ctx context.Context, sel ast.SelectionSet, v *model.RelationshipSchemaNode) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
-		return graphql.Null
 	}
-	return ec._RelationshipSchemaNode(ctx, sel, v)
-}
 
-func (ec *executionContext) marshalNRelationshipSchemaNodeResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, v model.RelationshipSchemaNodeResponse) graphql.Marshaler {
-	return ec._RelationshipSchemaNodeResponse(ctx, sel, &v)
+	return ret
 }
 
-func (ec *executionContext) marshalNRelationshipSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, v *model.RelationshipSchemaNodeResponse) graphql.Marshaler {
+func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._RelationshipSchemaNodeResponse(ctx, sel, v)
+	return ec.___Type(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNRelationshipSchemaNodesResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, v model.RelationshipSchemaNodesResponse) graphql.Marshaler {
-	return ec._RelationshipSchemaNodesResponse(ctx, sel, &v)
+func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
+	res, err := graphql.UnmarshalString(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNRelationshipSchemaNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, v *model.RelationshipSchemaNodesResponse) graphql.Marshaler {
-	if v == nil {
+func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	res := graphql.MarshalString(v)
+	if res == graphql.Null {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-		return graphql.Null
 	}
-	return ec._RelationshipSchemaNodesResponse(ctx, sel, v)
+	return res
 }
 
-func (ec *executionContext) unmarshalNString2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
+func (ec *executionContext) unmarshalOAny2interface(ctx context.Context, v interface{}) (interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalAny(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNString2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
+func (ec *executionContext) marshalOAny2interface(ctx context.Context, sel ast.SelectionSet, v interface{}) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
+	res := graphql.MarshalAny(v)
 	return res
 }
 
-func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
+func (ec *executionContext) unmarshalOAny2ᚕinterfaceᚄ(ctx context.Context, v interface{}) ([]interface{}, error) {
+	if v == nil {
+		return nil, nil
+	}
 	var vSlice []interface{}
 	if v != nil {
 		vSlice = graphql.CoerceList(v)
 	}
 	var err error
-	res := make([]string, len(vSlice))
+	res := make([]interface{}, len(vSlice))
 	for i := range vSlice {
 		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNString2string(ctx, vSlice[i])
+		res[i], err = ec.unmarshalNAny2interface(ctx, vSlice[i])
 		if err != nil {
 			return nil, err
 		}
@@ -15071,10 +22646,13 @@ func (ec *executionContext) unmarshalNString2ᚕstringᚄ(ctx context.Context, v
 	return res, nil
 }
 
-func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
+func (ec *executionContext) marshalOAny2ᚕinterfaceᚄ(ctx context.Context, sel ast.SelectionSet, v []interface{}) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	for i := range v {
-		ret[i] = ec.marshalNString2string(ctx, sel, v[i])
+		ret[i] = ec.marshalNAny2interface(ctx, sel, v[i])
 	}
 
 	for _, e := range ret {
@@ -15086,49 +22664,36 @@ func (ec *executionContext) marshalNString2ᚕstringᚄ(ctx context.Context, sel
 	return ret
 }
 
-func (ec *executionContext) marshalNTypeSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNode(ctx context.Context, sel ast.SelectionSet, v *model.TypeSchemaNode) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._TypeSchemaNode(ctx, sel, v)
+func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
+	res, err := graphql.UnmarshalBoolean(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNTypeSchemaNodeResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, v model.TypeSchemaNodeResponse) graphql.Marshaler {
-	return ec._TypeSchemaNodeResponse(ctx, sel, &v)
+func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
+	res := graphql.MarshalBoolean(v)
+	return res
 }
 
-func (ec *executionContext) marshalNTypeSchemaNodeResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodeResponse(ctx context.Context, sel ast.SelectionSet, v *model.TypeSchemaNodeResponse) graphql.Marshaler {
+func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v interface{}) (*bool, error) {
 	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
+		return nil, nil
 	}
-	return ec._TypeSchemaNodeResponse(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNTypeSchemaNodesResponse2githubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, v model.TypeSchemaNodesResponse) graphql.Marshaler {
-	return ec._TypeSchemaNodesResponse(ctx, sel, &v)
+	res, err := graphql.UnmarshalBoolean(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNTypeSchemaNodesResponse2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐTypeSchemaNodesResponse(ctx context.Context, sel ast.SelectionSet, v *model.TypeSchemaNodesResponse) graphql.Marshaler {
+func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
 	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
 		return graphql.Null
 	}
-	return ec._TypeSchemaNodesResponse(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx context.Context, sel ast.SelectionSet, v introspection.Directive) graphql.Marshaler {
-	return ec.___Directive(ctx, sel, &v)
+	res := graphql.MarshalBoolean(*v)
+	return res
 }
 
-func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirectiveᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Directive) graphql.Marshaler {
+func (ec *executionContext) marshalOCardinalityViolation2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐCardinalityViolationᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CardinalityViolation) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -15152,7 +22717,7 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Directive2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐDirective(ctx, sel, v[i])
+			ret[i] = ec.marshalNCardinalityViolation2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐCardinalityViolation(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -15172,39 +22737,10 @@ func (ec *executionContext) marshalN__Directive2ᚕgithubᚗcomᚋ99designsᚋgq
 	return ret
 }
 
-func (ec *executionContext) unmarshalN__DirectiveLocation2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalN__DirectiveLocation2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
-	var vSlice []interface{}
-	if v != nil {
-		vSlice = graphql.CoerceList(v)
-	}
-	var err error
-	res := make([]string, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalN__DirectiveLocation2string(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
+func (ec *executionContext) marshalOComputedProperty2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐComputedPropertyᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ComputedProperty) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
 	}
-	return res, nil
-}
-
-func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context.Context, sel ast.SelectionSet, v []string) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -15228,7 +22764,7 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__DirectiveLocation2string(ctx, sel, v[i])
+			ret[i] = ec.marshalNComputedProperty2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐComputedProperty(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -15248,19 +22784,26 @@ func (ec *executionContext) marshalN__DirectiveLocation2ᚕstringᚄ(ctx context
 	return ret
 }
 
-func (ec *executionContext) marshalN__EnumValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐEnumValue(ctx context.Context, sel ast.SelectionSet, v introspection.EnumValue) graphql.Marshaler {
-	return ec.___EnumValue(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalN__Field2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐField(ctx context.Context, sel ast.SelectionSet, v introspection.Field) graphql.Marshaler {
-	return ec.___Field(ctx, sel, &v)
+func (ec *executionContext) unmarshalOCrossDomainRule2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐCrossDomainRule(ctx context.Context, v interface{}) (*model.CrossDomainRule, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.CrossDomainRule)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx context.Context, sel ast.SelectionSet, v introspection.InputValue) graphql.Marshaler {
-	return ec.___InputValue(ctx, sel, &v)
+func (ec *executionContext) marshalOCrossDomainRule2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐCrossDomainRule(ctx context.Context, sel ast.SelectionSet, v *model.CrossDomainRule) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
 }
 
-func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValueᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.InputValue) graphql.Marshaler {
+func (ec *executionContext) marshalODomainDependency2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainDependencyᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DomainDependency) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -15284,7 +22827,7 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__InputValue2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐInputValue(ctx, sel, v[i])
+			ret[i] = ec.marshalNDomainDependency2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainDependency(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -15300,15 +22843,14 @@ func (ec *executionContext) marshalN__InputValue2ᚕgithubᚗcomᚋ99designsᚋg
 			return graphql.Null
 		}
 	}
-
-	return ret
-}
-
-func (ec *executionContext) marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v introspection.Type) graphql.Marshaler {
-	return ec.___Type(ctx, sel, &v)
+
+	return ret
 }
 
-func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐTypeᚄ(ctx context.Context, sel ast.SelectionSet, v []introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalODomainSchemaNode2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DomainSchemaNode) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -15332,7 +22874,7 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalN__Type2githubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx, sel, v[i])
+			ret[i] = ec.marshalNDomainSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNode(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -15352,58 +22894,30 @@ func (ec *executionContext) marshalN__Type2ᚕgithubᚗcomᚋ99designsᚋgqlgen
 	return ret
 }
 
-func (ec *executionContext) marshalN__Type2ᚖgithubᚗcomᚋ99designsᚋgqlgenᚋgraphqlᚋintrospectionᚐType(ctx context.Context, sel ast.SelectionSet, v *introspection.Type) graphql.Marshaler {
+func (ec *executionContext) marshalODomainSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNode(ctx context.Context, sel ast.SelectionSet, v *model.DomainSchemaNode) graphql.Marshaler {
 	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
 		return graphql.Null
 	}
-	return ec.___Type(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalN__TypeKind2string(ctx context.Context, v interface{}) (string, error) {
-	res, err := graphql.UnmarshalString(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalN__TypeKind2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
-	res := graphql.MarshalString(v)
-	if res == graphql.Null {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-	}
-	return res
-}
-
-func (ec *executionContext) unmarshalOBoolean2bool(ctx context.Context, v interface{}) (bool, error) {
-	res, err := graphql.UnmarshalBoolean(v)
-	return res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalOBoolean2bool(ctx context.Context, sel ast.SelectionSet, v bool) graphql.Marshaler {
-	res := graphql.MarshalBoolean(v)
-	return res
+	return ec._DomainSchemaNode(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalOBoolean2ᚖbool(ctx context.Context, v interface{}) (*bool, error) {
+func (ec *executionContext) unmarshalOFloat2ᚖfloat64(ctx context.Context, v interface{}) (*float64, error) {
 	if v == nil {
 		return nil, nil
 	}
-	res, err := graphql.UnmarshalBoolean(v)
+	res, err := graphql.UnmarshalFloatContext(ctx, v)
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast.SelectionSet, v *bool) graphql.Marshaler {
+func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel ast.SelectionSet, v *float64) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	res := graphql.MarshalBoolean(*v)
-	return res
+	res := graphql.MarshalFloatContext(*v)
+	return graphql.WrapContextMarshaler(ctx, res)
 }
 
-func (ec *executionContext) marshalODomainSchemaNode2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNodeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DomainSchemaNode) graphql.Marshaler {
+func (ec *executionContext) marshalOIndex2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐIndexᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Index) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
@@ -15430,7 +22944,7 @@ func (ec *executionContext) marshalODomainSchemaNode2ᚕᚖgithubᚗcomᚋmike
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNDomainSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNode(ctx, sel, v[i])
+			ret[i] = ec.marshalNIndex2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐIndex(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -15450,11 +22964,20 @@ func (ec *executionContext) marshalODomainSchemaNode2ᚕᚖgithubᚗcomᚋmike
 	return ret
 }
 
-func (ec *executionContext) marshalODomainSchemaNode2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐDomainSchemaNode(ctx context.Context, sel ast.SelectionSet, v *model.DomainSchemaNode) graphql.Marshaler {
+func (ec *executionContext) unmarshalOInt2ᚖint(ctx context.Context, v interface{}) (*int, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := graphql.UnmarshalInt(v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOInt2ᚖint(ctx context.Context, sel ast.SelectionSet, v *int) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
 	}
-	return ec._DomainSchemaNode(ctx, sel, v)
+	res := graphql.MarshalInt(*v)
+	return res
 }
 
 func (ec *executionContext) unmarshalOJSON2ᚕmapᚄ(ctx context.Context, v interface{}) ([]map[string]interface{}, error) {
@@ -15751,6 +23274,81 @@ func (ec *executionContext) marshalOProperty2ᚕᚖgithubᚗcomᚋmikeᚑjacks
 	return ret
 }
 
+func (ec *executionContext) marshalOPropertyConstraint2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyConstraintᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PropertyConstraint) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPropertyConstraint2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyConstraint(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalOPropertyConstraintInput2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyConstraintInput(ctx context.Context, v interface{}) (*model.PropertyConstraintInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputPropertyConstraintInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalOPropertyFilter2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyFilterᚄ(ctx context.Context, v interface{}) ([]*model.PropertyFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.PropertyFilter, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNPropertyFilter2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyFilter(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) unmarshalOPropertyInput2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyInputᚄ(ctx context.Context, v interface{}) ([]*model.PropertyInput, error) {
 	if v == nil {
 		return nil, nil
@@ -15771,6 +23369,88 @@ func (ec *executionContext) unmarshalOPropertyInput2ᚕᚖgithubᚗcomᚋmikeᚑ
 	return res, nil
 }
 
+func (ec *executionContext) marshalOPropertyJsonSchema2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyJSONSchemaᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PropertyJSONSchema) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPropertyJsonSchema2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertyJSONSchema(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalOPropertySort2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertySortᚄ(ctx context.Context, v interface{}) ([]*model.PropertySort, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []interface{}
+	if v != nil {
+		vSlice = graphql.CoerceList(v)
+	}
+	var err error
+	res := make([]*model.PropertySort, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNPropertySort2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐPropertySort(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+func (ec *executionContext) marshalORelationshipCardinality2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipCardinality(ctx context.Context, sel ast.SelectionSet, v *model.RelationshipCardinality) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RelationshipCardinality(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalORelationshipCardinalityInput2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipCardinalityInput(ctx context.Context, v interface{}) (*model.RelationshipCardinalityInput, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputRelationshipCardinalityInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalORelationshipSchemaNode2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐRelationshipSchemaNodeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.RelationshipSchemaNode) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -15825,6 +23505,69 @@ func (ec *executionContext) marshalORelationshipSchemaNode2ᚖgithubᚗcomᚋmik
 	return ec._RelationshipSchemaNode(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOSchemaChange2ᚕᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaChangeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SchemaChange) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNSchemaChange2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSchemaChange(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) unmarshalOSortDirection2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSortDirection(ctx context.Context, v interface{}) (*model.SortDirection, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.SortDirection)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOSortDirection2ᚖgithubᚗcomᚋmikeᚑjacksᚋneoᚋmodelᚐSortDirection(ctx context.Context, sel ast.SelectionSet, v *model.SortDirection) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOString2ᚕstringᚄ(ctx context.Context, v interface{}) ([]string, error) {
 	if v == nil {
 		return nil, nil
@@ -0,0 +1,107 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+)
+
+// CheckFunc reports whether a dependency is usable, returning nil when it is
+type CheckFunc func(ctx context.Context) error
+
+type namedCheck struct {
+	name  string
+	check CheckFunc
+}
+
+// Checker serves the liveness and readiness endpoints
+type Checker struct {
+	checks       []namedCheck
+	timeout      time.Duration
+	shuttingDown atomic.Bool
+}
+
+type CheckResult struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks,omitempty"`
+}
+
+var errShuttingDown = errors.New("server is shutting down")
+
+// NewChecker creates a Checker that gives each readiness check up to timeout to complete
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{timeout: timeout}
+}
+
+// AddCheck registers a readiness check under name
+func (c *Checker) AddCheck(name string, check CheckFunc) {
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// SetShuttingDown makes every following readiness probe fail so the orchestrator stops routing
+// traffic while in-flight requests are drained
+func (c *Checker) SetShuttingDown() {
+	c.shuttingDown.Store(true)
+}
+
+// Ready runs every readiness check and reports whether all of them passed
+func (c *Checker) Ready(ctx context.Context) (Report, bool) {
+	report := Report{Status: "ready", Checks: map[string]CheckResult{}}
+	ready := true
+
+	if c.shuttingDown.Load() {
+		report.Checks["shutdown"] = CheckResult{Status: "failing", Error: errShuttingDown.Error()}
+		ready = false
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	for _, check := range c.checks {
+		if err := check.check(ctx); err != nil {
+			report.Checks[check.name] = CheckResult{Status: "failing", Error: err.Error()}
+			ready = false
+			continue
+		}
+		report.Checks[check.name] = CheckResult{Status: "ok"}
+	}
+
+	if !ready {
+		report.Status = "not ready"
+	}
+	return report, ready
+}
+
+// LivenessHandler serves /healthz, it only reports that the process is up and serving HTTP
+func (c *Checker) LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, http.StatusOK, Report{Status: "ok"})
+	})
+}
+
+// ReadinessHandler serves /readyz with a JSON report of every check
+func (c *Checker) ReadinessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		report, ready := c.Ready(r.Context())
+		status := http.StatusOK
+		if !ready {
+			status = http.StatusServiceUnavailable
+		}
+		writeReport(w, status, report)
+	})
+}
+
+func writeReport(w http.ResponseWriter, status int, report Report) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(report)
+}
@@ -0,0 +1,515 @@
+// Package jsonschema validates JSON property values against the JSON Schema stored for them on a type
+// schema node.
+//
+// It implements the validation keywords of JSON Schema that need no external resolution:
+//
+//	type, enum, const
+//	minimum, maximum, exclusiveMinimum, exclusiveMaximum, multipleOf
+//	minLength, maxLength, pattern
+//	items, minItems, maxItems, uniqueItems
+//	properties, required, additionalProperties, minProperties, maxProperties
+//	allOf, anyOf, oneOf, not
+//
+// Annotations such as title or description are ignored. Schemas using any other keyword, $ref in
+// particular, are rejected when compiled rather than silently accepting every value.
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Schema is a compiled JSON Schema
+type Schema struct {
+	boolean *bool
+
+	types    []string
+	enum     []any
+	constant *any
+
+	minimum, maximum                   *float64
+	exclusiveMinimum, exclusiveMaximum *float64
+	multipleOf                         *float64
+
+	minLength, maxLength *int
+	pattern              *regexp.Regexp
+
+	items                *Schema
+	minItems, maxItems   *int
+	uniqueItems          bool
+	properties           map[string]*Schema
+	required             []string
+	additionalProperties *Schema
+	minProperties        *int
+	maxProperties        *int
+
+	allOf, anyOf, oneOf []*Schema
+	not                 *Schema
+}
+
+var annotations = map[string]bool{
+	"$schema": true, "$id": true, "$comment": true, "title": true, "description": true,
+	"default": true, "examples": true, "deprecated": true, "readOnly": true, "writeOnly": true, "format": true,
+}
+
+var types = map[string]bool{
+	"null": true, "boolean": true, "object": true, "array": true, "number": true, "integer": true, "string": true,
+}
+
+// Compile parses a JSON Schema document
+func Compile(document string) (*Schema, error) {
+	value, err := Decode(document)
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON Schema: %w", err)
+	}
+	schema, err := compile(value, "#")
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON Schema: %w", err)
+	}
+	return schema, nil
+}
+
+// Decode parses a JSON document keeping numbers as json.Number, the representation values are validated in
+func Decode(document string) (any, error) {
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if decoder.More() {
+		return nil, fmt.Errorf("unexpected data after the JSON value")
+	}
+	return value, nil
+}
+
+func compile(value any, location string) (*Schema, error) {
+	if b, ok := value.(bool); ok {
+		return &Schema{boolean: &b}, nil
+	}
+	keywords, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: a schema must be an object or a boolean", location)
+	}
+
+	schema := &Schema{}
+	for _, keyword := range sortedKeys(keywords) {
+		argument := keywords[keyword]
+		at := location + "/" + keyword
+		var err error
+		switch keyword {
+		case "type":
+			schema.types, err = compileTypes(argument, at)
+		case "enum":
+			values, ok := argument.([]any)
+			if !ok {
+				return nil, fmt.Errorf("%s: must be an array", at)
+			}
+			schema.enum = values
+		case "const":
+			schema.constant = &argument
+		case "minimum":
+			schema.minimum, err = compileNumber(argument, at)
+		case "maximum":
+			schema.maximum, err = compileNumber(argument, at)
+		case "exclusiveMinimum":
+			schema.exclusiveMinimum, err = compileNumber(argument, at)
+		case "exclusiveMaximum":
+			schema.exclusiveMaximum, err = compileNumber(argument, at)
+		case "multipleOf":
+			if schema.multipleOf, err = compileNumber(argument, at); err == nil && *schema.multipleOf <= 0 {
+				err = fmt.Errorf("%s: must be greater than 0", at)
+			}
+		case "minLength":
+			schema.minLength, err = compileCount(argument, at)
+		case "maxLength":
+			schema.maxLength, err = compileCount(argument, at)
+		case "pattern":
+			pattern, ok := argument.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s: must be a string", at)
+			}
+			if schema.pattern, err = regexp.Compile(pattern); err != nil {
+				err = fmt.Errorf("%s: %w", at, err)
+			}
+		case "items":
+			schema.items, err = compile(argument, at)
+		case "minItems":
+			schema.minItems, err = compileCount(argument, at)
+		case "maxItems":
+			schema.maxItems, err = compileCount(argument, at)
+		case "uniqueItems":
+			unique, ok := argument.(bool)
+			if !ok {
+				return nil, fmt.Errorf("%s: must be a boolean", at)
+			}
+			schema.uniqueItems = unique
+		case "properties":
+			properties, ok := argument.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%s: must be an object", at)
+			}
+			schema.properties = map[string]*Schema{}
+			for _, name := range sortedKeys(properties) {
+				if schema.properties[name], err = compile(properties[name], at+"/"+name); err != nil {
+					return nil, err
+				}
+			}
+		case "required":
+			names, ok := argument.([]any)
+			if !ok {
+				return nil, fmt.Errorf("%s: must be an array of strings", at)
+			}
+			for _, name := range names {
+				s, ok := name.(string)
+				if !ok {
+					return nil, fmt.Errorf("%s: must be an array of strings", at)
+				}
+				schema.required = append(schema.required, s)
+			}
+		case "additionalProperties":
+			schema.additionalProperties, err = compile(argument, at)
+		case "minProperties":
+			schema.minProperties, err = compileCount(argument, at)
+		case "maxProperties":
+			schema.maxProperties, err = compileCount(argument, at)
+		case "allOf":
+			schema.allOf, err = compileList(argument, at)
+		case "anyOf":
+			schema.anyOf, err = compileList(argument, at)
+		case "oneOf":
+			schema.oneOf, err = compileList(argument, at)
+		case "not":
+			schema.not, err = compile(argument, at)
+		default:
+			if !annotations[keyword] {
+				return nil, fmt.Errorf("%s: unsupported keyword", at)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return schema, nil
+}
+
+func compileTypes(argument any, location string) ([]string, error) {
+	names := []any{argument}
+	if list, ok := argument.([]any); ok {
+		names = list
+	}
+	result := []string{}
+	for _, name := range names {
+		s, ok := name.(string)
+		if !ok || !types[s] {
+			return nil, fmt.Errorf("%s: %v is not a JSON type", location, name)
+		}
+		result = append(result, s)
+	}
+	return result, nil
+}
+
+func compileNumber(argument any, location string) (*float64, error) {
+	number, ok := toFloat(argument)
+	if !ok {
+		return nil, fmt.Errorf("%s: must be a number", location)
+	}
+	return &number, nil
+}
+
+func compileCount(argument any, location string) (*int, error) {
+	number, ok := toFloat(argument)
+	if !ok || number < 0 || number != math.Trunc(number) {
+		return nil, fmt.Errorf("%s: must be a non-negative integer", location)
+	}
+	count := int(number)
+	return &count, nil
+}
+
+func compileList(argument any, location string) ([]*Schema, error) {
+	list, ok := argument.([]any)
+	if !ok || len(list) == 0 {
+		return nil, fmt.Errorf("%s: must be a non-empty array of schemas", location)
+	}
+	schemas := make([]*Schema, 0, len(list))
+	for i, item := range list {
+		schema, err := compile(item, fmt.Sprintf("%s/%d", location, i))
+		if err != nil {
+			return nil, err
+		}
+		schemas = append(schemas, schema)
+	}
+	return schemas, nil
+}
+
+// Validate checks a value decoded by Decode, the error names the first location that does not match
+func (s *Schema) Validate(value any) error {
+	return s.validate(value, "$")
+}
+
+func (s *Schema) validate(value any, location string) error {
+	if s.boolean != nil {
+		if !*s.boolean {
+			return fmt.Errorf("%s: no value is allowed", location)
+		}
+		return nil
+	}
+
+	if len(s.types) > 0 {
+		matched := false
+		for _, name := range s.types {
+			if hasType(value, name) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("%s: expected %s, got %s", location, strings.Join(s.types, " or "), typeOf(value))
+		}
+	}
+	if s.enum != nil {
+		matched := false
+		for _, allowed := range s.enum {
+			if Equal(value, allowed) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return fmt.Errorf("%s: %s is not one of the allowed values", location, encode(value))
+		}
+	}
+	if s.constant != nil && !Equal(value, *s.constant) {
+		return fmt.Errorf("%s: expected %s", location, encode(*s.constant))
+	}
+
+	switch v := value.(type) {
+	case json.Number, float64, int, int64:
+		if err := s.validateNumber(v, location); err != nil {
+			return err
+		}
+	case string:
+		length := utf8.RuneCountInString(v)
+		if s.minLength != nil && length < *s.minLength {
+			return fmt.Errorf("%s: shorter than %d characters", location, *s.minLength)
+		}
+		if s.maxLength != nil && length > *s.maxLength {
+			return fmt.Errorf("%s: longer than %d characters", location, *s.maxLength)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(v) {
+			return fmt.Errorf("%s: does not match %s", location, s.pattern)
+		}
+	case []any:
+		if s.minItems != nil && len(v) < *s.minItems {
+			return fmt.Errorf("%s: fewer than %d items", location, *s.minItems)
+		}
+		if s.maxItems != nil && len(v) > *s.maxItems {
+			return fmt.Errorf("%s: more than %d items", location, *s.maxItems)
+		}
+		if s.uniqueItems {
+			for i := range v {
+				for j := i + 1; j < len(v); j++ {
+					if Equal(v[i], v[j]) {
+						return fmt.Errorf("%s: items %d and %d are equal", location, i, j)
+					}
+				}
+			}
+		}
+		if s.items != nil {
+			for i, item := range v {
+				if err := s.items.validate(item, fmt.Sprintf("%s[%d]", location, i)); err != nil {
+					return err
+				}
+			}
+		}
+	case map[string]any:
+		if s.minProperties != nil && len(v) < *s.minProperties {
+			return fmt.Errorf("%s: fewer than %d properties", location, *s.minProperties)
+		}
+		if s.maxProperties != nil && len(v) > *s.maxProperties {
+			return fmt.Errorf("%s: more than %d properties", location, *s.maxProperties)
+		}
+		for _, name := range s.required {
+			if _, ok := v[name]; !ok {
+				return fmt.Errorf("%s: missing required property %s", location, name)
+			}
+		}
+		for _, name := range sortedKeys(v) {
+			property, ok := s.properties[name]
+			if !ok {
+				property = s.additionalProperties
+			}
+			if property == nil {
+				continue
+			}
+			if err := property.validate(v[name], location+"."+name); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, schema := range s.allOf {
+		if err := schema.validate(value, location); err != nil {
+			return err
+		}
+	}
+	if s.anyOf != nil {
+		var first error
+		for _, schema := range s.anyOf {
+			err := schema.validate(value, location)
+			if err == nil {
+				first = nil
+				break
+			}
+			if first == nil {
+				first = err
+			}
+		}
+		if first != nil {
+			return fmt.Errorf("%s: matches none of anyOf, first mismatch: %w", location, first)
+		}
+	}
+	if s.oneOf != nil {
+		matches := 0
+		for _, schema := range s.oneOf {
+			if schema.validate(value, location) == nil {
+				matches++
+			}
+		}
+		if matches != 1 {
+			return fmt.Errorf("%s: matches %d of oneOf, expected exactly 1", location, matches)
+		}
+	}
+	if s.not != nil && s.not.validate(value, location) == nil {
+		return fmt.Errorf("%s: must not match the schema of not", location)
+	}
+	return nil
+}
+
+func (s *Schema) validateNumber(value any, location string) error {
+	number, _ := toFloat(value)
+	if s.minimum != nil && number < *s.minimum {
+		return fmt.Errorf("%s: less than %v", location, *s.minimum)
+	}
+	if s.maximum != nil && number > *s.maximum {
+		return fmt.Errorf("%s: greater than %v", location, *s.maximum)
+	}
+	if s.exclusiveMinimum != nil && number <= *s.exclusiveMinimum {
+		return fmt.Errorf("%s: not greater than %v", location, *s.exclusiveMinimum)
+	}
+	if s.exclusiveMaximum != nil && number >= *s.exclusiveMaximum {
+		return fmt.Errorf("%s: not less than %v", location, *s.exclusiveMaximum)
+	}
+	if s.multipleOf != nil {
+		quotient := number / *s.multipleOf
+		if math.Abs(quotient-math.Round(quotient)) > 1e-9 {
+			return fmt.Errorf("%s: not a multiple of %v", location, *s.multipleOf)
+		}
+	}
+	return nil
+}
+
+func hasType(value any, name string) bool {
+	switch name {
+	case "integer":
+		number, ok := toFloat(value)
+		return ok && number == math.Trunc(number)
+	case "number":
+		_, ok := toFloat(value)
+		return ok
+	}
+	return typeOf(value) == name
+}
+
+func typeOf(value any) string {
+	switch value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	case json.Number, float64, int, int64:
+		return "number"
+	}
+	return fmt.Sprintf("%T", value)
+}
+
+// Equal compares two decoded JSON values, numbers are equal when their values are
+func Equal(a any, b any) bool {
+	if x, ok := toFloat(a); ok {
+		y, ok := toFloat(b)
+		return ok && x == y
+	}
+	switch x := a.(type) {
+	case []any:
+		y, ok := b.([]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for i := range x {
+			if !Equal(x[i], y[i]) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		y, ok := b.(map[string]any)
+		if !ok || len(x) != len(y) {
+			return false
+		}
+		for key, value := range x {
+			other, ok := y[key]
+			if !ok || !Equal(value, other) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+func encode(value any) string {
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Sprint(value)
+	}
+	return strings.TrimSpace(b.String())
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,170 @@
+package jsonschema
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		value  string
+		// want is a part of the expected error, empty when the value is valid
+		want string
+	}{
+		{"type string", `{"type": "string"}`, `"a"`, ""},
+		{"type string mismatch", `{"type": "string"}`, `1`, "$: expected string, got number"},
+		{"type integer", `{"type": "integer"}`, `3`, ""},
+		{"type integer with zero fraction", `{"type": "integer"}`, `3.0`, ""},
+		{"type integer mismatch", `{"type": "integer"}`, `3.5`, "expected integer, got number"},
+		{"type number", `{"type": "number"}`, `-2.5e3`, ""},
+		{"type null", `{"type": "null"}`, `null`, ""},
+		{"type boolean mismatch", `{"type": "boolean"}`, `"true"`, "expected boolean, got string"},
+		{"type list", `{"type": ["string", "null"]}`, `null`, ""},
+		{"type list mismatch", `{"type": ["string", "null"]}`, `{}`, "expected string or null, got object"},
+		{"type array", `{"type": "array"}`, `[]`, ""},
+		{"type object mismatch", `{"type": "object"}`, `[]`, "expected object, got array"},
+
+		{"required present", `{"required": ["a", "b"]}`, `{"a": 1, "b": null}`, ""},
+		{"required missing", `{"required": ["a", "b"]}`, `{"a": 1}`, "$: missing required property b"},
+		{"required ignores other types", `{"required": ["a"]}`, `"a"`, ""},
+
+		{"properties", `{"properties": {"a": {"type": "string"}}}`, `{"a": "x", "b": 1}`, ""},
+		{"properties mismatch", `{"properties": {"a": {"type": "string"}}}`, `{"a": 1}`, "$.a: expected string, got number"},
+		{"nested properties mismatch", `{"properties": {"a": {"properties": {"b": {"minimum": 2}}}}}`, `{"a": {"b": 1}}`, "$.a.b: less than 2"},
+		{"properties absent", `{"properties": {"a": {"type": "string"}}}`, `{}`, ""},
+
+		{"items", `{"items": {"type": "integer"}}`, `[1, 2, 3]`, ""},
+		{"items mismatch", `{"items": {"type": "integer"}}`, `[1, "2"]`, "$[1]: expected integer, got string"},
+		{"items nested", `{"items": {"items": {"type": "boolean"}}}`, `[[true], [false, 0]]`, "$[1][1]: expected boolean"},
+		{"minItems", `{"minItems": 2}`, `[1]`, "fewer than 2 items"},
+		{"maxItems", `{"maxItems": 1}`, `[1, 2]`, "more than 1 items"},
+		{"uniqueItems", `{"uniqueItems": true}`, `[1, 2, 1.0]`, "items 0 and 2 are equal"},
+		{"uniqueItems of objects", `{"uniqueItems": true}`, `[{"a": 1}, {"a": 2}]`, ""},
+
+		{"enum", `{"enum": ["red", 1, null, [1]]}`, `[1.0]`, ""},
+		{"enum mismatch", `{"enum": ["red", 1, null]}`, `"blue"`, `$: "blue" is not one of the allowed values`},
+		{"enum number", `{"enum": [1]}`, `1.0`, ""},
+		{"const", `{"const": {"a": [1, "b"]}}`, `{"a": [1, "b"]}`, ""},
+		{"const mismatch", `{"const": {"a": [1, "b"]}}`, `{"a": [1]}`, `expected {"a":[1,"b"]}`},
+
+		{"minimum", `{"minimum": 1}`, `1`, ""},
+		{"minimum mismatch", `{"minimum": 1}`, `0.5`, "less than 1"},
+		{"maximum", `{"maximum": 1}`, `1`, ""},
+		{"maximum mismatch", `{"maximum": 1}`, `1.5`, "greater than 1"},
+		{"exclusiveMinimum", `{"exclusiveMinimum": 1}`, `1`, "not greater than 1"},
+		{"exclusiveMaximum", `{"exclusiveMaximum": 1}`, `1`, "not less than 1"},
+		{"multipleOf", `{"multipleOf": 0.1}`, `0.3`, ""},
+		{"multipleOf mismatch", `{"multipleOf": 2}`, `3`, "not a multiple of 2"},
+		{"minimum ignores other types", `{"minimum": 1}`, `"0"`, ""},
+
+		{"minLength counts characters", `{"minLength": 2}`, `"éé"`, ""},
+		{"minLength mismatch", `{"minLength": 2}`, `"é"`, "shorter than 2 characters"},
+		{"maxLength mismatch", `{"maxLength": 2}`, `"abc"`, "longer than 2 characters"},
+		{"pattern", `{"pattern": "^[a-z]+-[0-9]+$"}`, `"web-1"`, ""},
+		{"pattern is unanchored", `{"pattern": "[0-9]"}`, `"web-1a"`, ""},
+		{"pattern mismatch", `{"pattern": "^[a-z]+-[0-9]+$"}`, `"WEB-1"`, "does not match ^[a-z]+-[0-9]+$"},
+
+		{"additionalProperties false", `{"properties": {"a": {}}, "additionalProperties": false}`, `{"a": 1}`, ""},
+		{"additionalProperties false mismatch", `{"properties": {"a": {}}, "additionalProperties": false}`, `{"a": 1, "b": 2}`, "$.b: no value is allowed"},
+		{"additionalProperties schema", `{"additionalProperties": {"type": "number"}}`, `{"a": 1, "b": "2"}`, "$.b: expected number, got string"},
+		{"minProperties", `{"minProperties": 1}`, `{}`, "fewer than 1 properties"},
+		{"maxProperties", `{"maxProperties": 1}`, `{"a": 1, "b": 2}`, "more than 1 properties"},
+
+		{"allOf", `{"allOf": [{"minimum": 1}, {"maximum": 3}]}`, `4`, "greater than 3"},
+		{"anyOf", `{"anyOf": [{"type": "string"}, {"type": "number"}]}`, `1`, ""},
+		{"anyOf mismatch", `{"anyOf": [{"type": "string"}, {"type": "number"}]}`, `true`, "matches none of anyOf"},
+		{"oneOf", `{"oneOf": [{"minimum": 2}, {"maximum": 0}]}`, `3`, ""},
+		{"oneOf matching two", `{"oneOf": [{"minimum": 2}, {"minimum": 1}]}`, `3`, "matches 2 of oneOf"},
+		{"not", `{"not": {"type": "null"}}`, `null`, "must not match the schema of not"},
+
+		{"true schema", `true`, `{"anything": [1]}`, ""},
+		{"false schema", `false`, `1`, "$: no value is allowed"},
+		{"annotations", `{"title": "t", "description": "d", "format": "email", "default": 1}`, `"x"`, ""},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schema, err := Compile(test.schema)
+			if err != nil {
+				t.Fatalf("Compile(%s) failed: %v", test.schema, err)
+			}
+			value, err := Decode(test.value)
+			if err != nil {
+				t.Fatalf("Decode(%s) failed: %v", test.value, err)
+			}
+			err = schema.Validate(value)
+			switch {
+			case test.want == "" && err != nil:
+				t.Errorf("%s against %s: got error %v, want none", test.value, test.schema, err)
+			case test.want != "" && err == nil:
+				t.Errorf("%s against %s: got no error, want %q", test.value, test.schema, test.want)
+			case test.want != "" && !strings.Contains(err.Error(), test.want):
+				t.Errorf("%s against %s: got error %q, want %q", test.value, test.schema, err, test.want)
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		schema string
+		want   string
+	}{
+		{``, "invalid JSON Schema: EOF"},
+		{`{"type": "string"`, "invalid JSON Schema"},
+		{`{} {}`, "unexpected data after the JSON value"},
+		{`"string"`, "#: a schema must be an object or a boolean"},
+		{`{"$ref": "#/definitions/a"}`, "#/$ref: unsupported keyword"},
+		{`{"type": "text"}`, "#/type: text is not a JSON type"},
+		{`{"type": ["string", 1]}`, "#/type: 1 is not a JSON type"},
+		{`{"enum": "red"}`, "#/enum: must be an array"},
+		{`{"minimum": "1"}`, "#/minimum: must be a number"},
+		{`{"multipleOf": 0}`, "#/multipleOf: must be greater than 0"},
+		{`{"minLength": -1}`, "#/minLength: must be a non-negative integer"},
+		{`{"maxItems": 1.5}`, "#/maxItems: must be a non-negative integer"},
+		{`{"pattern": 1}`, "#/pattern: must be a string"},
+		{`{"pattern": "(unclosed"}`, "#/pattern: error parsing regexp"},
+		{`{"uniqueItems": "yes"}`, "#/uniqueItems: must be a boolean"},
+		{`{"properties": []}`, "#/properties: must be an object"},
+		{`{"properties": {"a": {"type": 1}}}`, "#/properties/a/type: 1 is not a JSON type"},
+		{`{"required": "a"}`, "#/required: must be an array of strings"},
+		{`{"required": ["a", 1]}`, "#/required: must be an array of strings"},
+		{`{"items": [{"type": "string"}]}`, "#/items: a schema must be an object or a boolean"},
+		{`{"additionalProperties": {"maxProperties": -2}}`, "#/additionalProperties/maxProperties: must be a non-negative integer"},
+		{`{"anyOf": []}`, "#/anyOf: must be a non-empty array of schemas"},
+		{`{"oneOf": [{}, {"unknown": 1}]}`, "#/oneOf/1/unknown: unsupported keyword"},
+		{`{"not": 1}`, "#/not: a schema must be an object or a boolean"},
+	}
+	for _, test := range tests {
+		schema, err := Compile(test.schema)
+		if err == nil {
+			t.Errorf("Compile(%s) = %v, want error %q", test.schema, schema, test.want)
+			continue
+		}
+		if !strings.HasPrefix(err.Error(), "invalid JSON Schema") || !strings.Contains(err.Error(), test.want) {
+			t.Errorf("Compile(%s) error %q, want %q", test.schema, err, test.want)
+		}
+	}
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{`1`, `1.0`, true},
+		{`1`, `"1"`, false},
+		{`[1, {"a": null}]`, `[1.0, {"a": null}]`, true},
+		{`{"a": 1}`, `{"a": 1, "b": 2}`, false},
+		{`[1, 2]`, `[2, 1]`, false},
+		{`null`, `false`, false},
+	}
+	for _, test := range tests {
+		a, _ := Decode(test.a)
+		b, _ := Decode(test.b)
+		if got := Equal(a, b); got != test.want {
+			t.Errorf("Equal(%s, %s) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Extension is a gqlgen handler extension that logs every GraphQL operation and failing resolver
+// with the request ID of the HTTP request that carried it
+type Extension struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = Extension{}
+
+func (Extension) ExtensionName() string {
+	return "StructuredLogging"
+}
+
+func (Extension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (Extension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	response := next(ctx)
+
+	opCtx := graphql.GetOperationContext(ctx)
+	operationType := "unknown"
+	if opCtx.Operation != nil {
+		operationType = string(opCtx.Operation.Operation)
+	}
+	attrs := []any{
+		slog.String("operation", opCtx.OperationName),
+		slog.String("type", operationType),
+		slog.Duration("duration", time.Since(start)),
+	}
+	if response != nil && len(response.Errors) > 0 {
+		FromContext(ctx).WarnContext(ctx, "graphql operation failed", append(attrs, slog.String("errors", response.Errors.Error()))...)
+	} else {
+		FromContext(ctx).DebugContext(ctx, "graphql operation completed", attrs...)
+	}
+	return response
+}
+
+func (Extension) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	res, err := next(ctx)
+	if err != nil {
+		FromContext(ctx).ErrorContext(ctx, "resolver failed",
+			slog.String("object", fc.Object),
+			slog.String("field", fc.Field.Name),
+			slog.Any("error", err),
+		)
+	}
+	return res, err
+}
@@ -0,0 +1,141 @@
+package logging
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/mike-jacks/neo/tracing"
+	"github.com/mike-jacks/neo/utils"
+)
+
+const RequestIDHeader = "X-Request-ID"
+
+type requestIDKey struct{}
+
+// queryLogging is off by default, generated Cypher is only logged once it is explicitly enabled
+var queryLogging atomic.Bool
+
+// Options configures the process wide logger
+type Options struct {
+	Level   string // debug, info, warn or error
+	Format  string // text or json
+	Queries bool   // log every generated Cypher statement at debug level
+}
+
+// Setup installs a slog logger built from options as the default logger and returns it
+func Setup(options Options, w io.Writer) (*slog.Logger, error) {
+	level, err := ParseLevel(options.Level)
+	if err != nil {
+		return nil, err
+	}
+
+	handlerOptions := &slog.HandlerOptions{Level: level}
+	var handler slog.Handler
+	switch strings.ToLower(options.Format) {
+	case "", "text":
+		handler = slog.NewTextHandler(w, handlerOptions)
+	case "json":
+		handler = slog.NewJSONHandler(w, handlerOptions)
+	default:
+		return nil, fmt.Errorf("unknown log format %q, expected text or json", options.Format)
+	}
+
+	queryLogging.Store(options.Queries)
+
+	logger := slog.New(handler)
+	slog.SetDefault(logger)
+	return logger, nil
+}
+
+// ParseLevel converts a level name to a slog.Level, an empty name means info
+func ParseLevel(level string) (slog.Level, error) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "", "info":
+		return slog.LevelInfo, nil
+	case "debug":
+		return slog.LevelDebug, nil
+	case "warn", "warning":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	}
+	return slog.LevelInfo, fmt.Errorf("unknown log level %q, expected debug, info, warn or error", level)
+}
+
+// WithRequestID returns a copy of ctx carrying the request ID
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestID returns the request ID stored in ctx, if any
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// FromContext returns the default logger annotated with the request ID stored in ctx
+func FromContext(ctx context.Context) *slog.Logger {
+	logger := slog.Default()
+	if requestID := RequestID(ctx); requestID != "" {
+		logger = logger.With(slog.String("request_id", requestID))
+	}
+	return logger
+}
+
+// Middleware assigns every request an ID, reusing the X-Request-ID header sent by the client or a
+// proxy when present, echoes it back in the response and logs the request
+func Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := r.Header.Get(RequestIDHeader)
+		if requestID == "" {
+			requestID = utils.GenerateId()
+		}
+		w.Header().Set(RequestIDHeader, requestID)
+
+		ctx := WithRequestID(r.Context(), requestID)
+		proto := r.Header.Get("X-Forwarded-Proto")
+		if proto == "" {
+			proto = "http"
+		}
+		FromContext(ctx).Info("request received",
+			slog.String("method", r.Method),
+			slog.String("path", r.URL.Path),
+			slog.String("protocol", proto),
+			slog.String("origin", r.Header.Get("Origin")),
+		)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// Query logs a Cypher statement at debug level when query logging is enabled. Parameter values are
+// redacted, only their keys are logged, and the statement is logged with its literals stripped, so values
+// written inline, as in statements run through cypherQuery, are not logged either.
+func Query(ctx context.Context, query string, parameters map[string]any) {
+	if !queryLogging.Load() {
+		return
+	}
+	logger := FromContext(ctx)
+	if !logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+	logger.DebugContext(ctx, "cypher query",
+		slog.String("query", tracing.StripLiterals(query)),
+		slog.Any("parameters", redact(parameters)),
+	)
+}
+
+func redact(parameters map[string]any) []string {
+	keys := make([]string, 0, len(parameters))
+	for key := range parameters {
+		keys = append(keys, key+"=[REDACTED]")
+	}
+	sort.Strings(keys)
+	return keys
+}
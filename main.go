@@ -2,11 +2,19 @@ package main
 
 import (
 	"context"
-	"log"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
 	"net/http"
+	"net/url"
 	"os"
+	"os/signal"
 	"strings"
+	"syscall"
+	"time"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
@@ -14,9 +22,18 @@ import (
 	"github.com/gorilla/websocket"
 	lru "github.com/hashicorp/golang-lru/v2"
 	"github.com/joho/godotenv"
+	"github.com/mike-jacks/neo/config"
 	"github.com/mike-jacks/neo/db"
+	"github.com/mike-jacks/neo/domainapi"
+	"github.com/mike-jacks/neo/drain"
 	"github.com/mike-jacks/neo/generated"
+	"github.com/mike-jacks/neo/health"
+	"github.com/mike-jacks/neo/logging"
+	"github.com/mike-jacks/neo/metrics"
 	"github.com/mike-jacks/neo/resolver"
+	"github.com/mike-jacks/neo/subscriptions"
+	"github.com/mike-jacks/neo/tracing"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	"github.com/rs/cors"
 	"github.com/vektah/gqlparser/v2/ast"
 )
@@ -24,6 +41,7 @@ import (
 // LRUQueryCache is a custom cache that implements graphql.Cache[*ast.QueryDocument]
 type LRUQueryCache struct {
 	cache *lru.Cache[string, *ast.QueryDocument]
+	name  string
 }
 
 // NewLRUQueryCache creates a new LRUQueryCache with the given size
@@ -32,7 +50,7 @@ func NewLRUQueryCache(size int) (*LRUQueryCache, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &LRUQueryCache{cache: cache}, nil
+	return &LRUQueryCache{cache: cache, name: "query"}, nil
 }
 
 // Add adds a query document to the cache
@@ -42,12 +60,15 @@ func (c *LRUQueryCache) Add(ctx context.Context, key string, value *ast.QueryDoc
 
 // Get retrieves a query document from the cache
 func (c *LRUQueryCache) Get(ctx context.Context, key string) (*ast.QueryDocument, bool) {
-	return c.cache.Get(key)
+	value, ok := c.cache.Get(key)
+	metrics.ObserveCacheLookup(c.name, ok)
+	return value, ok
 }
 
 // LRUStringCache is a custom cache that implements graphql.Cache[string]
 type LRUStringCache struct {
 	cache *lru.Cache[string, string]
+	name  string
 }
 
 // NewLRUStringCache creates a new LRUStringCache with the given size
@@ -56,7 +77,7 @@ func NewLRUStringCache(size int) (*LRUStringCache, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &LRUStringCache{cache: cache}, nil
+	return &LRUStringCache{cache: cache, name: "persisted_query"}, nil
 }
 
 // Add adds a string to the cache
@@ -66,11 +87,16 @@ func (c *LRUStringCache) Add(ctx context.Context, key string, value string) {
 
 // Get retrieves a string from the cache
 func (c *LRUStringCache) Get(ctx context.Context, key string) (string, bool) {
-	return c.cache.Get(key)
+	value, ok := c.cache.Get(key)
+	metrics.ObserveCacheLookup(c.name, ok)
+	return value, ok
 }
 
-func setupGraphQLServer(db db.Database) *handler.Server {
-	resolver := resolver.NewResolver(db)
+// websocketCountedKey marks websocket connections that were counted in metrics.WebsocketConnections
+type websocketCountedKey struct{}
+
+func setupGraphQLServer(cfg *config.Config, db db.Database, subscriptionManager *subscriptions.SubscriptionManager, tracker *drain.Tracker) *handler.Server {
+	resolver := resolver.NewResolver(db, subscriptionManager)
 	schema := generated.NewExecutableSchema(generated.Config{Resolvers: resolver})
 	server := handler.New(schema)
 
@@ -79,97 +105,248 @@ func setupGraphQLServer(db db.Database) *handler.Server {
 			CheckOrigin: func(r *http.Request) bool {
 				return true
 			},
-			ReadBufferSize:  1024,
-			WriteBufferSize: 1024,
+			ReadBufferSize:  cfg.Websocket.ReadBufferSize,
+			WriteBufferSize: cfg.Websocket.WriteBufferSize,
+		},
+		InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+			metrics.WebsocketConnections.Inc()
+			return context.WithValue(ctx, websocketCountedKey{}, true), nil, nil
+		},
+		CloseFunc: func(ctx context.Context, closeCode int) {
+			if counted, _ := ctx.Value(websocketCountedKey{}).(bool); counted {
+				metrics.WebsocketConnections.Dec()
+			}
 		},
 	})
 
+	configureGraphQLServer(cfg, server, tracker)
+	return server
+}
+
+// newDomainGraphQLServer creates the server of a generated domain API, which has no subscriptions
+func newDomainGraphQLServer(cfg *config.Config, tracker *drain.Tracker) func(schema graphql.ExecutableSchema) *handler.Server {
+	return func(schema graphql.ExecutableSchema) *handler.Server {
+		server := handler.New(schema)
+		configureGraphQLServer(cfg, server, tracker)
+		return server
+	}
+}
+
+// configureGraphQLServer adds the HTTP transports, caches and extensions shared by every GraphQL server
+func configureGraphQLServer(cfg *config.Config, server *handler.Server, tracker *drain.Tracker) {
 	server.AddTransport(transport.Options{})
 	server.AddTransport(transport.GET{})
 	server.AddTransport(transport.POST{})
 	server.AddTransport(transport.MultipartForm{})
 
 	// Create a custom LRU cache for query documents
-	queryCache, err := NewLRUQueryCache(1000)
+	queryCache, err := NewLRUQueryCache(cfg.GraphQL.QueryCacheSize)
 	if err != nil {
-		log.Fatal("Error creating query cache:", err)
+		fatal("Error creating query cache", err)
 	}
 	server.SetQueryCache(queryCache)
 
 	// Create a custom LRU cache for persisted queries
-	persistedQueryCache, err := NewLRUStringCache(100)
+	persistedQueryCache, err := NewLRUStringCache(cfg.GraphQL.PersistedQueryCacheSize)
 	if err != nil {
-		log.Fatal("Error creating persisted query cache:", err)
+		fatal("Error creating persisted query cache", err)
 	}
 
+	server.Use(tracker)
+	server.Use(logging.Extension{})
+	server.Use(metrics.Extension{})
+	server.Use(tracing.Extension{})
 	server.Use(extension.Introspection{})
 	server.Use(extension.AutomaticPersistedQuery{
 		Cache: persistedQueryCache, // Use the custom LRUStringCache
 	})
+}
 
-	return server
+// fatal logs err and exits, replacing log.Fatal now that logging goes through slog
+func fatal(message string, err error) {
+	slog.Error(message, slog.Any("error", err))
+	os.Exit(1)
 }
 
 func main() {
-	if err := godotenv.Load(); err != nil {
-		log.Println(".env file not found")
+	envErr := godotenv.Load()
+
+	// The first positional argument names a subcommand, for example neo migrate status. Flags may
+	// come before or right after it.
+	var commandName string
+	args := os.Args[1:]
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		commandName, args = args[0], args[1:]
 	}
 
-	driver, err := db.SetupNeo4jDriver()
+	cfg, err := config.Load(args)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
 	if err != nil {
-		log.Fatal(err)
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	if commandName == "" && len(cfg.Args) > 0 {
+		commandName, cfg.Args = cfg.Args[0], cfg.Args[1:]
+	}
+	run, ok := commands[commandName]
+	if commandName != "" && !ok {
+		fmt.Fprintf(os.Stderr, "unknown command %q\n", commandName)
+		os.Exit(2)
+	}
+	if cfg.PrintConfig {
+		if err := cfg.Print(os.Stdout); err != nil {
+			fatal("Error printing configuration", err)
+		}
+		if err := cfg.Validate(); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(2)
+		}
+		return
 	}
-	defer driver.Close(context.Background())
 
-	neo4jdb := &db.Neo4jDatabase{Driver: driver}
+	loggingOptions := logging.Options{Level: cfg.Logging.Level, Format: cfg.Logging.Format, Queries: cfg.Logging.Queries}
+	if _, err := logging.Setup(loggingOptions, os.Stdout); err != nil {
+		fatal("Error setting up logging", err)
+	}
+	if envErr != nil {
+		slog.Info(".env file not found")
+	}
 
-	srv := setupGraphQLServer(neo4jdb)
+	shutdownTracing, tracingEnabled, err := tracing.SetupOTLP(context.Background(), cfg.Tracing.OTLPEndpoint)
+	if err != nil {
+		fatal("Error setting up tracing", err)
+	}
+	defer shutdownTracing(context.Background())
+	if tracingEnabled {
+		slog.Info("OpenTelemetry tracing enabled")
+	}
 
-	corsHandler := cors.New(cors.Options{
-		AllowedOrigins: []string{"*"},
-		AllowedMethods: []string{"GET", "POST", "OPTIONS"},
-		AllowedHeaders: []string{"*"},
-	})
+	driver, err := db.SetupNeo4jDriver(cfg.Neo4j)
+	if err != nil {
+		fatal("Error connecting to Neo4j", err)
+	}
+
+	neo4jdb := db.NewNeo4jDatabase(driver, cfg.Neo4j)
+
+	// Create the schema constraints once up front instead of on every write
+	if err := neo4jdb.BootstrapSchema(context.Background()); err != nil {
+		fatal("Error creating schema constraints", err)
+	}
 
-	loggingHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		proto := r.Header.Get("X-Forwarded-Proto")
-		if proto == "" {
-			proto = "http"
+	if run != nil {
+		err := run(context.Background(), cfg, neo4jdb, cfg.Args)
+		driver.Close(context.Background())
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
 		}
-		log.Printf("Request received: Method: %s, Path: %s, Protocol: %s", r.Method, r.URL.Path, proto)
+		return
+	}
+
+	subscriptionManager := subscriptions.NewSubscriptionManager()
+
+	tracker := drain.NewTracker()
 
+	srv := setupGraphQLServer(cfg, neo4jdb, subscriptionManager, tracker)
+	domainAPIs := domainapi.NewRegistry(neo4jdb, subscriptionManager, newDomainGraphQLServer(cfg, tracker))
+
+	healthChecker := health.NewChecker(cfg.Server.HealthCheckTimeout)
+	healthChecker.AddCheck("neo4j", driver.VerifyConnectivity)
+	healthChecker.AddCheck("schemaConstraints", neo4jdb.CheckSystemConstraints)
+	healthChecker.AddCheck("subscriptions", subscriptionManager.Check)
+
+	corsHandler := cors.New(cors.Options{
+		AllowedOrigins: cfg.CORS.AllowedOrigins,
+		AllowedMethods: cfg.CORS.AllowedMethods,
+		AllowedHeaders: cfg.CORS.AllowedHeaders,
+	})
+
+	queryHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if websocket.IsWebSocketUpgrade(r) {
-			log.Printf("WebSocket Upgrade Detected. Origin: %s", r.Header.Get("Origin"))
+			logging.FromContext(r.Context()).Debug("websocket upgrade detected")
 			srv.ServeHTTP(w, r)
 			return
 		} else {
-			log.Printf("Non-WebSocket Request Detected. Origin: %s", r.Header.Get("Origin"))
 			corsHandler.Handler(srv).ServeHTTP(w, r)
 		}
 	})
 
-	http.Handle("/graphql", corsHandler.Handler(playground.Handler("GraphQL Playground", "/query")))
-	http.Handle("/query", loggingHandler)
+	mux := http.NewServeMux()
+	mux.Handle("/graphql", corsHandler.Handler(playground.Handler("GraphQL Playground", "/query")))
+	mux.Handle("/query", logging.Middleware(queryHandler))
+	mux.Handle("/domains/{domain}/graphql", corsHandler.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		domain := r.PathValue("domain")
+		playground.Handler("GraphQL Playground: "+domain, "/domains/"+url.PathEscape(domain)+"/query").ServeHTTP(w, r)
+	})))
+	mux.Handle("/domains/{domain}/query", logging.Middleware(corsHandler.Handler(domainAPIs)))
+	mux.Handle("/metrics", metrics.Handler())
+	mux.Handle("/healthz", healthChecker.LivenessHandler())
+	mux.Handle("/readyz", healthChecker.ReadinessHandler())
+
+	url := cfg.ServerURL()
+	websocketUrl := strings.Replace(strings.Replace(url, "https://", "wss://", 1), "http://", "ws://", 1)
 
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
+	slog.Info("server starting",
+		slog.String("playground", url+"/graphql"),
+		slog.String("api", url+"/query"),
+		slog.String("websocket", websocketUrl+"/query"),
+		slog.String("domainAPI", url+"/domains/{domain}/query"),
+		slog.String("metrics", url+"/metrics"),
+		slog.String("readiness", url+"/readyz"),
+		slog.String("neo4jConsole", "https://console.neo4j.io"),
+	)
+
+	httpServer := &http.Server{Addr: fmt.Sprintf(":%d", cfg.Server.Port), Handler: mux}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	serverErr := make(chan error, 1)
+	go func() {
+		serverErr <- httpServer.ListenAndServe()
+	}()
+
+	select {
+	case err := <-serverErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			fatal("Server stopped", err)
+		}
+	case <-ctx.Done():
+		stop()
+		shutdown(httpServer, healthChecker, tracker, subscriptionManager, driver, cfg.Server.ShutdownReadinessDelay, cfg.Server.ShutdownTimeout)
 	}
-	url := os.Getenv("URL")
+}
+
+// shutdown drains the server in dependency order: readiness fails first while requests are still served
+// for readinessDelay, so load balancers stop routing new traffic before it is refused. Then new requests
+// and operations are refused, in-flight ones are given until timeout to finish, subscriptions are
+// completed and finally the Neo4j driver is closed
+func shutdown(httpServer *http.Server, healthChecker *health.Checker, tracker *drain.Tracker, subscriptionManager *subscriptions.SubscriptionManager, driver neo4j.DriverWithContext, readinessDelay time.Duration, timeout time.Duration) {
+	slog.Info("shutdown signal received, draining", slog.Duration("readinessDelay", readinessDelay), slog.Duration("timeout", timeout))
 
-	var websocketUrl string
-	if url == "" {
-		url = "http://localhost" + ":" + port
-		websocketUrl = "ws://localhost" + ":" + port
-	} else {
-		websocketUrl = strings.Replace(url, "https://", "wss://", 1)
+	healthChecker.SetShuttingDown()
+	time.Sleep(readinessDelay)
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	tracker.Close()
+
+	if err := httpServer.Shutdown(ctx); err != nil {
+		slog.Warn("HTTP server did not drain before the deadline", slog.Any("error", err))
 	}
 
-	log.Printf("Connect to %s/graphql for GraphQL Playground", url)
-	log.Printf("GraphQL WebSocket endpoint: %s/query", websocketUrl)
-	log.Printf("Connect to %s/query for GraphQL API", url)
-	log.Printf("Connect to https://console.neo4j.io for Neo4j Browser Console")
-	
-	log.Fatal(http.ListenAndServe(":"+port, nil))
+	// Closing the manager completes every subscription, which sends a complete message to its client
+	subscriptionManager.Close()
 
+	if err := tracker.Wait(ctx); err != nil {
+		slog.Warn("GraphQL operations did not drain before the deadline", slog.Any("error", err))
+	}
+
+	if err := driver.Close(context.Background()); err != nil {
+		slog.Error("Error closing Neo4j driver", slog.Any("error", err))
+	}
+	slog.Info("shutdown complete")
 }
@@ -0,0 +1,105 @@
+package metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// Extension is a gqlgen handler extension that records operation, field and error metrics
+type Extension struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = Extension{}
+
+func (Extension) ExtensionName() string {
+	return "PrometheusMetrics"
+}
+
+func (Extension) Validate(schema graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptResponse is called once per response, so subscriptions are counted once per delivered event
+func (Extension) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	if !graphql.HasOperationContext(ctx) {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	response := next(ctx)
+
+	opCtx := graphql.GetOperationContext(ctx)
+	operationName := operationName(opCtx)
+	operationType := "unknown"
+	if opCtx.Operation != nil {
+		operationType = string(opCtx.Operation.Operation)
+	}
+
+	status := "success"
+	if response != nil && len(response.Errors) > 0 {
+		status = "error"
+		GraphQLErrors.WithLabelValues(operationName).Add(float64(len(response.Errors)))
+	}
+	GraphQLOperations.WithLabelValues(operationName, operationType, status).Inc()
+	GraphQLOperationDuration.WithLabelValues(operationName, operationType).Observe(time.Since(start).Seconds())
+
+	return response
+}
+
+// InterceptField only records fields backed by a resolver to keep label cardinality bounded
+func (Extension) InterceptField(ctx context.Context, next graphql.Resolver) (any, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	res, err := next(ctx)
+
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+	GraphQLFields.WithLabelValues(fc.Object, fc.Field.Name, status).Inc()
+	GraphQLFieldDuration.WithLabelValues(fc.Object, fc.Field.Name).Observe(time.Since(start).Seconds())
+
+	return res, err
+}
+
+// maxOperationNames caps the operation names used as label values, operation names come from clients
+const maxOperationNames = 100
+
+var operationNames = struct {
+	sync.Mutex
+	seen map[string]bool
+}{seen: map[string]bool{}}
+
+// operationName returns the operation name of opCtx, or "other" once maxOperationNames other names have been seen
+func operationName(opCtx *graphql.OperationContext) string {
+	name := "anonymous"
+	if opCtx.OperationName != "" {
+		name = opCtx.OperationName
+	} else if opCtx.Operation != nil && opCtx.Operation.Name != "" {
+		name = opCtx.Operation.Name
+	}
+	return boundedOperationName(name)
+}
+
+func boundedOperationName(name string) string {
+	operationNames.Lock()
+	defer operationNames.Unlock()
+	if operationNames.seen[name] {
+		return name
+	}
+	if len(operationNames.seen) >= maxOperationNames {
+		return "other"
+	}
+	operationNames.seen[name] = true
+	return name
+}
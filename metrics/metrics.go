@@ -0,0 +1,113 @@
+package metrics
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "neo"
+
+var (
+	GraphQLOperations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "graphql",
+		Name:      "operations_total",
+		Help:      "Number of GraphQL operations handled, by operation name, operation type and status.",
+	}, []string{"operation", "type", "status"})
+
+	GraphQLOperationDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "graphql",
+		Name:      "operation_duration_seconds",
+		Help:      "Latency of GraphQL operations, by operation name and operation type.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "type"})
+
+	GraphQLFields = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "graphql",
+		Name:      "field_resolutions_total",
+		Help:      "Number of resolver field resolutions, by parent object, field and status.",
+	}, []string{"object", "field", "status"})
+
+	GraphQLFieldDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "graphql",
+		Name:      "field_duration_seconds",
+		Help:      "Latency of resolver field resolutions, by parent object and field.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"object", "field"})
+
+	GraphQLErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "graphql",
+		Name:      "errors_total",
+		Help:      "Number of errors returned in GraphQL responses, by operation name.",
+	}, []string{"operation"})
+
+	WebsocketConnections = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "websocket",
+		Name:      "connections_active",
+		Help:      "Number of open GraphQL websocket connections.",
+	})
+
+	SubscriptionsActive = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "subscriptions",
+		Name:      "active",
+		Help:      "Number of active subscribers on the subscription manager, by event type.",
+	}, []string{"event"})
+
+	SubscriptionEventsPublished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "subscriptions",
+		Name:      "events_published_total",
+		Help:      "Number of events delivered to subscribers, by event type.",
+	}, []string{"event"})
+
+	SubscriptionEventsDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "subscriptions",
+		Name:      "events_dropped_total",
+		Help:      "Number of events dropped because a subscriber's channel was full, by event type.",
+	}, []string{"event"})
+
+	CacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "requests_total",
+		Help:      "Number of cache lookups, by cache and result (hit or miss).",
+	}, []string{"cache", "result"})
+
+	Neo4jQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "neo4j",
+		Name:      "query_duration_seconds",
+		Help:      "Latency of Neo4jDatabase methods, by method name.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+)
+
+// Handler returns the HTTP handler serving the /metrics endpoint
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveCacheLookup records a hit or a miss for the named cache
+func ObserveCacheLookup(cache string, hit bool) {
+	result := "miss"
+	if hit {
+		result = "hit"
+	}
+	CacheRequests.WithLabelValues(cache, result).Inc()
+}
+
+// ObserveNeo4jQuery records the time elapsed since start for the given Neo4jDatabase method
+func ObserveNeo4jQuery(method string, start time.Time) {
+	Neo4jQueryDuration.WithLabelValues(method).Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,165 @@
+// Package migrations applies versioned, reversible schema migrations and records them in a
+// _MIGRATION ledger in Neo4j.
+//
+// A migration is a pair of files in the migrations directory, migrations/files by default, named
+//
+//	<version>_<name>.up.<cypher|graphql>
+//	<version>_<name>.down.<cypher|graphql>
+//
+// where version is a positive integer, for example a sequence number or a timestamp. Cypher files
+// hold statements separated by semicolons. GraphQL files hold one or more mutation operations which
+// are run in order against this server's schema, a mutation answering success: false fails the
+// migration. The down file is optional but a migration without one cannot be rolled back.
+package migrations
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type Kind string
+
+const (
+	KindCypher  Kind = "cypher"
+	KindGraphQL Kind = "graphql"
+)
+
+type Migration struct {
+	Version  int64
+	Name     string
+	Kind     Kind
+	Up       string
+	Down     string
+	Checksum string
+}
+
+var fileNamePattern = regexp.MustCompile(`^(\d+)_([A-Za-z0-9_\-]+)\.(up|down)\.(cypher|graphql)$`)
+
+// Load reads the migrations in dir ordered by version
+func Load(dir string) ([]*Migration, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read migrations directory: %w", err)
+	}
+
+	byVersion := map[int64]*Migration{}
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		match := fileNamePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			return nil, fmt.Errorf("unexpected file %s in migrations directory, expected <version>_<name>.<up|down>.<cypher|graphql>", entry.Name())
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || version < 1 {
+			return nil, fmt.Errorf("invalid version in migration file %s", entry.Name())
+		}
+		name, direction, kind := match[2], match[3], Kind(match[4])
+
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read migration file %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: name, Kind: kind}
+			byVersion[version] = migration
+		}
+		if migration.Name != name || migration.Kind != kind {
+			return nil, fmt.Errorf("migration %d has files with different names or kinds", version)
+		}
+		if direction == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if strings.TrimSpace(migration.Up) == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up step", migration.Version, migration.Name)
+		}
+		checksum := sha256.Sum256([]byte(migration.Up))
+		migration.Checksum = hex.EncodeToString(checksum[:])
+		migrations = append(migrations, migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// SplitCypher splits a script into statements on the semicolons outside of strings, quoted
+// identifiers and comments
+func SplitCypher(script string) []string {
+	statements := []string{}
+	var current strings.Builder
+	var quote rune
+	lineComment, blockComment := false, false
+
+	runes := []rune(script)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		next := rune(0)
+		if i+1 < len(runes) {
+			next = runes[i+1]
+		}
+
+		switch {
+		case lineComment:
+			if r == '\n' {
+				lineComment = false
+				current.WriteRune(r)
+			}
+			continue
+		case blockComment:
+			if r == '*' && next == '/' {
+				blockComment = false
+				i++
+			}
+			continue
+		case quote != 0:
+			current.WriteRune(r)
+			if r == '\\' && quote != '`' && next != 0 {
+				current.WriteRune(next)
+				i++
+			} else if r == quote {
+				quote = 0
+			}
+			continue
+		}
+
+		switch {
+		case r == '/' && next == '/':
+			lineComment = true
+			i++
+		case r == '/' && next == '*':
+			blockComment = true
+			i++
+		case r == '\'' || r == '"' || r == '`':
+			quote = r
+			current.WriteRune(r)
+		case r == ';':
+			if statement := strings.TrimSpace(current.String()); statement != "" {
+				statements = append(statements, statement)
+			}
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if statement := strings.TrimSpace(current.String()); statement != "" {
+		statements = append(statements, statement)
+	}
+	return statements
+}
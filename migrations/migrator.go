@@ -0,0 +1,304 @@
+package migrations
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/mike-jacks/neo/db"
+	"github.com/mike-jacks/neo/utils"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// Store keeps the ledger and the lock, it is implemented by db.Neo4jDatabase
+type Store interface {
+	AcquireMigrationLock(ctx context.Context, owner string, ttl time.Duration) (string, error)
+	ReleaseMigrationLock(ctx context.Context, owner string) error
+	GetMigrationRecords(ctx context.Context) ([]*db.MigrationRecord, error)
+	AddMigrationRecord(ctx context.Context, record *db.MigrationRecord) error
+	RemoveMigrationRecord(ctx context.Context, version int64) error
+	RunMigrationStatement(ctx context.Context, statement string) error
+}
+
+// GraphQLExecutor runs a single named operation of document against the server schema and returns
+// the response data
+type GraphQLExecutor func(ctx context.Context, document string, operationName string) (json.RawMessage, error)
+
+type State string
+
+const (
+	StatePending State = "pending"
+	StateApplied State = "applied"
+	// StateModified is an applied migration whose up file changed since it was applied
+	StateModified State = "modified"
+	// StateMissing is an applied migration whose files are no longer in the migrations directory
+	StateMissing State = "missing"
+)
+
+type Status struct {
+	Version   int64
+	Name      string
+	State     State
+	AppliedAt *time.Time
+}
+
+var ErrLocked = errors.New("migrations are locked by another instance")
+
+type Migrator struct {
+	store      Store
+	graphql    GraphQLExecutor
+	migrations []*Migration
+	owner      string
+	lockTTL    time.Duration
+}
+
+// NewMigrator creates a Migrator for migrations, holding the lock for at most lockTTL so a crashed
+// instance cannot block migrations forever
+func NewMigrator(store Store, graphql GraphQLExecutor, migrations []*Migration, lockTTL time.Duration) *Migrator {
+	hostname, _ := os.Hostname()
+	return &Migrator{
+		store:      store,
+		graphql:    graphql,
+		migrations: migrations,
+		owner:      fmt.Sprintf("%s/%d/%s", hostname, os.Getpid(), utils.GenerateId()),
+		lockTTL:    lockTTL,
+	}
+}
+
+// Status reports every known migration, including applied ones whose files are gone
+func (m *Migrator) Status(ctx context.Context) ([]*Status, error) {
+	records, err := m.store.GetMigrationRecords(ctx)
+	if err != nil {
+		return nil, err
+	}
+	applied := map[int64]*db.MigrationRecord{}
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+
+	statuses := []*Status{}
+	known := map[int64]bool{}
+	for _, migration := range m.migrations {
+		known[migration.Version] = true
+		status := &Status{Version: migration.Version, Name: migration.Name, State: StatePending}
+		if record, ok := applied[migration.Version]; ok {
+			appliedAt := record.AppliedAt
+			status.AppliedAt = &appliedAt
+			status.State = StateApplied
+			if record.Checksum != migration.Checksum {
+				status.State = StateModified
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	for _, record := range records {
+		if !known[record.Version] {
+			appliedAt := record.AppliedAt
+			statuses = append(statuses, &Status{Version: record.Version, Name: record.Name, State: StateMissing, AppliedAt: &appliedAt})
+		}
+	}
+	return statuses, nil
+}
+
+// Apply applies pending migrations in version order up to and including target, or all of them
+// when target is 0. It returns the migrations applied before an error stopped it.
+func (m *Migrator) Apply(ctx context.Context, target int64) ([]*Migration, error) {
+	applied := []*Migration{}
+	err := m.withLock(ctx, func(ctx context.Context) error {
+		records, err := m.store.GetMigrationRecords(ctx)
+		if err != nil {
+			return err
+		}
+		done := map[int64]bool{}
+		for _, record := range records {
+			done[record.Version] = true
+		}
+
+		for _, migration := range m.migrations {
+			if target != 0 && migration.Version > target {
+				break
+			}
+			if done[migration.Version] {
+				continue
+			}
+			if err := m.renewLock(ctx); err != nil {
+				return err
+			}
+			slog.Info("applying migration", slog.Int64("version", migration.Version), slog.String("name", migration.Name))
+			if err := m.run(ctx, migration.Kind, migration.Up); err != nil {
+				return fmt.Errorf("migration %d_%s failed: %w", migration.Version, migration.Name, err)
+			}
+			record := &db.MigrationRecord{Version: migration.Version, Name: migration.Name, Checksum: migration.Checksum}
+			if err := m.store.AddMigrationRecord(ctx, record); err != nil {
+				return err
+			}
+			applied = append(applied, migration)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Rollback runs the down step of the last steps applied migrations, newest first. It returns the
+// migrations rolled back before an error stopped it.
+func (m *Migrator) Rollback(ctx context.Context, steps int) ([]*Migration, error) {
+	rolledBack := []*Migration{}
+	err := m.withLock(ctx, func(ctx context.Context) error {
+		records, err := m.store.GetMigrationRecords(ctx)
+		if err != nil {
+			return err
+		}
+		byVersion := map[int64]*Migration{}
+		for _, migration := range m.migrations {
+			byVersion[migration.Version] = migration
+		}
+
+		for i := len(records) - 1; i >= 0 && len(rolledBack) < steps; i-- {
+			record := records[i]
+			migration, ok := byVersion[record.Version]
+			if !ok {
+				return fmt.Errorf("migration %d_%s is applied but its files are missing", record.Version, record.Name)
+			}
+			if migration.Down == "" {
+				return fmt.Errorf("migration %d_%s has no down step", migration.Version, migration.Name)
+			}
+			if err := m.renewLock(ctx); err != nil {
+				return err
+			}
+			slog.Info("rolling back migration", slog.Int64("version", migration.Version), slog.String("name", migration.Name))
+			if err := m.run(ctx, migration.Kind, migration.Down); err != nil {
+				return fmt.Errorf("rollback of migration %d_%s failed: %w", migration.Version, migration.Name, err)
+			}
+			if err := m.store.RemoveMigrationRecord(ctx, migration.Version); err != nil {
+				return err
+			}
+			rolledBack = append(rolledBack, migration)
+		}
+		return nil
+	})
+	return rolledBack, err
+}
+
+// withLock runs fn holding the migration lock. The lock is renewed every third of lockTTL while fn runs, so a
+// migration running longer than lockTTL keeps it. If a renewal finds another owner holds the lock, the context
+// of fn is cancelled and withLock returns ErrLocked. Apply and Rollback also renew it before every migration, so
+// they never start one without holding it.
+func (m *Migrator) withLock(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := m.renewLock(ctx); err != nil {
+		return err
+	}
+	defer func() {
+		if err := m.store.ReleaseMigrationLock(context.Background(), m.owner); err != nil {
+			slog.Warn("unable to release the migration lock", slog.Any("error", err))
+		}
+	}()
+
+	lockCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	stop := make(chan struct{})
+	var renewals sync.WaitGroup
+	renewals.Add(1)
+	go func() {
+		defer renewals.Done()
+		ticker := time.NewTicker(m.lockTTL / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				err := m.renewLock(lockCtx)
+				if errors.Is(err, ErrLocked) {
+					slog.Error("lost the migration lock", slog.Any("error", err))
+					cancel(err)
+					return
+				}
+				// A failed renewal is retried on the next tick, the lock is still held until it expires
+				if err != nil {
+					slog.Warn("unable to renew the migration lock", slog.Any("error", err))
+				}
+			}
+		}
+	}()
+
+	err := fn(lockCtx)
+	close(stop)
+	renewals.Wait()
+	if lost := context.Cause(lockCtx); lost != nil && ctx.Err() == nil {
+		return lost
+	}
+	return err
+}
+
+// renewLock takes the migration lock, or extends it when owner already holds it, for another lockTTL
+func (m *Migrator) renewLock(ctx context.Context) error {
+	holder, err := m.store.AcquireMigrationLock(ctx, m.owner, m.lockTTL)
+	if err != nil {
+		return fmt.Errorf("unable to acquire the migration lock: %w", err)
+	}
+	if holder != m.owner {
+		return fmt.Errorf("%w (%s)", ErrLocked, holder)
+	}
+	return nil
+}
+
+func (m *Migrator) run(ctx context.Context, kind Kind, script string) error {
+	switch kind {
+	case KindCypher:
+		for _, statement := range SplitCypher(script) {
+			if err := m.store.RunMigrationStatement(ctx, statement); err != nil {
+				return err
+			}
+		}
+		return nil
+	case KindGraphQL:
+		return m.runGraphQL(ctx, script)
+	}
+	return fmt.Errorf("unknown migration kind %s", kind)
+}
+
+// runGraphQL runs every operation of document in order. Mutations report failures as success: false
+// rather than errors, so every top level field is checked too.
+func (m *Migrator) runGraphQL(ctx context.Context, document string) error {
+	if m.graphql == nil {
+		return fmt.Errorf("GraphQL migrations are not supported here")
+	}
+	parsed, err := parser.ParseQuery(&ast.Source{Input: document})
+	if err != nil {
+		return err
+	}
+
+	for _, operation := range parsed.Operations {
+		data, err := m.graphql(ctx, document, operation.Name)
+		if err != nil {
+			return err
+		}
+		fields := map[string]json.RawMessage{}
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return fmt.Errorf("unexpected GraphQL response: %w", err)
+		}
+		for field, value := range fields {
+			var response struct {
+				Success *bool   `json:"success"`
+				Message *string `json:"message"`
+			}
+			if json.Unmarshal(value, &response) != nil {
+				continue
+			}
+			if response.Success != nil && !*response.Success {
+				message := ""
+				if response.Message != nil {
+					message = *response.Message
+				}
+				return fmt.Errorf("%s was not successful: %s", field, message)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,347 @@
+package migrations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/mike-jacks/neo/db"
+)
+
+// fakeStore keeps the ledger and the lock in memory. A statement starting with "WAIT" blocks until it is
+// released through waiting, for migrations that outlive the lock TTL.
+type fakeStore struct {
+	mu         sync.Mutex
+	owner      string
+	expiresAt  time.Time
+	records    map[int64]*db.MigrationRecord
+	statements []string
+	failOn     string
+	waiting    chan struct{}
+	released   chan struct{}
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{records: map[int64]*db.MigrationRecord{}}
+}
+
+func (s *fakeStore) AcquireMigrationLock(ctx context.Context, owner string, ttl time.Duration) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	if s.owner == "" || s.owner == owner || s.expiresAt.Before(now) {
+		s.owner, s.expiresAt = owner, now.Add(ttl)
+	}
+	return s.owner, nil
+}
+
+func (s *fakeStore) ReleaseMigrationLock(ctx context.Context, owner string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.owner == owner {
+		s.owner = ""
+	}
+	return nil
+}
+
+func (s *fakeStore) GetMigrationRecords(ctx context.Context) ([]*db.MigrationRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	records := []*db.MigrationRecord{}
+	for _, record := range s.records {
+		records = append(records, record)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Version < records[j].Version })
+	return records, nil
+}
+
+func (s *fakeStore) AddMigrationRecord(ctx context.Context, record *db.MigrationRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.records[record.Version]; ok {
+		return fmt.Errorf("migration %d is already recorded", record.Version)
+	}
+	record.AppliedAt = time.Now()
+	s.records[record.Version] = record
+	return nil
+}
+
+func (s *fakeStore) RemoveMigrationRecord(ctx context.Context, version int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.records, version)
+	return nil
+}
+
+func (s *fakeStore) RunMigrationStatement(ctx context.Context, statement string) error {
+	if strings.HasPrefix(statement, "WAIT") {
+		close(s.waiting)
+		select {
+		case <-s.released:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.failOn != "" && statement == s.failOn {
+		return fmt.Errorf("statement %s failed", statement)
+	}
+	s.statements = append(s.statements, statement)
+	return nil
+}
+
+func (s *fakeStore) ran() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string{}, s.statements...)
+}
+
+func migration(version int64, name string) *Migration {
+	return &Migration{
+		Version:  version,
+		Name:     name,
+		Kind:     KindCypher,
+		Up:       fmt.Sprintf("UP %d;", version),
+		Down:     fmt.Sprintf("DOWN %d;", version),
+		Checksum: fmt.Sprintf("checksum %d", version),
+	}
+}
+
+func versions(migrations []*Migration) []int64 {
+	result := []int64{}
+	for _, migration := range migrations {
+		result = append(result, migration.Version)
+	}
+	return result
+}
+
+func assertEqual[T comparable](t *testing.T, what string, got []T, want []T) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Errorf("got %s %v, want %v", what, got, want)
+		return
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("got %s %v, want %v", what, got, want)
+			return
+		}
+	}
+}
+
+func TestApplyRunsPendingMigrationsInOrder(t *testing.T) {
+	store := newFakeStore()
+	store.records[2] = &db.MigrationRecord{Version: 2, Name: "two", Checksum: "checksum 2"}
+	migrator := NewMigrator(store, nil, []*Migration{migration(1, "one"), migration(2, "two"), migration(3, "three")}, time.Minute)
+
+	applied, err := migrator.Apply(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	assertEqual(t, "applied versions", versions(applied), []int64{1, 3})
+	assertEqual(t, "statements", store.ran(), []string{"UP 1", "UP 3"})
+	if len(store.records) != 3 {
+		t.Errorf("got %d ledger records, want 3", len(store.records))
+	}
+	if store.owner != "" {
+		t.Errorf("lock still held by %s after Apply", store.owner)
+	}
+}
+
+func TestApplyStopsAtTarget(t *testing.T) {
+	store := newFakeStore()
+	migrator := NewMigrator(store, nil, []*Migration{migration(1, "one"), migration(2, "two"), migration(3, "three")}, time.Minute)
+
+	applied, err := migrator.Apply(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	assertEqual(t, "applied versions", versions(applied), []int64{1, 2})
+
+	applied, err = migrator.Apply(context.Background(), 0)
+	if err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	assertEqual(t, "applied versions", versions(applied), []int64{3})
+}
+
+func TestApplyStopsAtAFailingMigration(t *testing.T) {
+	store := newFakeStore()
+	store.failOn = "UP 2"
+	migrator := NewMigrator(store, nil, []*Migration{migration(1, "one"), migration(2, "two"), migration(3, "three")}, time.Minute)
+
+	applied, err := migrator.Apply(context.Background(), 0)
+	if err == nil || !strings.Contains(err.Error(), "migration 2_two failed") {
+		t.Fatalf("got error %v, want migration 2_two to fail", err)
+	}
+	assertEqual(t, "applied versions", versions(applied), []int64{1})
+	if _, ok := store.records[2]; ok {
+		t.Error("failed migration 2 was recorded")
+	}
+}
+
+func TestRollbackRunsDownStepsNewestFirst(t *testing.T) {
+	store := newFakeStore()
+	migrator := NewMigrator(store, nil, []*Migration{migration(1, "one"), migration(2, "two"), migration(3, "three")}, time.Minute)
+	if _, err := migrator.Apply(context.Background(), 0); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	rolledBack, err := migrator.Rollback(context.Background(), 2)
+	if err != nil {
+		t.Fatalf("Rollback failed: %v", err)
+	}
+	assertEqual(t, "rolled back versions", versions(rolledBack), []int64{3, 2})
+	assertEqual(t, "statements", store.ran(), []string{"UP 1", "UP 2", "UP 3", "DOWN 3", "DOWN 2"})
+	if _, ok := store.records[1]; !ok || len(store.records) != 1 {
+		t.Errorf("got ledger %v, want only migration 1", store.records)
+	}
+}
+
+func TestRollbackRefusesMigrationsWithoutDownStep(t *testing.T) {
+	store := newFakeStore()
+	irreversible := migration(2, "two")
+	irreversible.Down = ""
+	migrator := NewMigrator(store, nil, []*Migration{migration(1, "one"), irreversible}, time.Minute)
+	if _, err := migrator.Apply(context.Background(), 0); err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+
+	rolledBack, err := migrator.Rollback(context.Background(), 1)
+	if err == nil || !strings.Contains(err.Error(), "has no down step") {
+		t.Fatalf("got error %v, want no down step", err)
+	}
+	if len(rolledBack) != 0 || len(store.records) != 2 {
+		t.Errorf("rolled back %v, leaving %d records, want nothing rolled back", versions(rolledBack), len(store.records))
+	}
+}
+
+func TestStatusReportsModifiedAndMissingMigrations(t *testing.T) {
+	store := newFakeStore()
+	store.records[1] = &db.MigrationRecord{Version: 1, Name: "one", Checksum: "checksum 1"}
+	store.records[2] = &db.MigrationRecord{Version: 2, Name: "two", Checksum: "checksum before the edit"}
+	store.records[4] = &db.MigrationRecord{Version: 4, Name: "four", Checksum: "checksum 4"}
+	migrator := NewMigrator(store, nil, []*Migration{migration(1, "one"), migration(2, "two"), migration(3, "three")}, time.Minute)
+
+	statuses, err := migrator.Status(context.Background())
+	if err != nil {
+		t.Fatalf("Status failed: %v", err)
+	}
+	states := map[int64]State{}
+	for _, status := range statuses {
+		states[status.Version] = status.State
+	}
+	want := map[int64]State{1: StateApplied, 2: StateModified, 3: StatePending, 4: StateMissing}
+	for version, state := range want {
+		if states[version] != state {
+			t.Errorf("migration %d is %s, want %s", version, states[version], state)
+		}
+	}
+}
+
+func TestApplyFailsWithErrLockedWhileAnotherInstanceHoldsTheLock(t *testing.T) {
+	store := newFakeStore()
+	store.owner, store.expiresAt = "another instance", time.Now().Add(time.Minute)
+	migrator := NewMigrator(store, nil, []*Migration{migration(1, "one")}, time.Minute)
+
+	applied, err := migrator.Apply(context.Background(), 0)
+	if !errors.Is(err, ErrLocked) {
+		t.Fatalf("got error %v, want ErrLocked", err)
+	}
+	if len(applied) != 0 || len(store.ran()) != 0 {
+		t.Errorf("ran %v while locked", store.ran())
+	}
+	if _, err := migrator.Rollback(context.Background(), 1); !errors.Is(err, ErrLocked) {
+		t.Errorf("got error %v from Rollback, want ErrLocked", err)
+	}
+	if store.owner != "another instance" {
+		t.Errorf("lock taken from its holder by %s", store.owner)
+	}
+}
+
+func TestApplyRenewsTheLockDuringLongMigrations(t *testing.T) {
+	const ttl = 30 * time.Millisecond
+	store := newFakeStore()
+	store.waiting, store.released = make(chan struct{}), make(chan struct{})
+	slow := migration(1, "slow")
+	slow.Up = "WAIT;"
+	migrator := NewMigrator(store, nil, []*Migration{slow, migration(2, "two")}, ttl)
+
+	done := make(chan error)
+	go func() {
+		_, err := migrator.Apply(context.Background(), 0)
+		done <- err
+	}()
+
+	<-store.waiting
+	time.Sleep(4 * ttl)
+	other := NewMigrator(store, nil, []*Migration{slow, migration(2, "two")}, ttl)
+	if _, err := other.Apply(context.Background(), 0); !errors.Is(err, ErrLocked) {
+		t.Errorf("got error %v from a second instance, want ErrLocked while the first one migrates", err)
+	}
+	close(store.released)
+
+	if err := <-done; err != nil {
+		t.Fatalf("Apply failed: %v", err)
+	}
+	assertEqual(t, "statements", store.ran(), []string{"WAIT", "UP 2"})
+}
+
+func TestApplyStopsWhenTheLockIsLost(t *testing.T) {
+	const ttl = 30 * time.Millisecond
+	store := newFakeStore()
+	store.waiting, store.released = make(chan struct{}), make(chan struct{})
+	slow := migration(1, "slow")
+	slow.Up = "WAIT;"
+	migrator := NewMigrator(store, nil, []*Migration{slow, migration(2, "two")}, ttl)
+
+	done := make(chan error)
+	go func() {
+		_, err := migrator.Apply(context.Background(), 0)
+		done <- err
+	}()
+
+	<-store.waiting
+	store.mu.Lock()
+	store.owner, store.expiresAt = "another instance", time.Now().Add(time.Minute)
+	store.mu.Unlock()
+
+	if err := <-done; !errors.Is(err, ErrLocked) {
+		t.Fatalf("got error %v, want ErrLocked", err)
+	}
+	if ran := store.ran(); len(ran) != 0 {
+		t.Errorf("ran %v after losing the lock", ran)
+	}
+	if store.owner != "another instance" {
+		t.Errorf("lock released from its new holder, now held by %q", store.owner)
+	}
+}
+
+func TestConcurrentApplyRunsMigrationsOnce(t *testing.T) {
+	store := newFakeStore()
+	start := make(chan struct{})
+	errs := make(chan error)
+	for i := 0; i < 8; i++ {
+		migrator := NewMigrator(store, nil, []*Migration{migration(1, "one"), migration(2, "two")}, time.Minute)
+		go func() {
+			<-start
+			_, err := migrator.Apply(context.Background(), 0)
+			errs <- err
+		}()
+	}
+	close(start)
+
+	// Every instance either applies the migrations, finds none pending or finds them locked
+	for i := 0; i < 8; i++ {
+		if err := <-errs; err != nil && !errors.Is(err, ErrLocked) {
+			t.Errorf("got error %v, want ErrLocked or none", err)
+		}
+	}
+	assertEqual(t, "statements", store.ran(), []string{"UP 1", "UP 2"})
+}
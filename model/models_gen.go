@@ -12,12 +12,51 @@ type ObjectNodeOrRelationshipNode interface {
 	IsObjectNodeOrRelationshipNode()
 }
 
+type CardinalityReportResponse struct {
+	Success    bool                    `json:"success"`
+	Message    *string                 `json:"message,omitempty"`
+	Violations []*CardinalityViolation `json:"violations,omitempty"`
+}
+
+// An object node with too few or too many object relationships of a relationship schema
+type CardinalityViolation struct {
+	RelationshipSchemaNodeID string           `json:"relationshipSchemaNodeId"`
+	Relationship             string           `json:"relationship"`
+	ObjectNodeID             string           `json:"objectNodeId"`
+	Side                     RelationshipSide `json:"side"`
+	Count                    int              `json:"count"`
+	Message                  string           `json:"message"`
+}
+
+// A read-only property object nodes of the type get on read, the value of expression evaluated against their
+// properties and object relationship counts, such as firstName + " " + lastName or outgoing("DEPENDS_ON")
+type ComputedProperty struct {
+	Key        string `json:"key"`
+	Expression string `json:"expression"`
+}
+
 type DeleteObjectNodeInput struct {
 	Domain string `json:"domain"`
 	Name   string `json:"name"`
 	Type   string `json:"type"`
 }
 
+type DomainDependenciesResponse struct {
+	Success      bool                `json:"success"`
+	Message      *string             `json:"message,omitempty"`
+	Dependencies []*DomainDependency `json:"dependencies,omitempty"`
+}
+
+// Object relationships of one name connecting a domain to another domain, and the relationship schema node describing them
+type DomainDependency struct {
+	Direction    DependencyDirection `json:"direction"`
+	Domain       string              `json:"domain"`
+	Relationship string              `json:"relationship"`
+	// Null for object relationships no cross-domain relationship schema node describes
+	RelationshipSchemaNodeID *string `json:"relationshipSchemaNodeId,omitempty"`
+	ObjectRelationshipCount  int     `json:"objectRelationshipCount"`
+}
+
 type DomainSchemaNode struct {
 	ID         string      `json:"id"`
 	Domain     string      `json:"domain"`
@@ -39,6 +78,22 @@ type DomainSchemaNodesResponse struct {
 	DomainSchemaNodes []*DomainSchemaNode `json:"domainSchemaNodes,omitempty"`
 }
 
+type Index struct {
+	Name             string   `json:"name"`
+	Type             string   `json:"type"`
+	EntityType       string   `json:"entityType"`
+	LabelsOrTypes    []string `json:"labelsOrTypes,omitempty"`
+	Properties       []string `json:"properties,omitempty"`
+	State            string   `json:"state"`
+	OwningConstraint *string  `json:"owningConstraint,omitempty"`
+}
+
+type IndexesResponse struct {
+	Success bool     `json:"success"`
+	Message *string  `json:"message,omitempty"`
+	Indexes []*Index `json:"indexes,omitempty"`
+}
+
 type Mutation struct {
 }
 
@@ -122,31 +177,109 @@ type ObjectRelationshipsResponse struct {
 	ObjectRelationships []*ObjectRelationship `json:"objectRelationships,omitempty"`
 }
 
+// Either latitude and longitude, a WGS-84 point, or x and y, a Cartesian point
+type PointInput struct {
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
+	X         *float64 `json:"x,omitempty"`
+	Y         *float64 `json:"y,omitempty"`
+}
+
 type Property struct {
 	Key   string       `json:"key"`
-	Value any          `json:"value"`
+	Value interface{}  `json:"value"`
 	Type  PropertyType `json:"type"`
+	// Computed properties are read-only, they cannot be written on object nodes
+	ReadOnly bool `json:"readOnly"`
+}
+
+// The constraints object node values of a property are checked against when written. Bounds and lengths are
+// inclusive, lengths count the characters of a STRING and the elements of an array, and allowed values, bounds
+// and patterns apply to every element of an array.
+type PropertyConstraint struct {
+	Key           string        `json:"key"`
+	AllowedValues []interface{} `json:"allowedValues,omitempty"`
+	// Bounds of INTEGER and FLOAT values
+	Min       *float64 `json:"min,omitempty"`
+	Max       *float64 `json:"max,omitempty"`
+	MinLength *int     `json:"minLength,omitempty"`
+	MaxLength *int     `json:"maxLength,omitempty"`
+	// A regular expression STRING values must match in full
+	Pattern *string `json:"pattern,omitempty"`
+	// No two object nodes of the type in the domain have the same value, backed by a Neo4j constraint
+	Unique bool `json:"unique"`
+	// The value of the property on object nodes created without it
+	DefaultValue interface{} `json:"defaultValue,omitempty"`
+}
+
+type PropertyConstraintInput struct {
+	AllowedValues []interface{} `json:"allowedValues,omitempty"`
+	Min           *float64      `json:"min,omitempty"`
+	Max           *float64      `json:"max,omitempty"`
+	MinLength     *int          `json:"minLength,omitempty"`
+	MaxLength     *int          `json:"maxLength,omitempty"`
+	Pattern       *string       `json:"pattern,omitempty"`
+	Unique        *bool         `json:"unique,omitempty"`
+	DefaultValue  interface{}   `json:"defaultValue,omitempty"`
+}
+
+// A comparison of a property with a value, the value is read as type so temporal values compare natively.
+// On JSON properties path, such as $.address.city or $.tags[0], selects the value inside the property to compare.
+type PropertyFilter struct {
+	Key      string         `json:"key"`
+	Operator FilterOperator `json:"operator"`
+	Value    interface{}    `json:"value"`
+	Type     PropertyType   `json:"type"`
+	Path     *string        `json:"path,omitempty"`
 }
 
 type PropertyInput struct {
-	Key   string       `json:"key"`
-	Value any          `json:"value"`
+	Key string `json:"key"`
+	// null removes the property
+	Value interface{}  `json:"value,omitempty"`
 	Type  PropertyType `json:"type"`
 }
 
+// The JSON Schema values of a JSON property are validated against
+type PropertyJSONSchema struct {
+	Key    string `json:"key"`
+	Schema string `json:"schema"`
+}
+
+type PropertySort struct {
+	Key       string         `json:"key"`
+	Direction *SortDirection `json:"direction,omitempty"`
+}
+
 type Query struct {
 }
 
+// The cardinality of the object relationships of a relationship schema. Required sides need at least one
+// relationship per object node, which deleting the last one cannot break, but object nodes created without
+// one are only reported by relationshipCardinalityReport.
+type RelationshipCardinality struct {
+	Cardinality  Cardinality `json:"cardinality"`
+	FromRequired bool        `json:"fromRequired"`
+	ToRequired   bool        `json:"toRequired"`
+}
+
+type RelationshipCardinalityInput struct {
+	Cardinality  Cardinality `json:"cardinality"`
+	FromRequired *bool       `json:"fromRequired,omitempty"`
+	ToRequired   *bool       `json:"toRequired,omitempty"`
+}
+
 type RelationshipSchemaNode struct {
-	ID                   string      `json:"id"`
-	Domain               string      `json:"domain"`
-	Name                 string      `json:"name"`
-	OriginalName         string      `json:"originalName"`
-	Type                 string      `json:"type"`
-	FromTypeSchemaNodeID string      `json:"fromTypeSchemaNodeId"`
-	ToTypeSchemaNodeID   string      `json:"toTypeSchemaNodeId"`
-	Properties           []*Property `json:"properties,omitempty"`
-	Labels               []string    `json:"labels,omitempty"`
+	ID                   string                   `json:"id"`
+	Domain               string                   `json:"domain"`
+	Name                 string                   `json:"name"`
+	OriginalName         string                   `json:"originalName"`
+	Type                 string                   `json:"type"`
+	FromTypeSchemaNodeID string                   `json:"fromTypeSchemaNodeId"`
+	ToTypeSchemaNodeID   string                   `json:"toTypeSchemaNodeId"`
+	Cardinality          *RelationshipCardinality `json:"cardinality,omitempty"`
+	Properties           []*Property              `json:"properties,omitempty"`
+	Labels               []string                 `json:"labels,omitempty"`
 }
 
 type RelationshipSchemaNodeResponse struct {
@@ -167,17 +300,57 @@ type Response struct {
 	Data    []map[string]interface{} `json:"data,omitempty"`
 }
 
+type SchemaChange struct {
+	Action                      SchemaChangeAction        `json:"action"`
+	Kind                        SchemaChangeKind          `json:"kind"`
+	Target                      string                    `json:"target"`
+	Description                 string                    `json:"description"`
+	Compatibility               SchemaChangeCompatibility `json:"compatibility"`
+	AffectedObjectNodes         int                       `json:"affectedObjectNodes"`
+	AffectedObjectRelationships int                       `json:"affectedObjectRelationships"`
+}
+
+type SchemaDiagramResponse struct {
+	Success bool                `json:"success"`
+	Message *string             `json:"message,omitempty"`
+	Format  SchemaDiagramFormat `json:"format"`
+	Diagram *string             `json:"diagram,omitempty"`
+}
+
+type SchemaDiffAgainst struct {
+	Document *string `json:"document,omitempty"`
+	Domain   *string `json:"domain,omitempty"`
+}
+
+type SchemaDiffResponse struct {
+	Success bool            `json:"success"`
+	Message *string         `json:"message,omitempty"`
+	Changes []*SchemaChange `json:"changes,omitempty"`
+}
+
+type SchemaPlanResponse struct {
+	Success bool            `json:"success"`
+	Message *string         `json:"message,omitempty"`
+	DryRun  bool            `json:"dryRun"`
+	Changes []*SchemaChange `json:"changes,omitempty"`
+}
+
 type Subscription struct {
 }
 
 type TypeSchemaNode struct {
-	ID           string      `json:"id"`
-	Domain       string      `json:"domain"`
-	Name         string      `json:"name"`
-	Type         string      `json:"type"`
-	OriginalName string      `json:"originalName"`
-	Labels       []string    `json:"labels,omitempty"`
-	Properties   []*Property `json:"properties,omitempty"`
+	ID                 string                `json:"id"`
+	Domain             string                `json:"domain"`
+	Name               string                `json:"name"`
+	Type               string                `json:"type"`
+	OriginalName       string                `json:"originalName"`
+	Labels             []string              `json:"labels,omitempty"`
+	Properties         []*Property           `json:"properties,omitempty"`
+	JSONSchemas        []*PropertyJSONSchema `json:"jsonSchemas,omitempty"`
+	Constraints        []*PropertyConstraint `json:"constraints,omitempty"`
+	ComputedProperties []*ComputedProperty   `json:"computedProperties,omitempty"`
+	// The type schema node of the same domain this one extends, inheriting its properties and relationship schemas
+	ParentTypeSchemaNodeID *string `json:"parentTypeSchemaNodeId,omitempty"`
 }
 
 type TypeSchemaNodeResponse struct {
@@ -198,31 +371,247 @@ type UpdateObjectNodeInput struct {
 	Properties []*PropertyInput `json:"properties,omitempty"`
 }
 
+// How many object relationships of a relationship schema an object node may have. The left side is the from
+// type, the right side the to type: MANY_TO_ONE lets every from object node have one relationship to a to
+// object node, while a to object node has any number of them.
+type Cardinality string
+
+const (
+	CardinalityOneToOne   Cardinality = "ONE_TO_ONE"
+	CardinalityOneToMany  Cardinality = "ONE_TO_MANY"
+	CardinalityManyToOne  Cardinality = "MANY_TO_ONE"
+	CardinalityManyToMany Cardinality = "MANY_TO_MANY"
+)
+
+var AllCardinality = []Cardinality{
+	CardinalityOneToOne,
+	CardinalityOneToMany,
+	CardinalityManyToOne,
+	CardinalityManyToMany,
+}
+
+func (e Cardinality) IsValid() bool {
+	switch e {
+	case CardinalityOneToOne, CardinalityOneToMany, CardinalityManyToOne, CardinalityManyToMany:
+		return true
+	}
+	return false
+}
+
+func (e Cardinality) String() string {
+	return string(e)
+}
+
+func (e *Cardinality) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Cardinality(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Cardinality", str)
+	}
+	return nil
+}
+
+func (e Cardinality) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// What deleting a domain does to the relationship schema nodes and object relationships connecting it to other
+// domains. Renaming a domain keeps them, they refer to type schema nodes and object nodes by id.
+type CrossDomainRule string
+
+const (
+	// Refuse to delete a domain with external dependencies
+	CrossDomainRuleRestrict CrossDomainRule = "RESTRICT"
+	// Delete the relationship schema nodes and object relationships connecting the domain to other domains with it,
+	// and clear the RELATIONSHIP properties of object nodes of other domains referencing its object nodes
+	CrossDomainRuleCascade CrossDomainRule = "CASCADE"
+)
+
+var AllCrossDomainRule = []CrossDomainRule{
+	CrossDomainRuleRestrict,
+	CrossDomainRuleCascade,
+}
+
+func (e CrossDomainRule) IsValid() bool {
+	switch e {
+	case CrossDomainRuleRestrict, CrossDomainRuleCascade:
+		return true
+	}
+	return false
+}
+
+func (e CrossDomainRule) String() string {
+	return string(e)
+}
+
+func (e *CrossDomainRule) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = CrossDomainRule(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid CrossDomainRule", str)
+	}
+	return nil
+}
+
+func (e CrossDomainRule) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type DependencyDirection string
+
+const (
+	// The domain points at the other domain
+	DependencyDirectionOutgoing DependencyDirection = "OUTGOING"
+	// The other domain points at the domain
+	DependencyDirectionIncoming DependencyDirection = "INCOMING"
+)
+
+var AllDependencyDirection = []DependencyDirection{
+	DependencyDirectionOutgoing,
+	DependencyDirectionIncoming,
+}
+
+func (e DependencyDirection) IsValid() bool {
+	switch e {
+	case DependencyDirectionOutgoing, DependencyDirectionIncoming:
+		return true
+	}
+	return false
+}
+
+func (e DependencyDirection) String() string {
+	return string(e)
+}
+
+func (e *DependencyDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DependencyDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DependencyDirection", str)
+	}
+	return nil
+}
+
+func (e DependencyDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type FilterOperator string
+
+const (
+	FilterOperatorEq  FilterOperator = "EQ"
+	FilterOperatorNeq FilterOperator = "NEQ"
+	FilterOperatorLt  FilterOperator = "LT"
+	FilterOperatorLte FilterOperator = "LTE"
+	FilterOperatorGt  FilterOperator = "GT"
+	FilterOperatorGte FilterOperator = "GTE"
+)
+
+var AllFilterOperator = []FilterOperator{
+	FilterOperatorEq,
+	FilterOperatorNeq,
+	FilterOperatorLt,
+	FilterOperatorLte,
+	FilterOperatorGt,
+	FilterOperatorGte,
+}
+
+func (e FilterOperator) IsValid() bool {
+	switch e {
+	case FilterOperatorEq, FilterOperatorNeq, FilterOperatorLt, FilterOperatorLte, FilterOperatorGt, FilterOperatorGte:
+		return true
+	}
+	return false
+}
+
+func (e FilterOperator) String() string {
+	return string(e)
+}
+
+func (e *FilterOperator) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FilterOperator(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FilterOperator", str)
+	}
+	return nil
+}
+
+func (e FilterOperator) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type PropertyType string
 
 const (
-	PropertyTypeString       PropertyType = "STRING"
-	PropertyTypeNumber       PropertyType = "NUMBER"
-	PropertyTypeBoolean      PropertyType = "BOOLEAN"
-	PropertyTypeArrayString  PropertyType = "ARRAY_STRING"
+	PropertyTypeString PropertyType = "STRING"
+	// Input only, resolves to INTEGER or FLOAT from the value
+	PropertyTypeNumber PropertyType = "NUMBER"
+	// A 64-bit integer
+	PropertyTypeInteger PropertyType = "INTEGER"
+	// A 64-bit float, whole numbers included
+	PropertyTypeFloat       PropertyType = "FLOAT"
+	PropertyTypeBoolean     PropertyType = "BOOLEAN"
+	PropertyTypeArrayString PropertyType = "ARRAY_STRING"
+	// Input only, resolves to ARRAY_INTEGER when every element is an integer and ARRAY_FLOAT otherwise
 	PropertyTypeArrayNumber  PropertyType = "ARRAY_NUMBER"
+	PropertyTypeArrayInteger PropertyType = "ARRAY_INTEGER"
+	PropertyTypeArrayFloat   PropertyType = "ARRAY_FLOAT"
 	PropertyTypeArrayBoolean PropertyType = "ARRAY_BOOLEAN"
 	PropertyTypeRelationship PropertyType = "RELATIONSHIP"
+	// ISO 8601 date, YYYY-MM-DD
+	PropertyTypeDate PropertyType = "DATE"
+	// ISO 8601 date and time with a UTC offset, YYYY-MM-DDThh:mm:ss±hh:mm
+	PropertyTypeDatetime PropertyType = "DATETIME"
+	// ISO 8601 date and time without a time zone, YYYY-MM-DDThh:mm:ss
+	PropertyTypeLocalDatetime PropertyType = "LOCAL_DATETIME"
+	// ISO 8601 duration, P[nY][nM][nW][nD][T[nH][nM][nS]]
+	PropertyTypeDuration PropertyType = "DURATION"
+	// A WGS-84 point, {latitude, longitude}, or a Cartesian point, {x, y}
+	PropertyTypePoint PropertyType = "POINT"
+	// Any JSON value, stored serialized and validated against the JSON Schema of the property on its type schema node, if any
+	PropertyTypeJSON PropertyType = "JSON"
 )
 
 var AllPropertyType = []PropertyType{
 	PropertyTypeString,
 	PropertyTypeNumber,
+	PropertyTypeInteger,
+	PropertyTypeFloat,
 	PropertyTypeBoolean,
 	PropertyTypeArrayString,
 	PropertyTypeArrayNumber,
+	PropertyTypeArrayInteger,
+	PropertyTypeArrayFloat,
 	PropertyTypeArrayBoolean,
 	PropertyTypeRelationship,
+	PropertyTypeDate,
+	PropertyTypeDatetime,
+	PropertyTypeLocalDatetime,
+	PropertyTypeDuration,
+	PropertyTypePoint,
+	PropertyTypeJSON,
 }
 
 func (e PropertyType) IsValid() bool {
 	switch e {
-	case PropertyTypeString, PropertyTypeNumber, PropertyTypeBoolean, PropertyTypeArrayString, PropertyTypeArrayNumber, PropertyTypeArrayBoolean, PropertyTypeRelationship:
+	case PropertyTypeString, PropertyTypeNumber, PropertyTypeInteger, PropertyTypeFloat, PropertyTypeBoolean, PropertyTypeArrayString, PropertyTypeArrayNumber, PropertyTypeArrayInteger, PropertyTypeArrayFloat, PropertyTypeArrayBoolean, PropertyTypeRelationship, PropertyTypeDate, PropertyTypeDatetime, PropertyTypeLocalDatetime, PropertyTypeDuration, PropertyTypePoint, PropertyTypeJSON:
 		return true
 	}
 	return false
@@ -248,3 +637,263 @@ func (e *PropertyType) UnmarshalGQL(v interface{}) error {
 func (e PropertyType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type RelationshipSide string
+
+const (
+	RelationshipSideFrom RelationshipSide = "FROM"
+	RelationshipSideTo   RelationshipSide = "TO"
+)
+
+var AllRelationshipSide = []RelationshipSide{
+	RelationshipSideFrom,
+	RelationshipSideTo,
+}
+
+func (e RelationshipSide) IsValid() bool {
+	switch e {
+	case RelationshipSideFrom, RelationshipSideTo:
+		return true
+	}
+	return false
+}
+
+func (e RelationshipSide) String() string {
+	return string(e)
+}
+
+func (e *RelationshipSide) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RelationshipSide(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RelationshipSide", str)
+	}
+	return nil
+}
+
+func (e RelationshipSide) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SchemaChangeAction string
+
+const (
+	SchemaChangeActionCreate SchemaChangeAction = "CREATE"
+	SchemaChangeActionUpdate SchemaChangeAction = "UPDATE"
+	SchemaChangeActionDelete SchemaChangeAction = "DELETE"
+)
+
+var AllSchemaChangeAction = []SchemaChangeAction{
+	SchemaChangeActionCreate,
+	SchemaChangeActionUpdate,
+	SchemaChangeActionDelete,
+}
+
+func (e SchemaChangeAction) IsValid() bool {
+	switch e {
+	case SchemaChangeActionCreate, SchemaChangeActionUpdate, SchemaChangeActionDelete:
+		return true
+	}
+	return false
+}
+
+func (e SchemaChangeAction) String() string {
+	return string(e)
+}
+
+func (e *SchemaChangeAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SchemaChangeAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SchemaChangeAction", str)
+	}
+	return nil
+}
+
+func (e SchemaChangeAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SchemaChangeCompatibility string
+
+const (
+	SchemaChangeCompatibilityAdditive      SchemaChangeCompatibility = "ADDITIVE"
+	SchemaChangeCompatibilityBreaking      SchemaChangeCompatibility = "BREAKING"
+	SchemaChangeCompatibilityDataMigrating SchemaChangeCompatibility = "DATA_MIGRATING"
+)
+
+var AllSchemaChangeCompatibility = []SchemaChangeCompatibility{
+	SchemaChangeCompatibilityAdditive,
+	SchemaChangeCompatibilityBreaking,
+	SchemaChangeCompatibilityDataMigrating,
+}
+
+func (e SchemaChangeCompatibility) IsValid() bool {
+	switch e {
+	case SchemaChangeCompatibilityAdditive, SchemaChangeCompatibilityBreaking, SchemaChangeCompatibilityDataMigrating:
+		return true
+	}
+	return false
+}
+
+func (e SchemaChangeCompatibility) String() string {
+	return string(e)
+}
+
+func (e *SchemaChangeCompatibility) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SchemaChangeCompatibility(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SchemaChangeCompatibility", str)
+	}
+	return nil
+}
+
+func (e SchemaChangeCompatibility) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SchemaChangeKind string
+
+const (
+	SchemaChangeKindDomain               SchemaChangeKind = "DOMAIN"
+	SchemaChangeKindType                 SchemaChangeKind = "TYPE"
+	SchemaChangeKindTypeProperty         SchemaChangeKind = "TYPE_PROPERTY"
+	SchemaChangeKindRelationship         SchemaChangeKind = "RELATIONSHIP"
+	SchemaChangeKindRelationshipProperty SchemaChangeKind = "RELATIONSHIP_PROPERTY"
+)
+
+var AllSchemaChangeKind = []SchemaChangeKind{
+	SchemaChangeKindDomain,
+	SchemaChangeKindType,
+	SchemaChangeKindTypeProperty,
+	SchemaChangeKindRelationship,
+	SchemaChangeKindRelationshipProperty,
+}
+
+func (e SchemaChangeKind) IsValid() bool {
+	switch e {
+	case SchemaChangeKindDomain, SchemaChangeKindType, SchemaChangeKindTypeProperty, SchemaChangeKindRelationship, SchemaChangeKindRelationshipProperty:
+		return true
+	}
+	return false
+}
+
+func (e SchemaChangeKind) String() string {
+	return string(e)
+}
+
+func (e *SchemaChangeKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SchemaChangeKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SchemaChangeKind", str)
+	}
+	return nil
+}
+
+func (e SchemaChangeKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SchemaDiagramFormat string
+
+const (
+	SchemaDiagramFormatMermaidEr    SchemaDiagramFormat = "MERMAID_ER"
+	SchemaDiagramFormatMermaidClass SchemaDiagramFormat = "MERMAID_CLASS"
+	SchemaDiagramFormatDot          SchemaDiagramFormat = "DOT"
+	SchemaDiagramFormatPlantuml     SchemaDiagramFormat = "PLANTUML"
+)
+
+var AllSchemaDiagramFormat = []SchemaDiagramFormat{
+	SchemaDiagramFormatMermaidEr,
+	SchemaDiagramFormatMermaidClass,
+	SchemaDiagramFormatDot,
+	SchemaDiagramFormatPlantuml,
+}
+
+func (e SchemaDiagramFormat) IsValid() bool {
+	switch e {
+	case SchemaDiagramFormatMermaidEr, SchemaDiagramFormatMermaidClass, SchemaDiagramFormatDot, SchemaDiagramFormatPlantuml:
+		return true
+	}
+	return false
+}
+
+func (e SchemaDiagramFormat) String() string {
+	return string(e)
+}
+
+func (e *SchemaDiagramFormat) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SchemaDiagramFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SchemaDiagramFormat", str)
+	}
+	return nil
+}
+
+func (e SchemaDiagramFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
@@ -1 +1,38 @@
 package resolver
+
+import (
+	"context"
+
+	"github.com/mike-jacks/neo/subscriptions"
+)
+
+// subscribe registers a subscriber for eventType and forwards its events of type T to the returned channel
+// until the client goes away, at which point the subscriber is removed from the manager
+func subscribe[T any](ctx context.Context, manager *subscriptions.SubscriptionManager, eventType subscriptions.EventType) <-chan T {
+	subscriber := manager.Subscribe(eventType)
+	ch := make(chan T)
+	go func() {
+		defer close(ch)
+		defer manager.Unsubscribe(eventType, subscriber.ID)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-subscriber.Events:
+				if !ok {
+					return
+				}
+				response, ok := event.(T)
+				if !ok {
+					continue
+				}
+				select {
+				case ch <- response:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return ch
+}
@@ -14,9 +14,9 @@ type Resolver struct {
 	Subscriptions *subscriptions.SubscriptionManager
 }
 
-func NewResolver(Database db.Database) *Resolver {
+func NewResolver(Database db.Database, Subscriptions *subscriptions.SubscriptionManager) *Resolver {
 	return &Resolver{
 		Database:      Database,
-		Subscriptions: subscriptions.NewSubscriptionManager(),
+		Subscriptions: Subscriptions,
 	}
 }
@@ -2,11 +2,12 @@ package resolver
 
 // This file will be automatically regenerated based on the schema, any resolver implementations
 // will be copied through when generating and any unknown code will be moved to the end.
-// Code generated by github.com/99designs/gqlgen version v0.17.54
+// Code generated by github.com/99designs/gqlgen version v0.17.56
 
 import (
 	"context"
 
+	"github.com/mike-jacks/neo/domainschema"
 	"github.com/mike-jacks/neo/generated"
 	"github.com/mike-jacks/neo/model"
 	"github.com/mike-jacks/neo/subscriptions"
@@ -170,8 +171,12 @@ func (r *mutationResolver) RenameDomainSchemaNode(ctx context.Context, id string
 }
 
 // DeleteDomainSchemaNode is the resolver for the deleteDomainSchemaNode field.
-func (r *mutationResolver) DeleteDomainSchemaNode(ctx context.Context, id string) (*model.DomainSchemaNodeResponse, error) {
-	result, err := r.Database.DeleteDomainSchemaNode(ctx, id)
+func (r *mutationResolver) DeleteDomainSchemaNode(ctx context.Context, id string, crossDomain *model.CrossDomainRule) (*model.DomainSchemaNodeResponse, error) {
+	rule := model.CrossDomainRuleRestrict
+	if crossDomain != nil {
+		rule = *crossDomain
+	}
+	result, err := r.Database.DeleteDomainSchemaNode(ctx, id, rule)
 	if err != nil {
 		return nil, err
 	}
@@ -218,8 +223,8 @@ func (r *mutationResolver) UpdatePropertiesOnTypeSchemaNode(ctx context.Context,
 }
 
 // RenamePropertyOnTypeSchemaNode is the resolver for the renamePropertyOnTypeSchemaNode field.
-func (r *mutationResolver) RenamePropertyOnTypeSchemaNode(ctx context.Context, id string, oldPropertyName string, newPropertyName string) (*model.TypeSchemaNodeResponse, error) {
-	result, err := r.Database.RenamePropertyOnTypeSchemaNode(ctx, id, oldPropertyName, newPropertyName)
+func (r *mutationResolver) RenamePropertyOnTypeSchemaNode(ctx context.Context, id string, oldPropertyName string, newPropertyName string, propagate *bool) (*model.TypeSchemaNodeResponse, error) {
+	result, err := r.Database.RenamePropertyOnTypeSchemaNode(ctx, id, oldPropertyName, newPropertyName, propagate == nil || *propagate)
 	if err != nil {
 		return nil, err
 	}
@@ -230,8 +235,56 @@ func (r *mutationResolver) RenamePropertyOnTypeSchemaNode(ctx context.Context, i
 }
 
 // RemovePropertiesFromTypeSchemaNode is the resolver for the removePropertiesFromTypeSchemaNode field.
-func (r *mutationResolver) RemovePropertiesFromTypeSchemaNode(ctx context.Context, id string, properties []string) (*model.TypeSchemaNodeResponse, error) {
-	result, err := r.Database.RemovePropertiesFromTypeSchemaNode(ctx, id, properties)
+func (r *mutationResolver) RemovePropertiesFromTypeSchemaNode(ctx context.Context, id string, properties []string, propagate *bool) (*model.TypeSchemaNodeResponse, error) {
+	result, err := r.Database.RemovePropertiesFromTypeSchemaNode(ctx, id, properties, propagate == nil || *propagate)
+	if err != nil {
+		return nil, err
+	}
+	if result.Success {
+		r.Subscriptions.Publish(subscriptions.TypeSchemaNodeUpdated, result)
+	}
+	return result, nil
+}
+
+// SetJSONSchemaOnTypeSchemaNode is the resolver for the setJsonSchemaOnTypeSchemaNode field.
+func (r *mutationResolver) SetJSONSchemaOnTypeSchemaNode(ctx context.Context, id string, property string, schema *string) (*model.TypeSchemaNodeResponse, error) {
+	result, err := r.Database.SetJSONSchemaOnTypeSchemaNode(ctx, id, property, schema)
+	if err != nil {
+		return nil, err
+	}
+	if result.Success {
+		r.Subscriptions.Publish(subscriptions.TypeSchemaNodeUpdated, result)
+	}
+	return result, nil
+}
+
+// SetPropertyConstraintOnTypeSchemaNode is the resolver for the setPropertyConstraintOnTypeSchemaNode field.
+func (r *mutationResolver) SetPropertyConstraintOnTypeSchemaNode(ctx context.Context, id string, property string, constraint *model.PropertyConstraintInput) (*model.TypeSchemaNodeResponse, error) {
+	result, err := r.Database.SetPropertyConstraintOnTypeSchemaNode(ctx, id, property, constraint)
+	if err != nil {
+		return nil, err
+	}
+	if result.Success {
+		r.Subscriptions.Publish(subscriptions.TypeSchemaNodeUpdated, result)
+	}
+	return result, nil
+}
+
+// SetComputedPropertyOnTypeSchemaNode is the resolver for the setComputedPropertyOnTypeSchemaNode field.
+func (r *mutationResolver) SetComputedPropertyOnTypeSchemaNode(ctx context.Context, id string, property string, expression *string) (*model.TypeSchemaNodeResponse, error) {
+	result, err := r.Database.SetComputedPropertyOnTypeSchemaNode(ctx, id, property, expression)
+	if err != nil {
+		return nil, err
+	}
+	if result.Success {
+		r.Subscriptions.Publish(subscriptions.TypeSchemaNodeUpdated, result)
+	}
+	return result, nil
+}
+
+// SetParentOnTypeSchemaNode is the resolver for the setParentOnTypeSchemaNode field.
+func (r *mutationResolver) SetParentOnTypeSchemaNode(ctx context.Context, id string, parentTypeSchemaNodeID *string) (*model.TypeSchemaNodeResponse, error) {
+	result, err := r.Database.SetParentOnTypeSchemaNode(ctx, id, parentTypeSchemaNodeID)
 	if err != nil {
 		return nil, err
 	}
@@ -290,8 +343,8 @@ func (r *mutationResolver) UpdatePropertiesOnRelationshipSchemaNode(ctx context.
 }
 
 // RenamePropertyOnRelationshipSchemaNode is the resolver for the renamePropertyOnRelationshipSchemaNode field.
-func (r *mutationResolver) RenamePropertyOnRelationshipSchemaNode(ctx context.Context, id string, oldPropertyName string, newPropertyName string) (*model.RelationshipSchemaNodeResponse, error) {
-	result, err := r.Database.RenamePropertyOnRelationshipSchemaNode(ctx, id, oldPropertyName, newPropertyName)
+func (r *mutationResolver) RenamePropertyOnRelationshipSchemaNode(ctx context.Context, id string, oldPropertyName string, newPropertyName string, propagate *bool) (*model.RelationshipSchemaNodeResponse, error) {
+	result, err := r.Database.RenamePropertyOnRelationshipSchemaNode(ctx, id, oldPropertyName, newPropertyName, propagate == nil || *propagate)
 	if err != nil {
 		return nil, err
 	}
@@ -302,8 +355,20 @@ func (r *mutationResolver) RenamePropertyOnRelationshipSchemaNode(ctx context.Co
 }
 
 // RemovePropertiesFromRelationshipSchemaNode is the resolver for the removePropertiesFromRelationshipSchemaNode field.
-func (r *mutationResolver) RemovePropertiesFromRelationshipSchemaNode(ctx context.Context, id string, properties []string) (*model.RelationshipSchemaNodeResponse, error) {
-	result, err := r.Database.RemovePropertiesFromRelationshipSchemaNode(ctx, id, properties)
+func (r *mutationResolver) RemovePropertiesFromRelationshipSchemaNode(ctx context.Context, id string, properties []string, propagate *bool) (*model.RelationshipSchemaNodeResponse, error) {
+	result, err := r.Database.RemovePropertiesFromRelationshipSchemaNode(ctx, id, properties, propagate == nil || *propagate)
+	if err != nil {
+		return nil, err
+	}
+	if result.Success {
+		r.Subscriptions.Publish(subscriptions.RelationshipSchemaNodeUpdated, result)
+	}
+	return result, nil
+}
+
+// SetCardinalityOnRelationshipSchemaNode is the resolver for the setCardinalityOnRelationshipSchemaNode field.
+func (r *mutationResolver) SetCardinalityOnRelationshipSchemaNode(ctx context.Context, id string, cardinality *model.RelationshipCardinalityInput) (*model.RelationshipSchemaNodeResponse, error) {
+	result, err := r.Database.SetCardinalityOnRelationshipSchemaNode(ctx, id, cardinality)
 	if err != nil {
 		return nil, err
 	}
@@ -325,6 +390,24 @@ func (r *mutationResolver) DeleteRelationshipSchemaNode(ctx context.Context, id
 	return result, nil
 }
 
+// ApplyDomainSchema is the resolver for the applyDomainSchema field.
+func (r *mutationResolver) ApplyDomainSchema(ctx context.Context, document string, dryRun *bool) (*model.SchemaPlanResponse, error) {
+	result, err := domainschema.ApplyDocument(ctx, r.Database, []byte(document), dryRun != nil && *dryRun, r.Subscriptions.Publish)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// RebuildConstraints is the resolver for the rebuildConstraints field.
+func (r *mutationResolver) RebuildConstraints(ctx context.Context) (*model.IndexesResponse, error) {
+	result, err := r.Database.RebuildConstraints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // GetObjectNode is the resolver for the getObjectNode field.
 func (r *queryResolver) GetObjectNode(ctx context.Context, id string) (*model.ObjectNodeResponse, error) {
 	result, err := r.Database.GetObjectNode(ctx, id)
@@ -335,8 +418,26 @@ func (r *queryResolver) GetObjectNode(ctx context.Context, id string) (*model.Ob
 }
 
 // GetObjectNodes is the resolver for the getObjectNodes field.
-func (r *queryResolver) GetObjectNodes(ctx context.Context, domain *string, typeArg *string) (*model.ObjectNodesResponse, error) {
-	result, err := r.Database.GetObjectNodes(ctx, domain, typeArg)
+func (r *queryResolver) GetObjectNodes(ctx context.Context, domain *string, typeArg *string, where []*model.PropertyFilter, orderBy []*model.PropertySort) (*model.ObjectNodesResponse, error) {
+	result, err := r.Database.GetObjectNodes(ctx, domain, typeArg, where, orderBy)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ObjectNodesWithinDistance is the resolver for the objectNodesWithinDistance field.
+func (r *queryResolver) ObjectNodesWithinDistance(ctx context.Context, domain string, typeArg string, property string, center model.PointInput, meters float64) (*model.ObjectNodesResponse, error) {
+	result, err := r.Database.ObjectNodesWithinDistance(ctx, domain, typeArg, property, center, meters)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ObjectNodesInBoundingBox is the resolver for the objectNodesInBoundingBox field.
+func (r *queryResolver) ObjectNodesInBoundingBox(ctx context.Context, domain string, typeArg string, property string, lowerLeft model.PointInput, upperRight model.PointInput) (*model.ObjectNodesResponse, error) {
+	result, err := r.Database.ObjectNodesInBoundingBox(ctx, domain, typeArg, property, lowerLeft, upperRight)
 	if err != nil {
 		return nil, err
 	}
@@ -388,6 +489,15 @@ func (r *queryResolver) GetDomainSchemaNodes(ctx context.Context) (*model.Domain
 	return result, nil
 }
 
+// GetDomainExternalDependencies is the resolver for the getDomainExternalDependencies field.
+func (r *queryResolver) GetDomainExternalDependencies(ctx context.Context, domain string) (*model.DomainDependenciesResponse, error) {
+	result, err := r.Database.GetDomainExternalDependencies(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // GetTypeSchemaNode is the resolver for the getTypeSchemaNode field.
 func (r *queryResolver) GetTypeSchemaNode(ctx context.Context, id string) (*model.TypeSchemaNodeResponse, error) {
 	result, err := r.Database.GetTypeSchemaNode(ctx, id)
@@ -442,214 +552,115 @@ func (r *queryResolver) GetRelationshipSchemaNodes(ctx context.Context, domain *
 	return result, nil
 }
 
+// RelationshipCardinalityReport is the resolver for the relationshipCardinalityReport field.
+func (r *queryResolver) RelationshipCardinalityReport(ctx context.Context, domain string) (*model.CardinalityReportResponse, error) {
+	result, err := r.Database.RelationshipCardinalityReport(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// SchemaDiff is the resolver for the schemaDiff field.
+func (r *queryResolver) SchemaDiff(ctx context.Context, domain string, against model.SchemaDiffAgainst) (*model.SchemaDiffResponse, error) {
+	result, err := domainschema.Diff(ctx, r.Database, domain, against)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ExportSchemaDiagram is the resolver for the exportSchemaDiagram field.
+func (r *queryResolver) ExportSchemaDiagram(ctx context.Context, domain string, format model.SchemaDiagramFormat, includeCounts *bool) (*model.SchemaDiagramResponse, error) {
+	result, err := domainschema.Diagram(ctx, r.Database, domain, format, includeCounts != nil && *includeCounts)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Indexes is the resolver for the indexes field.
+func (r *queryResolver) Indexes(ctx context.Context) (*model.IndexesResponse, error) {
+	result, err := r.Database.GetIndexes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // ObjectNodeCreated is the resolver for the objectNodeCreated field.
 func (r *subscriptionResolver) ObjectNodeCreated(ctx context.Context) (<-chan *model.ObjectNodeResponse, error) {
-	subscriber := r.Subscriptions.Subscribe(subscriptions.ObjectNodeCreated)
-	ch := make(chan *model.ObjectNodeResponse)
-	go func() {
-		for event := range subscriber.Events {
-			if response, ok := event.(*model.ObjectNodeResponse); ok {
-				ch <- response
-			}
-		}
-	}()
-	return ch, nil
+	return subscribe[*model.ObjectNodeResponse](ctx, r.Subscriptions, subscriptions.ObjectNodeCreated), nil
 }
 
 // ObjectNodeUpdated is the resolver for the objectNodeUpdated field.
 func (r *subscriptionResolver) ObjectNodeUpdated(ctx context.Context) (<-chan *model.ObjectNodeResponse, error) {
-	subscriber := r.Subscriptions.Subscribe(subscriptions.ObjectNodeUpdated)
-	ch := make(chan *model.ObjectNodeResponse)
-	go func() {
-		for event := range subscriber.Events {
-			if response, ok := event.(*model.ObjectNodeResponse); ok {
-				ch <- response
-			}
-		}
-	}()
-	return ch, nil
+	return subscribe[*model.ObjectNodeResponse](ctx, r.Subscriptions, subscriptions.ObjectNodeUpdated), nil
 }
 
 // ObjectNodeDeleted is the resolver for the objectNodeDeleted field.
 func (r *subscriptionResolver) ObjectNodeDeleted(ctx context.Context) (<-chan *model.ObjectNodeResponse, error) {
-	subscriber := r.Subscriptions.Subscribe(subscriptions.ObjectNodeDeleted)
-	ch := make(chan *model.ObjectNodeResponse)
-	go func() {
-		for event := range subscriber.Events {
-			if response, ok := event.(*model.ObjectNodeResponse); ok {
-				ch <- response
-			}
-		}
-	}()
-	return ch, nil
+	return subscribe[*model.ObjectNodeResponse](ctx, r.Subscriptions, subscriptions.ObjectNodeDeleted), nil
 }
 
 // ObjectRelationshipCreated is the resolver for the objectRelationshipCreated field.
 func (r *subscriptionResolver) ObjectRelationshipCreated(ctx context.Context) (<-chan *model.ObjectRelationshipResponse, error) {
-	subscriber := r.Subscriptions.Subscribe(subscriptions.ObjectRelationshipCreated)
-	ch := make(chan *model.ObjectRelationshipResponse)
-	go func() {
-		for event := range subscriber.Events {
-			if response, ok := event.(*model.ObjectRelationshipResponse); ok {
-				ch <- response
-			}
-		}
-	}()
-	return ch, nil
+	return subscribe[*model.ObjectRelationshipResponse](ctx, r.Subscriptions, subscriptions.ObjectRelationshipCreated), nil
 }
 
 // ObjectRelationshipUpdated is the resolver for the objectRelationshipUpdated field.
 func (r *subscriptionResolver) ObjectRelationshipUpdated(ctx context.Context) (<-chan *model.ObjectRelationshipResponse, error) {
-	subscriber := r.Subscriptions.Subscribe(subscriptions.ObjectRelationshipUpdated)
-	ch := make(chan *model.ObjectRelationshipResponse)
-	go func() {
-		for event := range subscriber.Events {
-			if response, ok := event.(*model.ObjectRelationshipResponse); ok {
-				ch <- response
-			}
-		}
-	}()
-	return ch, nil
+	return subscribe[*model.ObjectRelationshipResponse](ctx, r.Subscriptions, subscriptions.ObjectRelationshipUpdated), nil
 }
 
 // ObjectRelationshipDeleted is the resolver for the objectRelationshipDeleted field.
 func (r *subscriptionResolver) ObjectRelationshipDeleted(ctx context.Context) (<-chan *model.ObjectRelationshipResponse, error) {
-	subscriber := r.Subscriptions.Subscribe(subscriptions.ObjectRelationshipDeleted)
-	ch := make(chan *model.ObjectRelationshipResponse)
-	go func() {
-		for event := range subscriber.Events {
-			if response, ok := event.(*model.ObjectRelationshipResponse); ok {
-				ch <- response
-			}
-		}
-	}()
-	return ch, nil
+	return subscribe[*model.ObjectRelationshipResponse](ctx, r.Subscriptions, subscriptions.ObjectRelationshipDeleted), nil
 }
 
 // DomainSchemaNodeCreated is the resolver for the domainSchemaNodeCreated field.
 func (r *subscriptionResolver) DomainSchemaNodeCreated(ctx context.Context) (<-chan *model.DomainSchemaNodeResponse, error) {
-	subscriber := r.Subscriptions.Subscribe(subscriptions.DomainSchemaNodeCreated)
-	ch := make(chan *model.DomainSchemaNodeResponse)
-	go func() {
-		for event := range subscriber.Events {
-			if response, ok := event.(*model.DomainSchemaNodeResponse); ok {
-				ch <- response
-			}
-		}
-	}()
-	return ch, nil
+	return subscribe[*model.DomainSchemaNodeResponse](ctx, r.Subscriptions, subscriptions.DomainSchemaNodeCreated), nil
 }
 
 // DomainSchemaNodeUpdated is the resolver for the domainSchemaNodeUpdated field.
 func (r *subscriptionResolver) DomainSchemaNodeUpdated(ctx context.Context) (<-chan *model.DomainSchemaNodeResponse, error) {
-	subscriber := r.Subscriptions.Subscribe(subscriptions.DomainSchemaNodeUpdated)
-	ch := make(chan *model.DomainSchemaNodeResponse)
-	go func() {
-		for event := range subscriber.Events {
-			if response, ok := event.(*model.DomainSchemaNodeResponse); ok {
-				ch <- response
-			}
-		}
-	}()
-	return ch, nil
+	return subscribe[*model.DomainSchemaNodeResponse](ctx, r.Subscriptions, subscriptions.DomainSchemaNodeUpdated), nil
 }
 
 // DomainSchemaNodeDeleted is the resolver for the domainSchemaNodeDeleted field.
 func (r *subscriptionResolver) DomainSchemaNodeDeleted(ctx context.Context) (<-chan *model.DomainSchemaNodeResponse, error) {
-	subscriber := r.Subscriptions.Subscribe(subscriptions.DomainSchemaNodeDeleted)
-	ch := make(chan *model.DomainSchemaNodeResponse)
-	go func() {
-		for event := range subscriber.Events {
-			if response, ok := event.(*model.DomainSchemaNodeResponse); ok {
-				ch <- response
-			}
-		}
-	}()
-	return ch, nil
+	return subscribe[*model.DomainSchemaNodeResponse](ctx, r.Subscriptions, subscriptions.DomainSchemaNodeDeleted), nil
 }
 
 // TypeSchemaNodeCreated is the resolver for the typeSchemaNodeCreated field.
 func (r *subscriptionResolver) TypeSchemaNodeCreated(ctx context.Context) (<-chan *model.TypeSchemaNodeResponse, error) {
-	subscriber := r.Subscriptions.Subscribe(subscriptions.TypeSchemaNodeCreated)
-	ch := make(chan *model.TypeSchemaNodeResponse)
-	go func() {
-		for event := range subscriber.Events {
-			if response, ok := event.(*model.TypeSchemaNodeResponse); ok {
-				ch <- response
-			}
-		}
-	}()
-	return ch, nil
+	return subscribe[*model.TypeSchemaNodeResponse](ctx, r.Subscriptions, subscriptions.TypeSchemaNodeCreated), nil
 }
 
 // TypeSchemaNodeUpdated is the resolver for the typeSchemaNodeUpdated field.
 func (r *subscriptionResolver) TypeSchemaNodeUpdated(ctx context.Context) (<-chan *model.TypeSchemaNodeResponse, error) {
-	subscriber := r.Subscriptions.Subscribe(subscriptions.TypeSchemaNodeUpdated)
-	ch := make(chan *model.TypeSchemaNodeResponse)
-	go func() {
-		for event := range subscriber.Events {
-			if response, ok := event.(*model.TypeSchemaNodeResponse); ok {
-				ch <- response
-			}
-		}
-	}()
-	return ch, nil
+	return subscribe[*model.TypeSchemaNodeResponse](ctx, r.Subscriptions, subscriptions.TypeSchemaNodeUpdated), nil
 }
 
 // TypeSchemaNodeDeleted is the resolver for the typeSchemaNodeDeleted field.
 func (r *subscriptionResolver) TypeSchemaNodeDeleted(ctx context.Context) (<-chan *model.TypeSchemaNodeResponse, error) {
-	subscriber := r.Subscriptions.Subscribe(subscriptions.TypeSchemaNodeDeleted)
-	ch := make(chan *model.TypeSchemaNodeResponse)
-	go func() {
-		for event := range subscriber.Events {
-			if response, ok := event.(*model.TypeSchemaNodeResponse); ok {
-				ch <- response
-			}
-		}
-	}()
-	return ch, nil
+	return subscribe[*model.TypeSchemaNodeResponse](ctx, r.Subscriptions, subscriptions.TypeSchemaNodeDeleted), nil
 }
 
 // RelationshipSchemaNodeCreated is the resolver for the relationshipSchemaNodeCreated field.
 func (r *subscriptionResolver) RelationshipSchemaNodeCreated(ctx context.Context) (<-chan *model.RelationshipSchemaNodeResponse, error) {
-	subscriber := r.Subscriptions.Subscribe(subscriptions.RelationshipSchemaNodeCreated)
-	ch := make(chan *model.RelationshipSchemaNodeResponse)
-	go func() {
-		for event := range subscriber.Events {
-			if response, ok := event.(*model.RelationshipSchemaNodeResponse); ok {
-				ch <- response
-			}
-		}
-	}()
-	return ch, nil
+	return subscribe[*model.RelationshipSchemaNodeResponse](ctx, r.Subscriptions, subscriptions.RelationshipSchemaNodeCreated), nil
 }
 
 // RelationshipSchemaNodeUpdated is the resolver for the relationshipSchemaNodeUpdated field.
 func (r *subscriptionResolver) RelationshipSchemaNodeUpdated(ctx context.Context) (<-chan *model.RelationshipSchemaNodeResponse, error) {
-	subscriber := r.Subscriptions.Subscribe(subscriptions.RelationshipSchemaNodeUpdated)
-	ch := make(chan *model.RelationshipSchemaNodeResponse)
-	go func() {
-		for event := range subscriber.Events {
-			if response, ok := event.(*model.RelationshipSchemaNodeResponse); ok {
-				ch <- response
-			}
-		}
-	}()
-	return ch, nil
+	return subscribe[*model.RelationshipSchemaNodeResponse](ctx, r.Subscriptions, subscriptions.RelationshipSchemaNodeUpdated), nil
 }
 
 // RelationshipSchemaNodeDeleted is the resolver for the relationshipSchemaNodeDeleted field.
 func (r *subscriptionResolver) RelationshipSchemaNodeDeleted(ctx context.Context) (<-chan *model.RelationshipSchemaNodeResponse, error) {
-	subscriber := r.Subscriptions.Subscribe(subscriptions.RelationshipSchemaNodeDeleted)
-	ch := make(chan *model.RelationshipSchemaNodeResponse)
-	go func() {
-		for event := range subscriber.Events {
-			if response, ok := event.(*model.RelationshipSchemaNodeResponse); ok {
-				ch <- response
-			}
-		}
-	}()
-	return ch, nil
+	return subscribe[*model.RelationshipSchemaNodeResponse](ctx, r.Subscriptions, subscriptions.RelationshipSchemaNodeDeleted), nil
 }
 
 // Mutation returns generated.MutationResolver implementation.
@@ -1,8 +1,11 @@
 package subscriptions
 
 import (
+	"context"
+	"errors"
 	"sync"
 
+	"github.com/mike-jacks/neo/metrics"
 	"github.com/mike-jacks/neo/utils"
 )
 
@@ -37,9 +40,12 @@ type Subscriber struct {
 
 type SubscriptionManager struct {
 	subscribers map[EventType]map[string]*Subscriber
+	closed      bool
 	mu          sync.RWMutex
 }
 
+var ErrClosed = errors.New("subscription manager is closed")
+
 func NewSubscriptionManager() *SubscriptionManager {
 	return &SubscriptionManager{
 		subscribers: make(map[EventType]map[string]*Subscriber),
@@ -50,16 +56,23 @@ func (m *SubscriptionManager) Subscribe(eventType EventType) *Subscriber {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	if m.subscribers[eventType] == nil {
-		m.subscribers[eventType] = make(map[string]*Subscriber)
-	}
-
 	subscriber := &Subscriber{
 		ID:     utils.GenerateId(),
 		Events: make(chan interface{}, 1),
 	}
 
+	// Subscribers arriving after Close get an already closed channel so they complete immediately
+	if m.closed {
+		close(subscriber.Events)
+		return subscriber
+	}
+
+	if m.subscribers[eventType] == nil {
+		m.subscribers[eventType] = make(map[string]*Subscriber)
+	}
+
 	m.subscribers[eventType][subscriber.ID] = subscriber
+	metrics.SubscriptionsActive.WithLabelValues(string(eventType)).Inc()
 
 	return subscriber
 }
@@ -72,20 +85,54 @@ func (m *SubscriptionManager) Unsubscribe(eventType EventType, subscriberID stri
 		if subscriber, exists := subscribers[subscriberID]; exists {
 			close(subscriber.Events)
 			delete(subscribers, subscriberID)
+			metrics.SubscriptionsActive.WithLabelValues(string(eventType)).Dec()
 		}
 	}
 }
 
 func (m *SubscriptionManager) Publish(eventType EventType, data interface{}) {
 	m.mu.RLock()
-	subscribers := m.subscribers[eventType]
-	m.mu.RUnlock()
+	defer m.mu.RUnlock()
 
+	subscribers := m.subscribers[eventType]
 	for _, subscriber := range subscribers {
 		select {
 		case subscriber.Events <- data:
+			metrics.SubscriptionEventsPublished.WithLabelValues(string(eventType)).Inc()
 		default:
 			// Channel is full, skip this subscriber
+			metrics.SubscriptionEventsDropped.WithLabelValues(string(eventType)).Inc()
 		}
 	}
 }
+
+// Close closes every subscriber channel, which completes the matching GraphQL subscriptions,
+// and rejects any further subscriber
+func (m *SubscriptionManager) Close() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.closed {
+		return
+	}
+	m.closed = true
+
+	for eventType, subscribers := range m.subscribers {
+		for subscriberID, subscriber := range subscribers {
+			close(subscriber.Events)
+			delete(subscribers, subscriberID)
+			metrics.SubscriptionsActive.WithLabelValues(string(eventType)).Dec()
+		}
+	}
+}
+
+// Check reports ErrClosed once the manager has been closed, it is used as a readiness check
+func (m *SubscriptionManager) Check(ctx context.Context) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	if m.closed {
+		return ErrClosed
+	}
+	return nil
+}
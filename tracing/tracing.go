@@ -0,0 +1,157 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	instrumentationName = "github.com/mike-jacks/neo"
+	serviceName         = "neo"
+)
+
+// Tracer returns the tracer used for all spans created by this service. Until Setup is called
+// it is backed by the global no-op provider, so instrumented code is safe to run untraced.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// Setup installs a global tracer provider exporting to the given exporter and returns a function
+// that flushes and shuts the provider down. Tests can pass a tracetest.InMemoryExporter.
+func Setup(exporter sdktrace.SpanExporter) func(context.Context) error {
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return provider.Shutdown
+}
+
+// SetupOTLP installs an OTLP/HTTP exporter sending to the collector at endpoint, a base URL such
+// as http://localhost:4318 to which /v1/traces is appended. The exporter reads the remaining
+// standard OTEL_EXPORTER_OTLP_* variables itself. It reports false when endpoint is empty and
+// tracing stays disabled.
+func SetupOTLP(ctx context.Context, endpoint string) (func(context.Context) error, bool, error) {
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, false, nil
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, false, fmt.Errorf("invalid OTLP endpoint: %w", err)
+	}
+	endpointURL.Path = strings.TrimSuffix(endpointURL.Path, "/") + "/v1/traces"
+
+	exporter, err := otlptracehttp.New(ctx, otlptracehttp.WithEndpointURL(endpointURL.String()))
+	if err != nil {
+		return nil, false, err
+	}
+	return Setup(exporter), true, nil
+}
+
+// StartQuerySpan starts a client span for a single Cypher statement. The statement is recorded with
+// its literals stripped and only parameter keys are recorded, never their values.
+func StartQuerySpan(ctx context.Context, query string, parameters map[string]any) (context.Context, trace.Span) {
+	keys := make([]string, 0, len(parameters))
+	for key := range parameters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return Tracer().Start(ctx, "neo4j.query",
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.DBSystemNeo4j,
+			semconv.DBOperationName(QueryOperation(query)),
+			semconv.DBQueryText(StripLiterals(query)),
+			attribute.StringSlice("db.query.parameter_keys", keys),
+		),
+	)
+}
+
+// QueryOperation is the first clause of a Cypher statement, such as MATCH or CREATE
+func QueryOperation(query string) string {
+	fields := strings.Fields(query)
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.ToUpper(fields[0])
+}
+
+// StripLiterals replaces the string and number literals of a Cypher statement with ?, so statements
+// written with their values inline can be recorded. Parameters, identifiers and backquoted names are kept.
+// Whitespace is collapsed.
+func StripLiterals(query string) string {
+	var stripped strings.Builder
+	for i := 0; i < len(query); {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"':
+			i = skipQuoted(query, i, c)
+			stripped.WriteByte('?')
+		case c == '`':
+			end := skipQuoted(query, i, c)
+			stripped.WriteString(query[i:end])
+			i = end
+		case isDigit(c) && (i == 0 || !isIdentifier(query[i-1])):
+			for i < len(query) && (isIdentifier(query[i]) || query[i] == '.' && i+1 < len(query) && isDigit(query[i+1])) {
+				i++
+			}
+			stripped.WriteByte('?')
+		case isIdentifier(c) || c == '$':
+			start := i
+			for i++; i < len(query) && isIdentifier(query[i]); i++ {
+			}
+			stripped.WriteString(query[start:i])
+		default:
+			stripped.WriteByte(c)
+			i++
+		}
+	}
+	return strings.Join(strings.Fields(stripped.String()), " ")
+}
+
+// skipQuoted returns the index after the quote closing the one at start, or the end of query when unterminated
+func skipQuoted(query string, start int, quote byte) int {
+	for i := start + 1; i < len(query); i++ {
+		switch query[i] {
+		case '\\':
+			if quote != '`' {
+				i++
+			}
+		case quote:
+			return i + 1
+		}
+	}
+	return len(query)
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifier(c byte) bool {
+	return c == '_' || isDigit(c) || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= 0x80
+}
+
+// RecordError marks the span as failed when err is not nil
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
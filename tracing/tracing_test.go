@@ -0,0 +1,149 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// setupInMemory installs a tracer provider exporting to an in-memory exporter and returns a function
+// flushing it and returning the exported spans
+func setupInMemory(t *testing.T) func() tracetest.SpanStubs {
+	t.Helper()
+	exporter := tracetest.NewInMemoryExporter()
+	shutdown := Setup(exporter)
+	t.Cleanup(func() { shutdown(context.Background()) })
+	return func() tracetest.SpanStubs {
+		if err := otel.GetTracerProvider().(*sdktrace.TracerProvider).ForceFlush(context.Background()); err != nil {
+			t.Fatalf("flushing spans: %v", err)
+		}
+		return exporter.GetSpans()
+	}
+}
+
+func attributeOf(span tracetest.SpanStub, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range span.Attributes {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestStartQuerySpanRecordsNoValues(t *testing.T) {
+	spans := setupInMemory(t)
+
+	query := `MATCH (objectNode {_id: $id}) SET objectNode.secret = "hunter2", objectNode.pin = 1234 RETURN objectNode`
+	_, span := StartQuerySpan(context.Background(), query, map[string]any{"id": "abc", "value": "classified"})
+	span.End()
+
+	exported := spans()
+	if len(exported) != 1 {
+		t.Fatalf("got %d spans, want 1", len(exported))
+	}
+	got := exported[0]
+	if got.Name != "neo4j.query" || got.SpanKind != trace.SpanKindClient {
+		t.Errorf("got span %q of kind %v, want neo4j.query of kind client", got.Name, got.SpanKind)
+	}
+	text, _ := attributeOf(got, "db.query.text")
+	if want := "MATCH (objectNode {_id: $id}) SET objectNode.secret = ?, objectNode.pin = ? RETURN objectNode"; text.AsString() != want {
+		t.Errorf("got query text %q, want %q", text.AsString(), want)
+	}
+	operation, _ := attributeOf(got, "db.operation.name")
+	if operation.AsString() != "MATCH" {
+		t.Errorf("got operation %q, want MATCH", operation.AsString())
+	}
+	keys, _ := attributeOf(got, "db.query.parameter_keys")
+	if got, want := keys.AsStringSlice(), []string{"id", "value"}; len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("got parameter keys %v, want %v", got, want)
+	}
+	for _, kv := range got.Attributes {
+		if kv.Value.Emit() == "abc" || kv.Value.Emit() == "classified" {
+			t.Errorf("attribute %s records a parameter value", kv.Key)
+		}
+	}
+}
+
+func TestExtensionSpans(t *testing.T) {
+	spans := setupInMemory(t)
+
+	ctx := graphql.WithOperationContext(context.Background(), &graphql.OperationContext{
+		OperationName: "CreateServer",
+		Operation:     &ast.OperationDefinition{Operation: ast.Mutation, Name: "CreateServer"},
+	})
+	response := Extension{}.InterceptResponse(ctx, func(ctx context.Context) *graphql.Response {
+		fieldCtx := graphql.WithFieldContext(ctx, &graphql.FieldContext{
+			Object:     "Mutation",
+			Field:      graphql.CollectedField{Field: &ast.Field{Name: "createObjectNode", Alias: "createObjectNode"}},
+			IsResolver: true,
+		})
+		_, err := Extension{}.InterceptField(fieldCtx, func(ctx context.Context) (any, error) {
+			_, span := StartQuerySpan(ctx, "CREATE (objectNode) RETURN objectNode", nil)
+			span.End()
+			return nil, errors.New("resolver failed")
+		})
+		if err == nil {
+			t.Error("InterceptField swallowed the resolver error")
+		}
+		return &graphql.Response{}
+	})
+	if response == nil {
+		t.Fatal("InterceptResponse returned no response")
+	}
+
+	byName := map[string]tracetest.SpanStub{}
+	for _, span := range spans() {
+		byName[span.Name] = span
+	}
+	operation, ok := byName["graphql.mutation CreateServer"]
+	if !ok {
+		t.Fatalf("no operation span in %v", byName)
+	}
+	resolver, ok := byName["graphql.resolve Mutation.createObjectNode"]
+	if !ok {
+		t.Fatalf("no resolver span in %v", byName)
+	}
+	query, ok := byName["neo4j.query"]
+	if !ok {
+		t.Fatalf("no query span in %v", byName)
+	}
+
+	if resolver.Parent.SpanID() != operation.SpanContext.SpanID() {
+		t.Error("resolver span is not a child of the operation span")
+	}
+	if query.Parent.SpanID() != resolver.SpanContext.SpanID() {
+		t.Error("query span is not a child of the resolver span")
+	}
+	if resolver.Status.Code != codes.Error {
+		t.Errorf("got resolver status %v, want error", resolver.Status.Code)
+	}
+}
+
+func TestStripLiterals(t *testing.T) {
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{`MATCH (n) RETURN n`, `MATCH (n) RETURN n`},
+		{`MATCH (n {name: 'O\'Brien'}) RETURN n`, `MATCH (n {name: ?}) RETURN n`},
+		{`CREATE (n {name: "a \"quoted\" name", size: 12, ratio: 1.5e3, offset: -4})`, `CREATE (n {name: ?, size: ?, ratio: ?, offset: -?})`},
+		{`MATCH (n:SERVER2 {_id: $id2}) SET n.point2d = point({x: 1.0, y: 2.0})`, `MATCH (n:SERVER2 {_id: $id2}) SET n.point2d = point({x: ?, y: ?})`},
+		{"MATCH (n:`WEB-SERVER 1`) RETURN n LIMIT 10", "MATCH (n:`WEB-SERVER 1`) RETURN n LIMIT ?"},
+		{"MATCH (n)\n\tWHERE n.name = 'unterminated", "MATCH (n) WHERE n.name = ?"},
+		{`RETURN [1, 2, 3][0..2]`, `RETURN [?, ?, ?][?..?]`},
+	}
+	for _, test := range tests {
+		if got := StripLiterals(test.query); got != test.want {
+			t.Errorf("StripLiterals(%q) = %q, want %q", test.query, got, test.want)
+		}
+	}
+}
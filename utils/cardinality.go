@@ -0,0 +1,31 @@
+package utils
+
+import (
+	"github.com/mike-jacks/neo/model"
+)
+
+// The relationship schema node properties holding its cardinality
+const (
+	CardinalityKey  = "_cardinality"
+	FromRequiredKey = "_fromRequired"
+	ToRequiredKey   = "_toRequired"
+)
+
+// PopRelationshipCardinality removes the cardinality from the properties of a relationship schema node and returns
+// it, nil when the relationship schema node has none
+func PopRelationshipCardinality(m map[string]interface{}) *model.RelationshipCardinality {
+	cardinality, _ := m[CardinalityKey].(string)
+	fromRequired, _ := m[FromRequiredKey].(bool)
+	toRequired, _ := m[ToRequiredKey].(bool)
+	delete(m, CardinalityKey)
+	delete(m, FromRequiredKey)
+	delete(m, ToRequiredKey)
+	if !model.Cardinality(cardinality).IsValid() {
+		return nil
+	}
+	return &model.RelationshipCardinality{
+		Cardinality:  model.Cardinality(cardinality),
+		FromRequired: fromRequired,
+		ToRequired:   toRequired,
+	}
+}
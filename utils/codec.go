@@ -0,0 +1,286 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/mike-jacks/neo/model"
+)
+
+// arrayTypePrefix prefixes the property recording the type of an array property next to it,
+// Neo4j keeps no element type for an empty list
+const arrayTypePrefix = "_arraytype_"
+
+// ArrayTypeKey is the property recording the type of the array property key
+func ArrayTypeKey(key string) string {
+	return arrayTypePrefix + key
+}
+
+var arrayElementTypes = map[model.PropertyType]model.PropertyType{
+	model.PropertyTypeArrayString:  model.PropertyTypeString,
+	model.PropertyTypeArrayNumber:  model.PropertyTypeNumber,
+	model.PropertyTypeArrayInteger: model.PropertyTypeInteger,
+	model.PropertyTypeArrayFloat:   model.PropertyTypeFloat,
+	model.PropertyTypeArrayBoolean: model.PropertyTypeBoolean,
+}
+
+func IsArrayPropertyType(propertyType model.PropertyType) bool {
+	_, ok := arrayElementTypes[propertyType]
+	return ok
+}
+
+// ArrayElementType is the type of the elements of an array property type, false for other types
+func ArrayElementType(propertyType model.PropertyType) (model.PropertyType, bool) {
+	elementType, ok := arrayElementTypes[propertyType]
+	return elementType, ok
+}
+
+// NormalizePropertyValue checks value has propertyType and returns it the way it is written and read back:
+// NUMBER resolves to INTEGER or FLOAT, and ARRAY_NUMBER to ARRAY_INTEGER when every element is an integer and
+// ARRAY_FLOAT otherwise. A nil value is kept as is, writing it removes the property.
+func NormalizePropertyValue(propertyType model.PropertyType, value any) (model.PropertyType, any, error) {
+	if value == nil {
+		return propertyType, nil, nil
+	}
+
+	switch propertyType {
+	case model.PropertyTypeString:
+		switch value.(type) {
+		case []any, map[string]any:
+			return "", nil, fmt.Errorf("STRING value must be a string, got %T", value)
+		}
+		s := fmt.Sprint(value)
+		// JSON and RELATIONSHIP values are stored as strings behind a marker, a STRING value cannot look like them
+		for _, marker := range []string{JSONMarker, ReferenceMarker} {
+			if strings.HasPrefix(s, marker) {
+				return "", nil, fmt.Errorf("STRING value cannot start with the reserved prefix %q", marker)
+			}
+		}
+		return propertyType, s, nil
+	case model.PropertyTypeBoolean:
+		b, ok := value.(bool)
+		if !ok {
+			return "", nil, fmt.Errorf("BOOLEAN value must be a boolean, got %T", value)
+		}
+		return propertyType, b, nil
+	case model.PropertyTypeInteger:
+		i, ok := toInteger(value)
+		if !ok {
+			return "", nil, fmt.Errorf("INTEGER value must be a whole number, got %v", value)
+		}
+		return propertyType, i, nil
+	case model.PropertyTypeFloat:
+		f, ok := toFloat(value)
+		if !ok {
+			return "", nil, fmt.Errorf("FLOAT value must be a finite number, got %v", value)
+		}
+		return propertyType, f, nil
+	case model.PropertyTypeNumber:
+		// Integers written as integers read back as INTEGER, anything else is a FLOAT
+		if _, isFloat := value.(float64); !isFloat {
+			if i, ok := toInteger(value); ok {
+				return model.PropertyTypeInteger, i, nil
+			}
+		}
+		f, ok := toFloat(value)
+		if !ok {
+			return "", nil, fmt.Errorf("NUMBER value must be a finite number, got %v", value)
+		}
+		return model.PropertyTypeFloat, f, nil
+	case model.PropertyTypeArrayString, model.PropertyTypeArrayNumber, model.PropertyTypeArrayInteger, model.PropertyTypeArrayFloat, model.PropertyTypeArrayBoolean:
+		return normalizeArray(propertyType, value)
+	case model.PropertyTypeDate, model.PropertyTypeDatetime, model.PropertyTypeLocalDatetime, model.PropertyTypeDuration:
+		normalized, err := NormalizeTemporalValue(propertyType, value)
+		return propertyType, normalized, err
+	case model.PropertyTypePoint:
+		normalized, err := NormalizePointValue(value)
+		return propertyType, normalized, err
+	case model.PropertyTypeJSON:
+		normalized, err := NormalizeJSONValue(value)
+		return propertyType, normalized, err
+	case model.PropertyTypeRelationship:
+		normalized, err := NormalizeReferenceValue(value)
+		return propertyType, normalized, err
+	}
+	return "", nil, fmt.Errorf("unsupported property type %q", propertyType)
+}
+
+func normalizeArray(propertyType model.PropertyType, value any) (model.PropertyType, any, error) {
+	values, ok := value.([]any)
+	if !ok {
+		return "", nil, fmt.Errorf("%s value must be a list, got %T", propertyType, value)
+	}
+
+	elementType := arrayElementTypes[propertyType]
+	normalized := make([]any, 0, len(values))
+	allIntegers := true
+	for i, element := range values {
+		if element == nil {
+			return "", nil, fmt.Errorf("%s value cannot contain null, item %d is null", propertyType, i)
+		}
+		normalizedType, normalizedElement, err := NormalizePropertyValue(elementType, element)
+		if err != nil {
+			return "", nil, fmt.Errorf("item %d: %w", i, err)
+		}
+		allIntegers = allIntegers && normalizedType == model.PropertyTypeInteger
+		normalized = append(normalized, normalizedElement)
+	}
+
+	if propertyType != model.PropertyTypeArrayNumber {
+		return propertyType, normalized, nil
+	}
+	// A list property has a single element type, mixed numbers are stored as floats
+	if allIntegers && len(normalized) > 0 {
+		return model.PropertyTypeArrayInteger, normalized, nil
+	}
+	for i, element := range normalized {
+		if integer, ok := element.(int64); ok {
+			normalized[i] = float64(integer)
+		}
+	}
+	return model.PropertyTypeArrayFloat, normalized, nil
+}
+
+func toInteger(value any) (int64, bool) {
+	switch v := value.(type) {
+	case int:
+		return int64(v), true
+	case int32:
+		return int64(v), true
+	case int64:
+		return v, true
+	case float64:
+		if v == math.Trunc(v) && math.Abs(v) < 1<<63 {
+			return int64(v), true
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i, true
+		}
+		if f, err := v.Float64(); err == nil {
+			return toInteger(f)
+		}
+	}
+	return 0, false
+}
+
+func toFloat(value any) (float64, bool) {
+	var f float64
+	switch v := value.(type) {
+	case int:
+		f = float64(v)
+	case int32:
+		f = float64(v)
+	case int64:
+		f = float64(v)
+	case float32:
+		f = float64(v)
+	case float64:
+		f = v
+	case json.Number:
+		parsed, err := v.Float64()
+		if err != nil {
+			return 0, false
+		}
+		f = parsed
+	default:
+		return 0, false
+	}
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0, false
+	}
+	return f, true
+}
+
+// PropertyParameter is the Cypher expression writing a value normalized by NormalizePropertyValue from the
+// parameter name, and the value of the parameter. Values never appear in the query text. A nil value is written
+// null and needs no parameter.
+func PropertyParameter(propertyType model.PropertyType, value any, name string) (string, any) {
+	if value == nil {
+		return "null", nil
+	}
+
+	switch propertyType {
+	case model.PropertyTypeDate, model.PropertyTypeDatetime, model.PropertyTypeLocalDatetime, model.PropertyTypeDuration:
+		return fmt.Sprintf("%s($%s)", TemporalFunction(propertyType), name), fmt.Sprint(value)
+	case model.PropertyTypePoint:
+		return fmt.Sprintf("point($%s)", name), pointCoordinates(value.(map[string]any))
+	}
+	return "$" + name, parameterValue(propertyType, value)
+}
+
+// parameterValue is the driver value storing a normalized value of a type written as a plain parameter
+func parameterValue(propertyType model.PropertyType, value any) any {
+	switch propertyType {
+	case model.PropertyTypeString:
+		return fmt.Sprint(value)
+	case model.PropertyTypeFloat:
+		f, _ := toFloat(value)
+		return f
+	case model.PropertyTypeJSON:
+		return storedJSON(value)
+	case model.PropertyTypeRelationship:
+		return storedReference(value)
+	}
+
+	if elementType, ok := arrayElementTypes[propertyType]; ok {
+		values, _ := value.([]any)
+		elements := make([]any, 0, len(values))
+		for _, element := range values {
+			elements = append(elements, parameterValue(elementType, element))
+		}
+		return elements
+	}
+	return value
+}
+
+// extractProperty maps a value read from Neo4j back to a property, arrayType is the recorded type of an array
+func extractProperty(key string, value any, arrayType model.PropertyType) (*model.Property, bool) {
+	if reference, ok := extractReferenceProperty(value); ok {
+		return &model.Property{Key: key, Value: reference, Type: model.PropertyTypeRelationship}, true
+	}
+	if decoded, ok := ExtractJSONProperty(value); ok {
+		return &model.Property{Key: key, Value: decoded, Type: model.PropertyTypeJSON}, true
+	}
+	if point, ok := extractPointProperty(value); ok {
+		return &model.Property{Key: key, Value: point, Type: model.PropertyTypePoint}, true
+	}
+	if propertyType, temporalValue, ok := extractTemporalProperty(value); ok {
+		return &model.Property{Key: key, Value: temporalValue, Type: propertyType}, true
+	}
+
+	switch v := value.(type) {
+	case string:
+		return &model.Property{Key: key, Value: v, Type: model.PropertyTypeString}, true
+	case bool:
+		return &model.Property{Key: key, Value: v, Type: model.PropertyTypeBoolean}, true
+	case int64:
+		return &model.Property{Key: key, Value: v, Type: model.PropertyTypeInteger}, true
+	case float64:
+		return &model.Property{Key: key, Value: v, Type: model.PropertyTypeFloat}, true
+	case []any:
+		if IsArrayPropertyType(arrayType) && arrayType != model.PropertyTypeArrayNumber {
+			return &model.Property{Key: key, Value: v, Type: arrayType}, true
+		}
+		return &model.Property{Key: key, Value: v, Type: inferArrayType(v)}, true
+	}
+	return nil, false
+}
+
+// inferArrayType is the type of an array stored without its type, before types were recorded
+func inferArrayType(values []any) model.PropertyType {
+	if len(values) == 0 {
+		return model.PropertyTypeArrayString
+	}
+	switch values[0].(type) {
+	case int64:
+		return model.PropertyTypeArrayInteger
+	case float64:
+		return model.PropertyTypeArrayFloat
+	case bool:
+		return model.PropertyTypeArrayBoolean
+	}
+	return model.PropertyTypeArrayString
+}
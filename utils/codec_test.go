@@ -0,0 +1,346 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mike-jacks/neo/model"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// The round trip tests write random values of every property type the way CreatePropertiesQuery does, store them
+// the way Neo4j evaluates the written expressions, and read them back with ExtractPropertiesFromNeo4jNode. What is
+// read back must normalize to what was written.
+
+const roundTrips = 500
+
+// parametersOnly matches assignments whose values are all parameters, values never appear in the query text
+var parametersOnly = regexp.MustCompile(`^(objectNode\.\w+ = (null|\$\w+|\w+\(\$\w+\)), )*$`)
+
+// generators produce random input values of each property type, in the shapes GraphQL clients send them
+var generators = map[model.PropertyType]func(r *rand.Rand) any{
+	model.PropertyTypeString:  func(r *rand.Rand) any { return randomString(r) },
+	model.PropertyTypeBoolean: func(r *rand.Rand) any { return r.IntN(2) == 0 },
+	model.PropertyTypeInteger: randomInteger,
+	model.PropertyTypeFloat:   randomFloat,
+	model.PropertyTypeNumber: func(r *rand.Rand) any {
+		if r.IntN(2) == 0 {
+			return randomInteger(r)
+		}
+		return randomFloat(r)
+	},
+	model.PropertyTypeArrayString:  randomArray(func(r *rand.Rand) any { return randomString(r) }),
+	model.PropertyTypeArrayNumber:  randomArray(func(r *rand.Rand) any { return r.Float64() * 10 }, randomInteger),
+	model.PropertyTypeArrayInteger: randomArray(randomInteger),
+	model.PropertyTypeArrayFloat:   randomArray(randomFloat),
+	model.PropertyTypeArrayBoolean: randomArray(func(r *rand.Rand) any { return r.IntN(2) == 0 }),
+	model.PropertyTypeRelationship: func(r *rand.Rand) any { return " " + GenerateId() + " " },
+	model.PropertyTypeDate: func(r *rand.Rand) any {
+		return randomTime(r).Format(dateLayout)
+	},
+	model.PropertyTypeDatetime: func(r *rand.Rand) any {
+		zone := time.FixedZone("", (r.IntN(27)-12)*3600+r.IntN(2)*1800)
+		if r.IntN(3) == 0 {
+			zone = time.UTC
+		}
+		return randomTime(r).In(zone).Format(time.RFC3339Nano)
+	},
+	model.PropertyTypeLocalDatetime: func(r *rand.Rand) any {
+		return randomTime(r).Format(localDateTimeLayout)
+	},
+	model.PropertyTypeDuration: func(r *rand.Rand) any {
+		duration := "P"
+		for _, unit := range []string{"Y", "M", "W", "D"} {
+			if r.IntN(2) == 0 {
+				duration += fmt.Sprintf("%d%s", r.IntN(100), unit)
+			}
+		}
+		duration += "T"
+		for _, unit := range []string{"H", "M"} {
+			if r.IntN(2) == 0 {
+				duration += fmt.Sprintf("%d%s", r.IntN(100), unit)
+			}
+		}
+		duration += fmt.Sprintf("%d.%dS", r.IntN(100), r.IntN(1000000))
+		return duration
+	},
+	model.PropertyTypePoint: func(r *rand.Rand) any {
+		if r.IntN(2) == 0 {
+			return map[string]any{"latitude": r.Float64()*180 - 90, "longitude": r.Float64()*360 - 180}
+		}
+		return map[string]any{"x": randomFloat(r), "y": json.Number(fmt.Sprint(r.IntN(1000) - 500)), "crs": CRSCartesian}
+	},
+	model.PropertyTypeJSON: func(r *rand.Rand) any { return randomJSON(r, 3) },
+}
+
+func randomString(r *rand.Rand) string {
+	alphabet := []rune("abcXYZ 019_-\"'\\\n\t\x00é日本🙂{}[]:json:ref:")
+	runes := make([]rune, r.IntN(12))
+	for i := range runes {
+		runes[i] = alphabet[r.IntN(len(alphabet))]
+	}
+	return string(runes)
+}
+
+func randomInteger(r *rand.Rand) any {
+	switch r.IntN(4) {
+	case 0:
+		return r.Int64() - math.MaxInt64/2
+	case 1:
+		// JSON numbers without a fraction decode to whole float64 values
+		return float64(r.IntN(1<<20) - 1<<19)
+	case 2:
+		return json.Number(fmt.Sprint(r.IntN(1000) - 500))
+	}
+	return []int64{0, 1, -1, math.MaxInt64, math.MinInt64}[r.IntN(5)]
+}
+
+func randomFloat(r *rand.Rand) any {
+	switch r.IntN(4) {
+	case 0:
+		return float64(r.IntN(100))
+	case 1:
+		return math.Float64frombits(r.Uint64()&^(0x7ff<<52) | uint64(r.IntN(0x7fe)+1)<<52)
+	case 2:
+		return json.Number(fmt.Sprintf("%.3f", r.Float64()*1000))
+	}
+	return (r.Float64() - 0.5) * math.Pow(10, float64(r.IntN(40)-20))
+}
+
+func randomArray(elements ...func(r *rand.Rand) any) func(r *rand.Rand) any {
+	return func(r *rand.Rand) any {
+		values := make([]any, r.IntN(5))
+		for i := range values {
+			values[i] = elements[r.IntN(len(elements))](r)
+		}
+		return values
+	}
+}
+
+func randomTime(r *rand.Rand) time.Time {
+	return time.Unix(r.Int64N(1<<35)-1<<34, r.Int64N(int64(time.Second))).UTC()
+}
+
+func randomJSON(r *rand.Rand, depth int) any {
+	kinds := 5
+	if depth > 0 {
+		kinds = 7
+	}
+	switch r.IntN(kinds) {
+	case 0:
+		return nil
+	case 1:
+		return r.IntN(2) == 0
+	case 2:
+		return json.Number(fmt.Sprint(r.IntN(1000) - 500))
+	case 3:
+		return r.Float64()
+	case 4:
+		return randomString(r)
+	case 5:
+		values := make([]any, r.IntN(4))
+		for i := range values {
+			values[i] = randomJSON(r, depth-1)
+		}
+		return values
+	}
+	object := map[string]any{}
+	for i := r.IntN(4); i > 0; i-- {
+		object[randomString(r)] = randomJSON(r, depth-1)
+	}
+	return object
+}
+
+// store evaluates a property expression written by PropertyParameter the way Neo4j does and returns the stored value
+func store(t *testing.T, expression string, parameter any) any {
+	t.Helper()
+	function, _, found := strings.Cut(expression, "(")
+	if !found {
+		return parameter
+	}
+	switch function {
+	case "date":
+		parsed, err := time.Parse(dateLayout, parameter.(string))
+		if err != nil {
+			t.Fatalf("date(%q): %v", parameter, err)
+		}
+		return dbtype.Date(parsed)
+	case "datetime":
+		parsed, err := time.Parse(time.RFC3339Nano, parameter.(string))
+		if err != nil {
+			t.Fatalf("datetime(%q): %v", parameter, err)
+		}
+		return parsed
+	case "localdatetime":
+		parsed, err := time.Parse(localDateTimeLayout, parameter.(string))
+		if err != nil {
+			t.Fatalf("localdatetime(%q): %v", parameter, err)
+		}
+		return dbtype.LocalDateTime(parsed)
+	case "duration":
+		duration, err := parseDuration(parameter.(string))
+		if err != nil {
+			t.Fatalf("duration(%q): %v", parameter, err)
+		}
+		return duration
+	case "point":
+		coordinates := parameter.(map[string]any)
+		if latitude, ok := coordinates["latitude"]; ok {
+			return dbtype.Point2D{X: coordinates["longitude"].(float64), Y: latitude.(float64), SpatialRefId: sridWGS84}
+		}
+		return dbtype.Point2D{X: coordinates["x"].(float64), Y: coordinates["y"].(float64), SpatialRefId: 7203}
+	}
+	t.Fatalf("unexpected expression %s", expression)
+	return nil
+}
+
+// roundTrip writes value as a property of type propertyType and reads it back
+func roundTrip(t *testing.T, propertyType model.PropertyType, value any) (model.PropertyType, any, *model.Property) {
+	t.Helper()
+	properties := []*model.PropertyInput{{Key: "Some Key", Type: propertyType, Value: value}}
+	if err := CleanUpPropertyObjects(&properties); err != nil {
+		t.Fatalf("%s %#v: %v", propertyType, value, err)
+	}
+	written := properties[0]
+
+	parameters := map[string]any{}
+	query := CreatePropertiesQuery("", parameters, properties, "objectNode")
+	if !parametersOnly.MatchString(query) {
+		t.Errorf("%s %#v: query %q writes more than parameters", propertyType, value, query)
+	}
+
+	node := map[string]any{}
+	for _, assignment := range strings.Split(strings.TrimSuffix(query, ", "), ", ") {
+		target, expression, _ := strings.Cut(assignment, " = ")
+		key := strings.TrimPrefix(target, "objectNode.")
+		if expression == "null" {
+			continue
+		}
+		name := expression[strings.Index(expression, "$")+1:]
+		name = strings.TrimSuffix(name, ")")
+		parameter, ok := parameters[name]
+		if !ok {
+			t.Fatalf("%s %#v: query %q refers to the missing parameter %s", propertyType, value, query, name)
+		}
+		node[key] = store(t, expression, parameter)
+	}
+
+	read := ExtractPropertiesFromNeo4jNode(node)
+	if written.Value == nil {
+		if len(read) != 0 {
+			t.Errorf("%s null: read back %v, want no property", propertyType, read)
+		}
+		return written.Type, nil, nil
+	}
+	if len(read) != 1 {
+		t.Fatalf("%s %#v: read back %d properties from %v, want 1", propertyType, value, len(read), node)
+	}
+	return written.Type, written.Value, read[0]
+}
+
+func TestPropertyRoundTrip(t *testing.T) {
+	r := rand.New(rand.NewPCG(45, 45))
+	for _, propertyType := range model.AllPropertyType {
+		generate, ok := generators[propertyType]
+		if !ok {
+			t.Errorf("no generator for property type %s", propertyType)
+			continue
+		}
+		t.Run(propertyType.String(), func(t *testing.T) {
+			for i := 0; i < roundTrips; i++ {
+				value := generate(r)
+				if propertyType == model.PropertyTypeString && (strings.HasPrefix(value.(string), JSONMarker) || strings.HasPrefix(value.(string), ReferenceMarker)) {
+					continue
+				}
+				writtenType, writtenValue, read := roundTrip(t, propertyType, value)
+				if read == nil {
+					// A JSON null is a null value, which removes the property
+					continue
+				}
+				if read.Key != "some_key" {
+					t.Errorf("%s %#v: read back key %s, want some_key", propertyType, value, read.Key)
+				}
+				if read.Type != writtenType {
+					t.Errorf("%s %#v: written as %s, read back as %s", propertyType, value, writtenType, read.Type)
+					continue
+				}
+				// Reading back and writing again stores the same value
+				_, renormalized, err := NormalizePropertyValue(read.Type, read.Value)
+				if err != nil {
+					t.Errorf("%s %#v: read back %#v which does not normalize: %v", propertyType, value, read.Value, err)
+					continue
+				}
+				if !reflect.DeepEqual(renormalized, writtenValue) {
+					t.Errorf("%s %#v: written %#v, read back %#v", propertyType, value, writtenValue, renormalized)
+				}
+			}
+		})
+	}
+}
+
+func TestPropertyRoundTripOfNull(t *testing.T) {
+	for _, propertyType := range model.AllPropertyType {
+		roundTrip(t, propertyType, nil)
+
+		parameters := map[string]any{}
+		query := CreatePropertiesQuery("", parameters, []*model.PropertyInput{{Key: "key", Type: propertyType}}, "objectNode")
+		if want := "objectNode.key = null, objectNode._arraytype_key = null, "; query != want || len(parameters) != 0 {
+			t.Errorf("%s null: got %q with %v, want %q", propertyType, query, parameters, want)
+		}
+		if query := CreatePropertiesQuery("", parameters, []*model.PropertyInput{{Key: "key", Type: propertyType}}); query != "" {
+			t.Errorf("%s null: got %q in a map, want it left out", propertyType, query)
+		}
+	}
+}
+
+func TestStringsCannotLookLikeMarkedValues(t *testing.T) {
+	for _, value := range []any{JSONMarker + `{"a": 1}`, ReferenceMarker + "id", []any{"fine", ReferenceMarker + "id"}} {
+		propertyType := model.PropertyTypeString
+		if _, ok := value.([]any); ok {
+			propertyType = model.PropertyTypeArrayString
+		}
+		if _, _, err := NormalizePropertyValue(propertyType, value); err == nil {
+			t.Errorf("%s %q: accepted a value starting with a marker", propertyType, value)
+		}
+	}
+	if _, value, err := NormalizePropertyValue(model.PropertyTypeString, "json:not a marker \x00json:"); err != nil || value != "json:not a marker \x00json:" {
+		t.Errorf("got %q and %v, want a value merely containing a marker kept", value, err)
+	}
+}
+
+func TestNormalizePropertyValueRejectsMismatchedValues(t *testing.T) {
+	tests := []struct {
+		propertyType model.PropertyType
+		value        any
+	}{
+		{model.PropertyTypeString, []any{"a"}},
+		{model.PropertyTypeBoolean, "true"},
+		{model.PropertyTypeInteger, 1.5},
+		{model.PropertyTypeInteger, "1"},
+		{model.PropertyTypeFloat, math.Inf(1)},
+		{model.PropertyTypeNumber, math.NaN()},
+		{model.PropertyTypeArrayInteger, []any{1, nil}},
+		{model.PropertyTypeArrayBoolean, true},
+		{model.PropertyTypeDate, "2024-13-01"},
+		{model.PropertyTypeDatetime, "2024-01-01T00:00:00"},
+		{model.PropertyTypeLocalDatetime, "2024-01-01T00:00:00Z"},
+		{model.PropertyTypeDuration, "PT"},
+		{model.PropertyTypePoint, map[string]any{"latitude": 91.0, "longitude": 0.0}},
+		{model.PropertyTypePoint, map[string]any{"x": 1.0}},
+		{model.PropertyTypeRelationship, 12},
+		{model.PropertyTypeJSON, map[string]any{"f": func() {}}},
+		{model.PropertyType("UNKNOWN"), "value"},
+	}
+	for _, test := range tests {
+		if _, _, err := NormalizePropertyValue(test.propertyType, test.value); err == nil {
+			t.Errorf("%s %#v: got no error", test.propertyType, test.value)
+		}
+	}
+}
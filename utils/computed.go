@@ -0,0 +1,33 @@
+package utils
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/mike-jacks/neo/model"
+)
+
+// computedPrefix prefixes the type schema node properties holding the expression of a computed property
+const computedPrefix = "_computed_"
+
+// ComputedPropertyKey is the type schema node property holding the expression of the computed property
+func ComputedPropertyKey(property string) string {
+	return computedPrefix + property
+}
+
+// PopComputedProperties removes the computed properties from the properties of a type schema node and returns them
+// by property key
+func PopComputedProperties(m map[string]interface{}) []*model.ComputedProperty {
+	computed := []*model.ComputedProperty{}
+	for key, value := range m {
+		if !strings.HasPrefix(key, computedPrefix) {
+			continue
+		}
+		delete(m, key)
+		if expression, ok := value.(string); ok {
+			computed = append(computed, &model.ComputedProperty{Key: strings.TrimPrefix(key, computedPrefix), Expression: expression})
+		}
+	}
+	sort.Slice(computed, func(i, j int) bool { return computed[i].Key < computed[j].Key })
+	return computed
+}
@@ -0,0 +1,50 @@
+package utils
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/mike-jacks/neo/model"
+)
+
+// constraintPrefix prefixes the type schema node properties holding the JSON encoded constraints of a property
+const constraintPrefix = "_constraint_"
+
+// PropertyConstraintKey is the type schema node property holding the constraints of property
+func PropertyConstraintKey(property string) string {
+	return constraintPrefix + property
+}
+
+// EncodePropertyConstraint returns the JSON a property constraint is stored as
+func EncodePropertyConstraint(constraint *model.PropertyConstraint) (string, error) {
+	encoded, err := json.Marshal(constraint)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+// PopPropertyConstraints removes the property constraints from the properties of a type schema node and returns them
+// by property key
+func PopPropertyConstraints(m map[string]interface{}) []*model.PropertyConstraint {
+	constraints := []*model.PropertyConstraint{}
+	for key, value := range m {
+		if !strings.HasPrefix(key, constraintPrefix) {
+			continue
+		}
+		delete(m, key)
+		encoded, ok := value.(string)
+		if !ok {
+			continue
+		}
+		constraint := &model.PropertyConstraint{}
+		if err := json.Unmarshal([]byte(encoded), constraint); err != nil {
+			continue
+		}
+		constraint.Key = strings.TrimPrefix(key, constraintPrefix)
+		constraints = append(constraints, constraint)
+	}
+	sort.Slice(constraints, func(i, j int) bool { return constraints[i].Key < constraints[j].Key })
+	return constraints
+}
@@ -0,0 +1,19 @@
+package utils
+
+// ParentTypeSchemaNodeIDKey is the type schema node property holding the id of the type schema node it extends
+const ParentTypeSchemaNodeIDKey = "_parentTypeSchemaNodeId"
+
+// SupertypesKey is the object node property listing the names of the ancestors of its type, the types it is an
+// object node of besides its own
+const SupertypesKey = "_supertypes"
+
+// PopParentTypeSchemaNodeID removes the parent from the properties of a type schema node and returns its id, nil
+// when the type schema node has none
+func PopParentTypeSchemaNodeID(m map[string]interface{}) *string {
+	value, ok := m[ParentTypeSchemaNodeIDKey].(string)
+	delete(m, ParentTypeSchemaNodeIDKey)
+	if !ok || value == "" {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,142 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/mike-jacks/neo/model"
+)
+
+// JSONMarker prefixes the string a JSON property is stored as, Neo4j properties cannot hold maps
+// and the marker tells a stored JSON value from a STRING property on read
+const JSONMarker = "\x00json:"
+
+// NormalizeJSONValue returns the compact JSON encoding of a JSON property value
+func NormalizeJSONValue(value any) (string, error) {
+	var b bytes.Buffer
+	encoder := json.NewEncoder(&b)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return "", fmt.Errorf("JSON value cannot be encoded: %w", err)
+	}
+	return strings.TrimSuffix(b.String(), "\n"), nil
+}
+
+// DecodeJSONValue parses a JSON document keeping numbers as json.Number so integers stay integers
+func DecodeJSONValue(document string) (any, error) {
+	decoder := json.NewDecoder(strings.NewReader(document))
+	decoder.UseNumber()
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// storedJSON is the string storing a normalized JSON value with its marker
+func storedJSON(value any) string {
+	return JSONMarker + fmt.Sprint(value)
+}
+
+// ExtractJSONProperty decodes a stored JSON value, strings without the marker are not JSON properties
+func ExtractJSONProperty(value any) (any, bool) {
+	s, ok := value.(string)
+	if !ok || !strings.HasPrefix(s, JSONMarker) {
+		return nil, false
+	}
+	decoded, err := DecodeJSONValue(strings.TrimPrefix(s, JSONMarker))
+	if err != nil {
+		return nil, false
+	}
+	return decoded, true
+}
+
+var jsonPathSegment = regexp.MustCompile(`^(?:\.([A-Za-z0-9_\-]+)|\[(\d+)\]|\["((?:[^"\\]|\\.)*)"\])`)
+
+// ParseJSONPath parses a path such as $.address.lines[0] or $["first name"] into object keys and array indexes,
+// the leading $ is optional
+func ParseJSONPath(path string) ([]any, error) {
+	rest := strings.TrimSpace(path)
+	rest = strings.TrimPrefix(rest, "$")
+	if rest != "" && rest[0] != '.' && rest[0] != '[' {
+		rest = "." + rest
+	}
+
+	segments := []any{}
+	for rest != "" {
+		match := jsonPathSegment.FindStringSubmatch(rest)
+		if match == nil {
+			return nil, fmt.Errorf("invalid JSON path %q at %q", path, rest)
+		}
+		switch {
+		case match[1] != "":
+			segments = append(segments, match[1])
+		case match[2] != "":
+			index, err := strconv.Atoi(match[2])
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON path %q: %w", path, err)
+			}
+			segments = append(segments, index)
+		default:
+			key, err := strconv.Unquote(`"` + match[3] + `"`)
+			if err != nil {
+				return nil, fmt.Errorf("invalid JSON path %q: %w", path, err)
+			}
+			segments = append(segments, key)
+		}
+		rest = rest[len(match[0]):]
+	}
+	return segments, nil
+}
+
+// LookupJSONPath returns the value at a parsed path, false when the path does not exist in value
+func LookupJSONPath(value any, path []any) (any, bool) {
+	for _, segment := range path {
+		switch s := segment.(type) {
+		case string:
+			object, ok := value.(map[string]any)
+			if !ok {
+				return nil, false
+			}
+			if value, ok = object[s]; !ok {
+				return nil, false
+			}
+		case int:
+			array, ok := value.([]any)
+			if !ok || s >= len(array) {
+				return nil, false
+			}
+			value = array[s]
+		}
+	}
+	return value, true
+}
+
+// jsonSchemaPrefix prefixes the type schema node properties holding the JSON Schema of a JSON property
+const jsonSchemaPrefix = "_jsonschema_"
+
+// JSONSchemaKey is the type schema node property holding the JSON Schema of property
+func JSONSchemaKey(property string) string {
+	return jsonSchemaPrefix + property
+}
+
+// PopJSONSchemas removes the JSON Schemas from the properties of a type schema node and returns them by property key
+func PopJSONSchemas(m map[string]interface{}) []*model.PropertyJSONSchema {
+	schemas := []*model.PropertyJSONSchema{}
+	for key, value := range m {
+		if !strings.HasPrefix(key, jsonSchemaPrefix) {
+			continue
+		}
+		delete(m, key)
+		if schema, ok := value.(string); ok {
+			schemas = append(schemas, &model.PropertyJSONSchema{Key: strings.TrimPrefix(key, jsonSchemaPrefix), Schema: schema})
+		}
+	}
+	sort.Slice(schemas, func(i, j int) bool { return schemas[i].Key < schemas[j].Key })
+	return schemas
+}
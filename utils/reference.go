@@ -0,0 +1,37 @@
+package utils
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ReferenceMarker prefixes the string a RELATIONSHIP property is stored as. On an object node the value is the
+// id of the referenced object node, on a schema node it is the type referenced object nodes must have, if any.
+const ReferenceMarker = "\x00ref:"
+
+// NormalizeReferenceValue checks a RELATIONSHIP property value is a string and trims it
+func NormalizeReferenceValue(value any) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("RELATIONSHIP value must be a string, got %T", value)
+	}
+	return strings.TrimSpace(s), nil
+}
+
+// ReferenceName is the name of the object relationship a RELATIONSHIP property maintains
+func ReferenceName(key string) string {
+	return SanitizeStringToUpper(RemoveSpacesAndHyphens(key))
+}
+
+// storedReference is the string storing a normalized RELATIONSHIP value with its marker
+func storedReference(value any) string {
+	return ReferenceMarker + fmt.Sprint(value)
+}
+
+func extractReferenceProperty(value any) (string, bool) {
+	s, ok := value.(string)
+	if !ok || !strings.HasPrefix(s, ReferenceMarker) {
+		return "", false
+	}
+	return strings.TrimPrefix(s, ReferenceMarker), true
+}
@@ -0,0 +1,105 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+// Coordinate reference systems of the points Neo4j stores, by SRID
+const (
+	CRSWGS84     = "wgs-84"
+	CRSCartesian = "cartesian"
+
+	sridWGS84   = 4326
+	sridWGS843D = 4979
+)
+
+// NormalizePointValue checks a POINT value is a map with either latitude and longitude, a WGS-84 point,
+// or x and y, a Cartesian point, and returns it the way it is read back from Neo4j
+func NormalizePointValue(value any) (map[string]any, error) {
+	coordinates, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("POINT value must be an object with latitude and longitude or x and y, got %T", value)
+	}
+
+	_, hasLatitude := coordinates["latitude"]
+	_, hasLongitude := coordinates["longitude"]
+	_, hasX := coordinates["x"]
+	_, hasY := coordinates["y"]
+	switch {
+	case hasLatitude && hasLongitude && !hasX && !hasY && len(coordinates) <= 3:
+		latitude, err := coordinate(coordinates, "latitude")
+		if err != nil {
+			return nil, err
+		}
+		longitude, err := coordinate(coordinates, "longitude")
+		if err != nil {
+			return nil, err
+		}
+		if latitude < -90 || latitude > 90 || longitude < -180 || longitude > 180 {
+			return nil, fmt.Errorf("POINT latitude must be between -90 and 90 and longitude between -180 and 180")
+		}
+		return map[string]any{"crs": CRSWGS84, "latitude": latitude, "longitude": longitude}, checkCRS(coordinates, CRSWGS84)
+	case hasX && hasY && !hasLatitude && !hasLongitude && len(coordinates) <= 3:
+		x, err := coordinate(coordinates, "x")
+		if err != nil {
+			return nil, err
+		}
+		y, err := coordinate(coordinates, "y")
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{"crs": CRSCartesian, "x": x, "y": y}, checkCRS(coordinates, CRSCartesian)
+	}
+	return nil, fmt.Errorf("POINT value must have either latitude and longitude or x and y")
+}
+
+// checkCRS accepts a crs key matching the coordinates, as values read back carry one
+func checkCRS(coordinates map[string]any, crs string) error {
+	value, ok := coordinates["crs"]
+	if !ok || value == crs {
+		return nil
+	}
+	return fmt.Errorf("POINT crs %v does not match its coordinates, expected %s", value, crs)
+}
+
+func coordinate(coordinates map[string]any, key string) (float64, error) {
+	switch v := coordinates[key].(type) {
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case float64:
+		return v, nil
+	case json.Number:
+		return v.Float64()
+	}
+	return 0, fmt.Errorf("POINT %s must be a number", key)
+}
+
+// pointCoordinates are the coordinates point() builds the native point of a normalized POINT value from
+func pointCoordinates(point map[string]any) map[string]any {
+	if point["crs"] == CRSCartesian {
+		return map[string]any{"x": point["x"], "y": point["y"]}
+	}
+	return map[string]any{"latitude": point["latitude"], "longitude": point["longitude"]}
+}
+
+// extractPointProperty maps a point read from Neo4j back to a POINT value
+func extractPointProperty(value any) (map[string]any, bool) {
+	switch v := value.(type) {
+	case dbtype.Point2D:
+		if v.SpatialRefId == sridWGS84 {
+			return map[string]any{"crs": CRSWGS84, "latitude": v.Y, "longitude": v.X}, true
+		}
+		return map[string]any{"crs": CRSCartesian, "x": v.X, "y": v.Y}, true
+	case dbtype.Point3D:
+		if v.SpatialRefId == sridWGS843D {
+			return map[string]any{"crs": CRSWGS84, "latitude": v.Y, "longitude": v.X, "height": v.Z}, true
+		}
+		return map[string]any{"crs": CRSCartesian, "x": v.X, "y": v.Y, "z": v.Z}, true
+	}
+	return nil, false
+}
@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mike-jacks/neo/model"
+	"github.com/neo4j/neo4j-go-driver/v5/neo4j/dbtype"
+)
+
+const (
+	dateLayout          = "2006-01-02"
+	localDateTimeLayout = "2006-01-02T15:04:05.999999999"
+)
+
+// temporalFunctions are the Cypher functions building the native value of each temporal property type
+var temporalFunctions = map[model.PropertyType]string{
+	model.PropertyTypeDate:          "date",
+	model.PropertyTypeDatetime:      "datetime",
+	model.PropertyTypeLocalDatetime: "localdatetime",
+	model.PropertyTypeDuration:      "duration",
+}
+
+var durationPattern = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)(?:\.(\d{1,9}))?S)?)?$`)
+
+func IsTemporalPropertyType(propertyType model.PropertyType) bool {
+	_, ok := temporalFunctions[propertyType]
+	return ok
+}
+
+// NormalizeTemporalValue checks an ISO 8601 temporal value and returns it in the form it is read back from Neo4j,
+// DATETIME values must carry a UTC offset and DURATION values are expressed in months, days and seconds
+func NormalizeTemporalValue(propertyType model.PropertyType, value any) (string, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%s value must be an ISO 8601 string, got %T", propertyType, value)
+	}
+	s = strings.TrimSpace(s)
+
+	switch propertyType {
+	case model.PropertyTypeDate:
+		t, err := time.Parse(dateLayout, s)
+		if err != nil {
+			return "", fmt.Errorf("invalid DATE %q, expected YYYY-MM-DD", s)
+		}
+		return t.Format(dateLayout), nil
+	case model.PropertyTypeDatetime:
+		t, err := time.Parse(time.RFC3339Nano, s)
+		if err != nil {
+			return "", fmt.Errorf("invalid DATETIME %q, expected YYYY-MM-DDThh:mm:ss with a Z or ±hh:mm offset", s)
+		}
+		return t.Format(time.RFC3339Nano), nil
+	case model.PropertyTypeLocalDatetime:
+		t, err := time.Parse(localDateTimeLayout, s)
+		if err != nil {
+			return "", fmt.Errorf("invalid LOCAL_DATETIME %q, expected YYYY-MM-DDThh:mm:ss without an offset", s)
+		}
+		return t.Format(localDateTimeLayout), nil
+	case model.PropertyTypeDuration:
+		duration, err := parseDuration(s)
+		if err != nil {
+			return "", err
+		}
+		return duration.String(), nil
+	}
+	return "", fmt.Errorf("%s is not a temporal property type", propertyType)
+}
+
+// parseDuration parses an ISO 8601 duration into the months, days and seconds Neo4j keeps
+func parseDuration(s string) (dbtype.Duration, error) {
+	match := durationPattern.FindStringSubmatch(s)
+	if match == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return dbtype.Duration{}, fmt.Errorf("invalid DURATION %q, expected P[nY][nM][nW][nD][T[nH][nM][nS]]", s)
+	}
+	var err error
+	n := make([]int64, len(match))
+	for i, part := range match[1:8] {
+		if part != "" {
+			if n[i+1], err = strconv.ParseInt(part, 10, 64); err != nil {
+				return dbtype.Duration{}, fmt.Errorf("invalid DURATION %q: %w", s, err)
+			}
+		}
+	}
+	nanos := 0
+	if match[8] != "" {
+		nanos, _ = strconv.Atoi(match[8] + strings.Repeat("0", 9-len(match[8])))
+	}
+	return dbtype.Duration{
+		Months:  n[1]*12 + n[2],
+		Days:    n[3]*7 + n[4],
+		Seconds: n[5]*3600 + n[6]*60 + n[7],
+		Nanos:   nanos,
+	}, nil
+}
+
+// TemporalFunction is the Cypher function building the native value of propertyType from its ISO 8601 string
+func TemporalFunction(propertyType model.PropertyType) string {
+	return temporalFunctions[propertyType]
+}
+
+// extractTemporalProperty maps a temporal value read from Neo4j back to its property type and ISO 8601 string
+func extractTemporalProperty(value any) (model.PropertyType, string, bool) {
+	switch v := value.(type) {
+	case dbtype.Date:
+		return model.PropertyTypeDate, v.Time().Format(dateLayout), true
+	case dbtype.LocalDateTime:
+		return model.PropertyTypeLocalDatetime, v.Time().Format(localDateTimeLayout), true
+	case time.Time:
+		return model.PropertyTypeDatetime, v.Format(time.RFC3339Nano), true
+	case dbtype.Duration:
+		return model.PropertyTypeDuration, v.String(), true
+	}
+	return "", "", false
+}
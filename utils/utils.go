@@ -1,7 +1,6 @@
 package utils
 
 import (
-	"context"
 	"fmt"
 	"math/rand/v2"
 	"regexp"
@@ -10,7 +9,6 @@ import (
 	"time"
 
 	"github.com/mike-jacks/neo/model"
-	"github.com/neo4j/neo4j-go-driver/v5/neo4j"
 	"github.com/nrednav/cuid2"
 )
 
@@ -38,22 +36,6 @@ func DereferenceOrNilString(s *string) interface{} {
 	return *s
 }
 
-func CreateConstraint(ctx context.Context, driver neo4j.DriverWithContext, queries []*string) error {
-	session := driver.NewSession(ctx, neo4j.SessionConfig{AccessMode: neo4j.AccessModeWrite})
-	defer session.Close(ctx)
-
-	for _, query := range queries {
-		_, err := session.ExecuteWrite(ctx, func(tx neo4j.ManagedTransaction) (interface{}, error) {
-			_, err := tx.Run(ctx, *query, nil)
-			return nil, err
-		})
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
 func PopString(m map[string]interface{}, key string) string {
 	value, ok := m[key]
 	if !ok {
@@ -63,72 +45,34 @@ func PopString(m map[string]interface{}, key string) string {
 	return value.(string)
 }
 
-func CreatePropertiesQuery(query string, properties []*model.PropertyInput, prefix ...string) string {
-	for _, property := range properties {
+// CreatePropertiesQuery appends the assignments writing properties, normalized by CleanUpPropertyObjects, to query:
+// "prefix.key = expression, " with a prefix and "key: expression, " without. The values are added to parameters, the
+// query only refers to them. A nil value sets the property to null, removing it, and is left out of the map form.
+// Array properties also write their type to ArrayTypeKey(key).
+func CreatePropertiesQuery(query string, parameters map[string]any, properties []*model.PropertyInput, prefix ...string) string {
+	for i, property := range properties {
 		if SpecialProps[property.Key] {
 			continue
 		}
-		if len(prefix) > 0 {
-			query += fmt.Sprintf("%v.", prefix[0])
-			if property.Type.String() == "STRING" {
-				query += fmt.Sprintf("%v = \"%v\", ", property.Key, property.Value)
-			} else if property.Type.String() == "BOOLEAN" {
-				query += fmt.Sprintf("%v = %v, ", property.Key, property.Value)
-			} else if property.Type.String() == "NUMBER" {
-				query += fmt.Sprintf("%v = %v, ", property.Key, property.Value)
-			} else if property.Type.String() == "ARRAY_STRING" {
-				query += fmt.Sprintf("%v = [", property.Key)
-				for _, value := range property.Value.([]interface{}) {
-					query += fmt.Sprintf("\"%v\", ", value)
-				}
-				query = strings.TrimSuffix(query, ", ")
-				query += "], "
-			} else if property.Type.String() == "ARRAY_NUMBER" {
-				query += fmt.Sprintf("%v = [", property.Key)
-				for _, value := range property.Value.([]interface{}) {
-					query += fmt.Sprintf("%v, ", value)
-				}
-				query = strings.TrimSuffix(query, ", ")
-				query += "], "
-			} else if property.Type.String() == "ARRAY_BOOLEAN" {
-				query += fmt.Sprintf("%v = [", property.Key)
-				for _, value := range property.Value.([]interface{}) {
-					query += fmt.Sprintf("%v, ", value)
-				}
-				query = strings.TrimSuffix(query, ", ")
-				query += "], "
-			}
+		name := fmt.Sprintf("propertyValue%d", i)
+		expression, value := PropertyParameter(property.Type, property.Value, name)
+		assignments := [][2]string{{property.Key, expression}}
+		if property.Value == nil {
+			assignments = append(assignments, [2]string{ArrayTypeKey(property.Key), "null"})
 		} else {
-			if property.Type.String() == "STRING" {
-				query += fmt.Sprintf("%v: \"%v\", ", property.Key, property.Value)
-			} else if property.Type.String() == "BOOLEAN" {
-				query += fmt.Sprintf("%v: %v, ", property.Key, property.Value)
-			} else if property.Type.String() == "NUMBER" {
-				query += fmt.Sprintf("%v: %v, ", property.Key, property.Value)
-			} else if property.Type.String() == "ARRAY_STRING" {
-				query += fmt.Sprintf("%v: [", property.Key)
-				for _, value := range property.Value.([]interface{}) {
-					query += fmt.Sprintf("\"%v\", ", value)
-				}
-				query = strings.TrimSuffix(query, ", ")
-				query += "], "
-			} else if property.Type.String() == "ARRAY_NUMBER" {
-				query += fmt.Sprintf("%v: [", property.Key)
-				for _, value := range property.Value.([]interface{}) {
-					query += fmt.Sprintf("%v, ", value)
-				}
-				query = strings.TrimSuffix(query, ", ")
-				query += "], "
-			} else if property.Type.String() == "ARRAY_BOOLEAN" {
-				query += fmt.Sprintf("%v: [", property.Key)
-				for _, value := range property.Value.([]interface{}) {
-					query += fmt.Sprintf("%v, ", value)
-				}
-				query = strings.TrimSuffix(query, ", ")
-				query += "], "
+			parameters[name] = value
+			if IsArrayPropertyType(property.Type) {
+				parameters[name+"ArrayType"] = property.Type.String()
+				assignments = append(assignments, [2]string{ArrayTypeKey(property.Key), "$" + name + "ArrayType"})
+			}
+		}
+		for _, assignment := range assignments {
+			if len(prefix) > 0 {
+				query += fmt.Sprintf("%v.%v = %v, ", prefix[0], assignment[0], assignment[1])
+			} else if property.Value != nil {
+				query += fmt.Sprintf("%v: %v, ", assignment[0], assignment[1])
 			}
 		}
-
 	}
 	return query
 }
@@ -140,6 +84,7 @@ func RemovePropertiesQuery(query string, properties []string, prefix ...string)
 				continue
 			}
 			query += fmt.Sprintf("%v.%v = null, ", prefix[0], property)
+			query += fmt.Sprintf("%v.%v = null, ", prefix[0], ArrayTypeKey(property))
 		}
 	} else {
 		for _, property := range properties {
@@ -147,6 +92,7 @@ func RemovePropertiesQuery(query string, properties []string, prefix ...string)
 				continue
 			}
 			query += fmt.Sprintf("%v: null, ", property)
+			query += fmt.Sprintf("%v: null, ", ArrayTypeKey(property))
 		}
 	}
 	return query
@@ -171,6 +117,12 @@ func CleanUpPropertyObjects(properties *[]*model.PropertyInput) error {
 	for _, property := range *properties {
 		cleanPropKey := RemoveSpacesAndLowerCase(property.Key)
 		if !SpecialProps[cleanPropKey] {
+			propertyType, value, err := NormalizePropertyValue(property.Type, property.Value)
+			if err != nil {
+				return fmt.Errorf("property %v: %w", cleanPropKey, err)
+			}
+			property.Type = propertyType
+			property.Value = value
 			property.Key = cleanPropKey
 			result = append(result, property)
 		}
@@ -207,6 +159,8 @@ func RenamePropertyQuery(query string, oldPropertyName string, newPropertyName s
 	if len(prefix) > 0 {
 		query += fmt.Sprintf("%v.%v = %v.%v, ", prefix[0], newPropertyName, prefix[0], oldPropertyName)
 		query += fmt.Sprintf("%v.%v = null, ", prefix[0], oldPropertyName)
+		query += fmt.Sprintf("%v.%v = %v.%v, ", prefix[0], ArrayTypeKey(newPropertyName), prefix[0], ArrayTypeKey(oldPropertyName))
+		query += fmt.Sprintf("%v.%v = null, ", prefix[0], ArrayTypeKey(oldPropertyName))
 	} else {
 		query += fmt.Sprintf("%v: $newPropertyName, ", newPropertyName)
 		query += fmt.Sprintf("%v: null, ", oldPropertyName)
@@ -261,28 +215,20 @@ func GenerateId() string {
 }
 
 func ExtractPropertiesFromNeo4jNode(properties map[string]interface{}) []*model.Property {
+	arrayTypes := map[string]model.PropertyType{}
+	for key, value := range properties {
+		if propertyType, ok := value.(string); ok && strings.HasPrefix(key, arrayTypePrefix) {
+			arrayTypes[strings.TrimPrefix(key, arrayTypePrefix)] = model.PropertyType(propertyType)
+		}
+	}
+
 	extractedProperties := []*model.Property{}
 	for key, value := range properties {
-		switch value.(type) {
-		case string:
-			extractedProperties = append(extractedProperties, &model.Property{Key: key, Value: value, Type: model.PropertyTypeString})
-		case int64:
-			extractedProperties = append(extractedProperties, &model.Property{Key: key, Value: value, Type: model.PropertyTypeNumber})
-		case bool:
-			extractedProperties = append(extractedProperties, &model.Property{Key: key, Value: value, Type: model.PropertyTypeBoolean})
-		case float64:
-			extractedProperties = append(extractedProperties, &model.Property{Key: key, Value: value, Type: model.PropertyTypeNumber})
-		case []interface{}:
-			switch value.([]interface{})[0].(type) {
-			case string:
-				extractedProperties = append(extractedProperties, &model.Property{Key: key, Value: value, Type: model.PropertyTypeArrayString})
-			case int64:
-				extractedProperties = append(extractedProperties, &model.Property{Key: key, Value: value, Type: model.PropertyTypeArrayNumber})
-			case bool:
-				extractedProperties = append(extractedProperties, &model.Property{Key: key, Value: value, Type: model.PropertyTypeArrayBoolean})
-			case float64:
-				extractedProperties = append(extractedProperties, &model.Property{Key: key, Value: value, Type: model.PropertyTypeArrayNumber})
-			}
+		if strings.HasPrefix(key, arrayTypePrefix) || key == SupertypesKey {
+			continue
+		}
+		if property, ok := extractProperty(key, value, arrayTypes[key]); ok {
+			extractedProperties = append(extractedProperties, property)
 		}
 	}
 	return extractedProperties